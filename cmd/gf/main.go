@@ -2,14 +2,21 @@ package main
 
 import (
 	"context"
+	"io"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/charmbracelet/log"
 	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/clipboard"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/config"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/git"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/notify"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/state"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/cli"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/progress"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/tui"
 	"github.com/qskkk/git-fleet/v2/internal/pkg/logger"
@@ -20,22 +27,19 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
-	// Check for verbose/debug flag early
-	verbose := false
-	for _, arg := range os.Args {
-		if arg == "-v" || arg == "--verbose" || arg == "-d" || arg == "--debug" {
-			verbose = true
-			break
-		}
-	}
+	// Check for flags needed before the command is handled
+	flags := cli.ScanFlags(os.Args[1:])
+	verbose := flags.Verbose
 
-	// Initialize logger with appropriate level
-	var loggerService logger.Service
+	// Initialize logger with appropriate level, --log-level taking precedence over -v
+	logLevel := logger.WARN
 	if verbose {
-		loggerService = logger.NewWithLevel(logger.DEBUG)
-	} else {
-		loggerService = logger.NewWithLevel(logger.WARN)
+		logLevel = logger.DEBUG
 	}
+	if level, ok := logger.ParseLevel(flags.LogLevel); ok {
+		logLevel = level
+	}
+	loggerService := logger.NewWithLevel(logLevel)
 
 	// Initialize UI components
 	stylesService := styles.NewService(styles.ThemeFleetName)
@@ -50,8 +54,11 @@ func main() {
 		os.Exit(0)
 	}
 
-	// Initialize configuration
+	// Initialize configuration, from the file given with --config when set
 	configRepo := config.NewRepository()
+	if flags.ConfigPath != "" {
+		configRepo = config.NewRepositoryWithPath(flags.ConfigPath)
+	}
 	configService := config.NewService(configRepo, loggerService)
 	validationService := config.NewValidationService()
 
@@ -61,11 +68,29 @@ func main() {
 		os.Exit(1)
 	}
 
-	stylesService.SetTheme(styles.GetThemeFromString(configService.GetTheme(ctx)))
+	stylesService.SetTheme(resolveTheme(ctx, configService, loggerService))
+	stylesService.SetBorderStyle(styles.GetBorderStyleFromString(configService.GetBorderStyle(ctx)))
+
+	summaryMetrics := configService.GetSummaryMetrics(ctx)
+	if unknown := presenter.SetSummaryMetrics(summaryMetrics); len(unknown) > 0 {
+		loggerService.Warn(ctx, "Ignoring unknown summary metrics", "metrics", unknown)
+	}
 
 	// Initialize Git repository
 	gitRepo := git.NewRepository()
-	executorRepo := git.NewExecutor(stylesService)
+	progressService := progress.NewProgressService(stylesService)
+	executorRepo := git.NewExecutorWithProgressReporter(progressService)
+	switch {
+	case flags.Events == cli.EventsFormatJSONLines:
+		// Events are the only output written to stdout in this mode
+		executorRepo = git.NewExecutorWithProgressReporter(progress.NewJSONLinesReporter(os.Stdout))
+	case flags.SummaryOnly:
+		// Only the final statistics are printed, by the CLI handler
+		executorRepo = git.NewExecutorWithProgressReporter(&progress.NoOpProgressReporter{})
+	}
+
+	// Print in-flight executions on SIGUSR1 without interrupting them
+	watchProgressDump(ctx, executorRepo)
 
 	// Initialize services
 	executionService := git.NewExecutionService(gitRepo, executorRepo, configService, loggerService)
@@ -92,6 +117,11 @@ func main() {
 		presenter,
 	)
 
+	// Record the last command run in each repository next to the configuration
+	stateRepo := state.NewRepository(filepath.Join(filepath.Dir(configRepo.GetPath()), state.FileName))
+	executeCommandUC.SetStateRepository(stateRepo)
+	statusReportUC.SetStateRepository(stateRepo)
+
 	manageConfigUC := usecases.NewManageConfigUseCase(
 		configRepo,
 		configService,
@@ -100,13 +130,62 @@ func main() {
 		presenter,
 	)
 
+	// In CLI mode, saving over a configuration file edited meanwhile is confirmed on the terminal
+	if len(os.Args) > 1 {
+		manageConfigUC.SetConfirmer(cli.NewTerminalConfirmer(os.Stdin, os.Stderr))
+	}
+
+	// Register the repositories created since the last start, unless --no-discover is set
+	if !flags.NoDiscover {
+		discoverOnStartup(ctx, manageConfigUC, loggerService)
+	}
+
 	// Determine if we should run in interactive mode
 	if len(os.Args) == 1 {
 		// Interactive mode
 		runInteractiveMode(ctx, executeCommandUC, statusReportUC, manageConfigUC, stylesService, loggerService)
 	} else {
-		// CLI mode
-		runCLIMode(ctx, os.Args, executeCommandUC, statusReportUC, manageConfigUC, stylesService, loggerService, verbose)
+		// CLI mode, where dangerous commands are confirmed and commit messages asked on the terminal
+		executeCommandUC.SetConfirmer(cli.NewTerminalConfirmer(os.Stdin, os.Stderr))
+		executeCommandUC.SetPrompter(cli.NewTerminalPrompter(os.Stdin, os.Stderr))
+		executeCommandUC.SetNotifier(notify.NewDesktopNotifier())
+		runCLIMode(ctx, os.Args, executeCommandUC, statusReportUC, manageConfigUC, stylesService, presenter.Output(), progressService, summaryMetrics, configService.GetPager(ctx), loggerService, verbose)
+	}
+}
+
+// resolveTheme returns the configured theme. The time theme follows the current hour and
+// falls back to the fleet theme when its schedule is missing or invalid.
+func resolveTheme(ctx context.Context, configService services.ConfigService, logger logger.Service) styles.Theme {
+	theme := configService.GetTheme(ctx)
+	if theme != styles.ThemeTimeName {
+		return styles.GetThemeFromString(theme)
+	}
+
+	schedule := configService.GetThemeSchedule(ctx)
+	if schedule == nil {
+		logger.Warn(ctx, "The time theme needs a theme_schedule, using the fleet theme")
+		return styles.ThemeFleet
+	}
+
+	scheduled, ok := styles.GetScheduledTheme(time.Now().Hour(), schedule.DayStart, schedule.DayEnd, schedule.NightTheme)
+	if !ok {
+		logger.Warn(ctx, "Invalid theme_schedule hours, using the fleet theme", "day_start", schedule.DayStart, "day_end", schedule.DayEnd)
+	}
+	return scheduled
+}
+
+// discoverOnStartup runs the automatic discovery configured with auto_discover, reporting
+// the repositories it added on stderr. Failures are only logged so that the requested
+// command still runs.
+func discoverOnStartup(ctx context.Context, manageConfigUC *usecases.ManageConfigUseCase, logger logger.Service) {
+	repos, err := manageConfigUC.AutoDiscover(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Automatic discovery failed", "error", err)
+		return
+	}
+
+	for _, repo := range repos {
+		log.Infof("Discovered repository %s at %s", repo.Name, repo.Path)
 	}
 }
 
@@ -139,17 +218,26 @@ func runCLIMode(
 	statusReportUC *usecases.StatusReportUseCase,
 	manageConfigUC *usecases.ManageConfigUseCase,
 	stylesService styles.Service,
+	out io.Writer,
+	progressReporter cli.OutputSetter,
+	summaryMetrics []string,
+	pager bool,
 	logger logger.Service,
 	verbose bool,
 ) {
-	logLevel := "WARN"
-	if verbose {
-		logLevel = "DEBUG"
-	}
-	logger.Info(ctx, "Starting CLI mode", "args", args, "log_level", logLevel)
+	logger.Info(ctx, "Starting CLI mode", "args", args, "verbose", verbose, "log_level", logger.GetLevel().String())
 
 	// Create CLI handler
 	cliHandler := cli.NewHandler(executeCommandUC, statusReportUC, manageConfigUC, stylesService)
+	cliHandler.SetOutput(out)
+	cliHandler.SetTerminal(os.Stdin, os.Stderr)
+	if progressReporter != nil {
+		cliHandler.SetProgress(progressReporter)
+	}
+	cliHandler.SetSummaryMetrics(summaryMetrics)
+	cliHandler.SetPagerDefault(pager)
+	cliHandler.SetClipboard(clipboard.NewSystemClipboard())
+	cliHandler.SetSelector(tui.NewRepositoryPicker(stylesService))
 
 	// Parse and execute command
 	if err := cliHandler.Execute(ctx, args); err != nil {
@@ -102,7 +102,7 @@ func TestRunCLIMode(t *testing.T) {
 			}()
 
 			// Call runCLIMode - this might exit, which is expected for some commands
-			runCLIMode(ctx, tt.args, executeCommandUC, statusReportUC, manageConfigUC, stylesService, loggerService, tt.verbose)
+			runCLIMode(ctx, tt.args, executeCommandUC, statusReportUC, manageConfigUC, stylesService, os.Stdout, nil, nil, false, loggerService, tt.verbose)
 		})
 	}
 }
@@ -136,7 +136,7 @@ func TestRunCLIModeWithValidCommand(t *testing.T) {
 			defer testCancel()
 
 			// This should complete without calling os.Exit
-			runCLIMode(testCtx, tc.args, executeCommandUC, statusReportUC, manageConfigUC, stylesService, loggerService, false)
+			runCLIMode(testCtx, tc.args, executeCommandUC, statusReportUC, manageConfigUC, stylesService, os.Stdout, nil, nil, false, loggerService, false)
 		})
 	}
 }
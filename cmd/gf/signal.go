@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+)
+
+// dumpRunningExecutions writes the repositories currently in flight and how long they have been running
+func dumpRunningExecutions(ctx context.Context, w io.Writer, executor repositories.ExecutorRepository) {
+	running, err := executor.GetRunningExecutions(ctx)
+	if err != nil {
+		fmt.Fprintf(w, "Failed to get running executions: %v\n", err)
+		return
+	}
+
+	if len(running) == 0 {
+		fmt.Fprintln(w, "⏳ No repositories in progress")
+		return
+	}
+
+	sort.Slice(running, func(i, j int) bool {
+		return running[i].Repository < running[j].Repository
+	})
+
+	fmt.Fprintf(w, "⏳ %d repositories in progress:\n", len(running))
+	for _, result := range running {
+		elapsed := time.Since(result.StartTime).Round(100 * time.Millisecond)
+		fmt.Fprintf(w, "  %s: %s (%s)\n", result.Repository, result.Command, elapsed)
+	}
+}
@@ -0,0 +1,12 @@
+//go:build !unix
+
+package main
+
+import (
+	"context"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+)
+
+// watchProgressDump is a no-op on platforms without SIGUSR1
+func watchProgressDump(ctx context.Context, executor repositories.ExecutorRepository) {}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+)
+
+func TestDumpRunningExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executor := repositories.NewMockExecutorRepository(ctrl)
+	ctx := context.Background()
+
+	api := entities.NewExecutionResult("api", "fetch")
+	api.StartTime = time.Now().Add(-3 * time.Second)
+	web := entities.NewExecutionResult("web", "fetch")
+
+	executor.EXPECT().GetRunningExecutions(ctx).Return([]*entities.ExecutionResult{web, api}, nil)
+
+	var out bytes.Buffer
+	dumpRunningExecutions(ctx, &out, executor)
+
+	output := out.String()
+	if !strings.Contains(output, "2 repositories in progress") {
+		t.Errorf("expected running count in output, got:\n%s", output)
+	}
+	if strings.Index(output, "api: fetch") > strings.Index(output, "web: fetch") {
+		t.Errorf("expected repositories sorted by name, got:\n%s", output)
+	}
+	if !strings.Contains(output, "api: fetch (3") {
+		t.Errorf("expected elapsed time for api, got:\n%s", output)
+	}
+}
+
+func TestDumpRunningExecutions_Empty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executor := repositories.NewMockExecutorRepository(ctrl)
+	ctx := context.Background()
+
+	executor.EXPECT().GetRunningExecutions(ctx).Return(nil, nil)
+
+	var out bytes.Buffer
+	dumpRunningExecutions(ctx, &out, executor)
+
+	if !strings.Contains(out.String(), "No repositories in progress") {
+		t.Errorf("expected empty message, got:\n%s", out.String())
+	}
+}
+
+func TestDumpRunningExecutions_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executor := repositories.NewMockExecutorRepository(ctrl)
+	ctx := context.Background()
+
+	executor.EXPECT().GetRunningExecutions(ctx).Return(nil, errors.New("boom"))
+
+	var out bytes.Buffer
+	dumpRunningExecutions(ctx, &out, executor)
+
+	if !strings.Contains(out.String(), "boom") {
+		t.Errorf("expected error in output, got:\n%s", out.String())
+	}
+}
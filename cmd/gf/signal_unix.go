@@ -0,0 +1,30 @@
+//go:build unix
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+)
+
+// watchProgressDump prints the running executions to stderr each time SIGUSR1 is received
+func watchProgressDump(ctx context.Context, executor repositories.ExecutorRepository) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1)
+
+	go func() {
+		defer signal.Stop(signals)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				dumpRunningExecutions(ctx, os.Stderr, executor)
+			}
+		}
+	}()
+}
@@ -1,4 +1,4 @@
-//go:generate go run go.uber.org/mock/mockgen -package=input -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/input CLIPort,InteractivePort,ConfigManager
+//go:generate go run go.uber.org/mock/mockgen -package=input -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/input CLIPort,InteractivePort,ConfigManager,ConfirmationPort,PromptPort
 package input
 
 import (
@@ -40,6 +40,19 @@ type InteractivePort interface {
 	ShowResults(ctx context.Context, summary *entities.Summary) error
 }
 
+// ConfirmationPort defines the interface for asking the user to confirm an action
+type ConfirmationPort interface {
+	// Confirm shows the prompt and returns true if the user accepts
+	Confirm(ctx context.Context, prompt string) (bool, error)
+}
+
+// PromptPort defines the interface for asking the user to type a line of text
+type PromptPort interface {
+	// Prompt shows the prompt and returns the line typed by the user, without its
+	// surrounding spaces
+	Prompt(ctx context.Context, prompt string) (string, error)
+}
+
 // ConfigManager defines the interface for configuration management
 type ConfigManager interface {
 	// ShowConfig displays the current configuration
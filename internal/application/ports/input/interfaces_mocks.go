@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/qskkk/git-fleet/v2/internal/application/ports/input (interfaces: CLIPort,InteractivePort,ConfigManager)
+// Source: github.com/qskkk/git-fleet/v2/internal/application/ports/input (interfaces: CLIPort,InteractivePort,ConfigManager,ConfirmationPort,PromptPort)
 //
 // Generated by this command:
 //
-//	mockgen -package=input -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/input CLIPort,InteractivePort,ConfigManager
+//	mockgen -package=input -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/input CLIPort,InteractivePort,ConfigManager,ConfirmationPort,PromptPort
 //
 
 // Package input is a generated GoMock package.
@@ -331,3 +331,81 @@ func (mr *MockConfigManagerMockRecorder) ValidateConfig(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateConfig", reflect.TypeOf((*MockConfigManager)(nil).ValidateConfig), ctx)
 }
+
+// MockConfirmationPort is a mock of ConfirmationPort interface.
+type MockConfirmationPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationPortMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmationPortMockRecorder is the mock recorder for MockConfirmationPort.
+type MockConfirmationPortMockRecorder struct {
+	mock *MockConfirmationPort
+}
+
+// NewMockConfirmationPort creates a new mock instance.
+func NewMockConfirmationPort(ctrl *gomock.Controller) *MockConfirmationPort {
+	mock := &MockConfirmationPort{ctrl: ctrl}
+	mock.recorder = &MockConfirmationPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmationPort) EXPECT() *MockConfirmationPortMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockConfirmationPort) Confirm(ctx context.Context, prompt string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, prompt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockConfirmationPortMockRecorder) Confirm(ctx, prompt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockConfirmationPort)(nil).Confirm), ctx, prompt)
+}
+
+// MockPromptPort is a mock of PromptPort interface.
+type MockPromptPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromptPortMockRecorder
+	isgomock struct{}
+}
+
+// MockPromptPortMockRecorder is the mock recorder for MockPromptPort.
+type MockPromptPortMockRecorder struct {
+	mock *MockPromptPort
+}
+
+// NewMockPromptPort creates a new mock instance.
+func NewMockPromptPort(ctrl *gomock.Controller) *MockPromptPort {
+	mock := &MockPromptPort{ctrl: ctrl}
+	mock.recorder = &MockPromptPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromptPort) EXPECT() *MockPromptPortMockRecorder {
+	return m.recorder
+}
+
+// Prompt mocks base method.
+func (m *MockPromptPort) Prompt(ctx context.Context, prompt string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prompt", ctx, prompt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prompt indicates an expected call of Prompt.
+func (mr *MockPromptPortMockRecorder) Prompt(ctx, prompt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prompt", reflect.TypeOf((*MockPromptPort)(nil).Prompt), ctx, prompt)
+}
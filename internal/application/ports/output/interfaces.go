@@ -1,8 +1,9 @@
-//go:generate go run go.uber.org/mock/mockgen -package=output -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/output PresenterPort,FormatterPort,WriterPort
+//go:generate go run go.uber.org/mock/mockgen -package=output -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/output PresenterPort,FormatterPort,WriterPort,NotifierPort,ClipboardPort,SelectorPort
 package output
 
 import (
 	"context"
+	"io"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 )
@@ -12,6 +13,9 @@ type PresenterPort interface {
 	// PresentStatus presents repository status information
 	PresentStatus(ctx context.Context, repos []*entities.Repository, groupFilter string) (string, error)
 
+	// PresentStatusByTag presents repository status information in one section per tag
+	PresentStatusByTag(ctx context.Context, repos []*entities.Repository) (string, error)
+
 	// PresentConfig presents configuration information
 	PresentConfig(ctx context.Context, config interface{}) (string, error)
 
@@ -26,6 +30,12 @@ type PresenterPort interface {
 
 	// PresentVersion presents version information
 	PresentVersion(ctx context.Context) string
+
+	// Output returns the writer presented information is written to
+	Output() io.Writer
+
+	// SetSummaryMetrics sets the metrics shown in summaries and returns the unknown ones
+	SetSummaryMetrics(metrics []string) []string
 }
 
 // FormatterPort defines the interface for formatting output
@@ -76,6 +86,25 @@ type WriterPort interface {
 	IsVerbose() bool
 }
 
+// NotifierPort defines the interface for notifying the user outside the terminal
+type NotifierPort interface {
+	// Notify shows a notification with the given title and message
+	Notify(ctx context.Context, title, message string) error
+}
+
+// ClipboardPort defines the interface for copying text to the system clipboard
+type ClipboardPort interface {
+	// Copy replaces the content of the clipboard with the text
+	Copy(ctx context.Context, text string) error
+}
+
+// SelectorPort defines the interface for letting the user pick repositories interactively
+type SelectorPort interface {
+	// SelectRepositories returns the names of the repositories the user kept, all of them
+	// being offered checked
+	SelectRepositories(ctx context.Context, repos []*entities.Repository) ([]string, error)
+}
+
 // TableOptions represents options for table formatting
 type TableOptions struct {
 	Title        string            `json:"title,omitempty"`
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/qskkk/git-fleet/v2/internal/application/ports/output (interfaces: PresenterPort,FormatterPort,WriterPort)
+// Source: github.com/qskkk/git-fleet/v2/internal/application/ports/output (interfaces: PresenterPort,FormatterPort,WriterPort,NotifierPort,ClipboardPort,SelectorPort)
 //
 // Generated by this command:
 //
-//	mockgen -package=output -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/output PresenterPort,FormatterPort,WriterPort
+//	mockgen -package=output -destination=interfaces_mocks.go github.com/qskkk/git-fleet/v2/internal/application/ports/output PresenterPort,FormatterPort,WriterPort,NotifierPort,ClipboardPort,SelectorPort
 //
 
 // Package output is a generated GoMock package.
@@ -11,6 +11,7 @@ package output
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	entities "github.com/qskkk/git-fleet/v2/internal/domain/entities"
@@ -41,6 +42,20 @@ func (m *MockPresenterPort) EXPECT() *MockPresenterPortMockRecorder {
 	return m.recorder
 }
 
+// Output mocks base method.
+func (m *MockPresenterPort) Output() io.Writer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Output")
+	ret0, _ := ret[0].(io.Writer)
+	return ret0
+}
+
+// Output indicates an expected call of Output.
+func (mr *MockPresenterPortMockRecorder) Output() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Output", reflect.TypeOf((*MockPresenterPort)(nil).Output))
+}
+
 // PresentConfig mocks base method.
 func (m *MockPresenterPort) PresentConfig(ctx context.Context, config any) (string, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +114,21 @@ func (mr *MockPresenterPortMockRecorder) PresentStatus(ctx, repos, groupFilter a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentStatus", reflect.TypeOf((*MockPresenterPort)(nil).PresentStatus), ctx, repos, groupFilter)
 }
 
+// PresentStatusByTag mocks base method.
+func (m *MockPresenterPort) PresentStatusByTag(ctx context.Context, repos []*entities.Repository) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentStatusByTag", ctx, repos)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresentStatusByTag indicates an expected call of PresentStatusByTag.
+func (mr *MockPresenterPortMockRecorder) PresentStatusByTag(ctx, repos any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentStatusByTag", reflect.TypeOf((*MockPresenterPort)(nil).PresentStatusByTag), ctx, repos)
+}
+
 // PresentSummary mocks base method.
 func (m *MockPresenterPort) PresentSummary(ctx context.Context, summary *entities.Summary) (string, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +158,20 @@ func (mr *MockPresenterPortMockRecorder) PresentVersion(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentVersion", reflect.TypeOf((*MockPresenterPort)(nil).PresentVersion), ctx)
 }
 
+// SetSummaryMetrics mocks base method.
+func (m *MockPresenterPort) SetSummaryMetrics(metrics []string) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSummaryMetrics", metrics)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// SetSummaryMetrics indicates an expected call of SetSummaryMetrics.
+func (mr *MockPresenterPortMockRecorder) SetSummaryMetrics(metrics any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSummaryMetrics", reflect.TypeOf((*MockPresenterPort)(nil).SetSummaryMetrics), metrics)
+}
+
 // MockFormatterPort is a mock of FormatterPort interface.
 type MockFormatterPort struct {
 	ctrl     *gomock.Controller
@@ -373,3 +417,118 @@ func (mr *MockWriterPortMockRecorder) WriteLine(ctx, line any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLine", reflect.TypeOf((*MockWriterPort)(nil).WriteLine), ctx, line)
 }
+
+// MockNotifierPort is a mock of NotifierPort interface.
+type MockNotifierPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierPortMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifierPortMockRecorder is the mock recorder for MockNotifierPort.
+type MockNotifierPortMockRecorder struct {
+	mock *MockNotifierPort
+}
+
+// NewMockNotifierPort creates a new mock instance.
+func NewMockNotifierPort(ctrl *gomock.Controller) *MockNotifierPort {
+	mock := &MockNotifierPort{ctrl: ctrl}
+	mock.recorder = &MockNotifierPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifierPort) EXPECT() *MockNotifierPortMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifierPort) Notify(ctx context.Context, title, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, title, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierPortMockRecorder) Notify(ctx, title, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifierPort)(nil).Notify), ctx, title, message)
+}
+
+// MockClipboardPort is a mock of ClipboardPort interface.
+type MockClipboardPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockClipboardPortMockRecorder
+	isgomock struct{}
+}
+
+// MockClipboardPortMockRecorder is the mock recorder for MockClipboardPort.
+type MockClipboardPortMockRecorder struct {
+	mock *MockClipboardPort
+}
+
+// NewMockClipboardPort creates a new mock instance.
+func NewMockClipboardPort(ctrl *gomock.Controller) *MockClipboardPort {
+	mock := &MockClipboardPort{ctrl: ctrl}
+	mock.recorder = &MockClipboardPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClipboardPort) EXPECT() *MockClipboardPortMockRecorder {
+	return m.recorder
+}
+
+// Copy mocks base method.
+func (m *MockClipboardPort) Copy(ctx context.Context, text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", ctx, text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockClipboardPortMockRecorder) Copy(ctx, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockClipboardPort)(nil).Copy), ctx, text)
+}
+
+// MockSelectorPort is a mock of SelectorPort interface.
+type MockSelectorPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockSelectorPortMockRecorder
+	isgomock struct{}
+}
+
+// MockSelectorPortMockRecorder is the mock recorder for MockSelectorPort.
+type MockSelectorPortMockRecorder struct {
+	mock *MockSelectorPort
+}
+
+// NewMockSelectorPort creates a new mock instance.
+func NewMockSelectorPort(ctrl *gomock.Controller) *MockSelectorPort {
+	mock := &MockSelectorPort{ctrl: ctrl}
+	mock.recorder = &MockSelectorPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSelectorPort) EXPECT() *MockSelectorPortMockRecorder {
+	return m.recorder
+}
+
+// SelectRepositories mocks base method.
+func (m *MockSelectorPort) SelectRepositories(ctx context.Context, repos []*entities.Repository) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectRepositories", ctx, repos)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectRepositories indicates an expected call of SelectRepositories.
+func (mr *MockSelectorPortMockRecorder) SelectRepositories(ctx, repos any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectRepositories", reflect.TypeOf((*MockSelectorPort)(nil).SelectRepositories), ctx, repos)
+}
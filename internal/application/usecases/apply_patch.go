@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// ApplyPatchInput represents input for applying a patch file across groups
+type ApplyPatchInput struct {
+	Groups    []string `json:"groups"`
+	PatchPath string   `json:"patch_path"`
+	ThreeWay  bool     `json:"three_way,omitempty"`
+}
+
+// ApplyPatch applies the patch file to each repository of the groups with git apply,
+// falling back to a three-way merge with ThreeWay. The patch is checked first with
+// git apply --check, and the repositories where it does not apply cleanly are skipped
+// so that none is left half patched.
+func (uc *ExecuteCommandUseCase) ApplyPatch(ctx context.Context, input *ApplyPatchInput) (*ExecuteCommandOutput, error) {
+	uc.logger.Info(ctx, "Starting patch apply", "groups", input.Groups, "patch", input.PatchPath, "three_way", input.ThreeWay)
+
+	if len(input.Groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+	if input.PatchPath == "" {
+		return nil, errors.ErrUsageApply
+	}
+
+	// git runs in each repository, so the patch is given by its absolute path
+	patchPath, err := filepath.Abs(input.PatchPath)
+	if err != nil {
+		return nil, errors.WrapPathError(errors.ErrPathDoesNotExist, input.PatchPath, err)
+	}
+	if _, err := os.Stat(patchPath); err != nil {
+		return nil, errors.WrapPathError(errors.ErrPathDoesNotExist, input.PatchPath, err)
+	}
+
+	repositories, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	command := applyPatchCommand(patchPath, input.ThreeWay, false)
+	applicable, rejected := uc.splitRejectedPatchRepositories(ctx, repositories, applyPatchCommand(patchPath, input.ThreeWay, true))
+
+	summary := entities.NewSummary()
+	if len(applicable) > 0 {
+		summary, err = uc.executorRepo.ExecuteInParallel(ctx, applicable, command)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to apply patch", err, "repositories", len(applicable))
+			return nil, errors.WrapFailedToExecuteCommand(err)
+		}
+	} else {
+		summary.Finalize()
+	}
+
+	addSkippedResults(summary, rejected, command, PatchDoesNotApplyReason)
+
+	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to format output", err)
+		formattedOutput = "Error formatting output"
+	}
+
+	return &ExecuteCommandOutput{
+		Summary:         summary,
+		FormattedOutput: formattedOutput,
+		Success:         !summary.HasFailures(),
+	}, nil
+}
+
+// applyPatchCommand returns the git apply command applying the patch, or only checking
+// that it applies with check
+func applyPatchCommand(patchPath string, threeWay, check bool) *entities.Command {
+	args := []string{"apply"}
+	if check {
+		args = append(args, "--check")
+	}
+	if threeWay {
+		args = append(args, "--3way")
+	}
+	return entities.NewGitCommand(append(args, patchPath))
+}
+
+// splitRejectedPatchRepositories separates the repositories where the patch does not
+// apply cleanly, according to the check command, from the others
+func (uc *ExecuteCommandUseCase) splitRejectedPatchRepositories(ctx context.Context, repositories []*entities.Repository, check *entities.Command) (applicable, rejected []*entities.Repository) {
+	applicable = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		result, err := uc.gitRepo.ExecuteCommand(ctx, repo, check)
+		switch {
+		case err != nil:
+			uc.logger.Warn(ctx, "Failed to check patch", "repository", repo.Name, "error", err)
+			rejected = append(rejected, repo)
+		case !result.IsSuccess():
+			uc.logger.Debug(ctx, "Patch does not apply", "repository", repo.Name, "error", strings.TrimSpace(result.ErrorOutput))
+			rejected = append(rejected, repo)
+		default:
+			applicable = append(applicable, repo)
+		}
+	}
+
+	return applicable, rejected
+}
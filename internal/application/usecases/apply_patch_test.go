@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestApplyPatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, nil, nil, logger, presenter)
+
+	patchPath := filepath.Join(t.TempDir(), "ci.patch")
+	if err := os.WriteFile(patchPath, []byte("diff --git a/ci.yml b/ci.yml\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+	web := &entities.Repository{Name: "web", Path: "/path/to/web"}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, web}, nil)
+
+	check := "apply --check --3way " + patchPath
+	gitRepo.EXPECT().ExecuteCommand(ctx, api, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+			if cmd.GetFullCommand() != check {
+				t.Errorf("check command = %q, want %q", cmd.GetFullCommand(), check)
+			}
+			result := entities.NewExecutionResult("api", cmd.GetFullCommand())
+			result.MarkAsSuccess("", 0)
+			return result, nil
+		})
+	gitRepo.EXPECT().ExecuteCommand(ctx, web, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+			result := entities.NewExecutionResult("web", cmd.GetFullCommand())
+			result.MarkAsFailed("error: patch failed: ci.yml:3\n", 1, "exit status 1")
+			return result, nil
+		})
+
+	presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+	executorRepo.EXPECT().ExecuteInParallel(ctx, []*entities.Repository{api}, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+			if want := "apply --3way " + patchPath; cmd.GetFullCommand() != want {
+				t.Errorf("command = %q, want %q", cmd.GetFullCommand(), want)
+			}
+			summary := entities.NewSummary()
+			result := entities.NewExecutionResult("api", cmd.GetFullCommand())
+			result.MarkAsSuccess("", 0)
+			summary.AddResult(*result)
+			return summary, nil
+		})
+
+	output, err := useCase.ApplyPatch(ctx, &ApplyPatchInput{Groups: []string{"all"}, PatchPath: patchPath, ThreeWay: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !output.Success {
+		t.Error("Expected success")
+	}
+
+	skipped := false
+	for _, result := range output.Summary.Results {
+		if result.Repository == "web" {
+			skipped = result.IsSkipped() && result.ErrorMessage == PatchDoesNotApplyReason
+		}
+	}
+	if !skipped {
+		t.Errorf("Expected web to be skipped as the patch does not apply, got %+v", output.Summary.Results)
+	}
+}
+
+func TestApplyPatch_MissingPatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := services.NewMockLoggingService(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, nil, nil, logger, nil)
+
+	missing := filepath.Join(t.TempDir(), "missing.patch")
+	_, err := useCase.ApplyPatch(context.Background(), &ApplyPatchInput{Groups: []string{"all"}, PatchPath: missing})
+	if !gferrors.IsError(err, gferrors.ErrPathDoesNotExist) {
+		t.Errorf("Expected ErrPathDoesNotExist, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// AuthorContribution holds the commits of an author across the repositories of a report
+type AuthorContribution struct {
+	Author       string `json:"author"`
+	Commits      int    `json:"commits"`
+	Repositories int    `json:"repositories"`
+}
+
+// AuthorsReport ranks the authors of a selection of repositories by their number of
+// commits. Repositories whose log cannot be read are listed as failed.
+type AuthorsReport struct {
+	Authors      []*AuthorContribution `json:"authors"`
+	Repositories int                   `json:"repositories"`
+	Failed       []string              `json:"failed,omitempty"`
+}
+
+// GetAuthors sums the commits of each author over the current branch of the repositories
+// in the given groups, only counting commits after since when it is set. Authors are
+// ranked by commits, then by name.
+func (uc *StatusReportUseCase) GetAuthors(ctx context.Context, groups []string, since string) (*AuthorsReport, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	report := &AuthorsReport{Repositories: len(repos)}
+	contributions := make(map[string]*AuthorContribution)
+	for _, repo := range repos {
+		counts, err := uc.gitRepo.GetAuthorCommitCounts(ctx, repo, since)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get authors", "repository", repo.Name, "error", err)
+			report.Failed = append(report.Failed, repo.Name)
+			continue
+		}
+
+		for author, commits := range counts {
+			contribution, ok := contributions[author]
+			if !ok {
+				contribution = &AuthorContribution{Author: author}
+				contributions[author] = contribution
+				report.Authors = append(report.Authors, contribution)
+			}
+			contribution.Commits += commits
+			contribution.Repositories++
+		}
+	}
+
+	sort.Slice(report.Authors, func(i, j int) bool {
+		if report.Authors[i].Commits != report.Authors[j].Commits {
+			return report.Authors[i].Commits > report.Authors[j].Commits
+		}
+		return report.Authors[i].Author < report.Authors[j].Author
+	})
+
+	return report, nil
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+)
+
+func TestStatusReportUseCase_GetAuthors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"team"}).Return([]*entities.Repository{web, broken, api}, nil)
+	mockGitRepo.EXPECT().GetAuthorCommitCounts(ctx, api, "2.weeks").Return(map[string]int{"Ada": 3, "Bob": 5}, nil)
+	mockGitRepo.EXPECT().GetAuthorCommitCounts(ctx, web, "2.weeks").Return(map[string]int{"Ada": 4, "Cy": 7}, nil)
+	mockGitRepo.EXPECT().GetAuthorCommitCounts(ctx, broken, "2.weeks").Return(nil, errors.New("not a git repository"))
+	mockLogger.EXPECT().Warn(ctx, "Failed to get authors", gomock.Any()).Times(1)
+
+	report, err := usecase.GetAuthors(ctx, []string{"team"}, "2.weeks")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []AuthorContribution{
+		{Author: "Ada", Commits: 7, Repositories: 2},
+		{Author: "Cy", Commits: 7, Repositories: 1},
+		{Author: "Bob", Commits: 5, Repositories: 1},
+	}
+	if len(report.Authors) != len(expected) {
+		t.Fatalf("GetAuthors() returned %d authors, want %d", len(report.Authors), len(expected))
+	}
+	for i, want := range expected {
+		if *report.Authors[i] != want {
+			t.Errorf("GetAuthors() author %d = %+v, want %+v", i, *report.Authors[i], want)
+		}
+	}
+	if report.Repositories != 3 || len(report.Failed) != 1 || report.Failed[0] != "broken" {
+		t.Errorf("GetAuthors() should report broken as failed out of 3 repositories, got %d and %v", report.Repositories, report.Failed)
+	}
+}
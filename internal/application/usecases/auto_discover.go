@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	gitfleetErrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// AutoDiscover adds the repositories found under the configured discovery roots when
+// discovery is set to run on startup and its cooldown has elapsed, and returns them.
+// Nothing is discovered otherwise.
+func (uc *ManageConfigUseCase) AutoDiscover(ctx context.Context) ([]*entities.Repository, error) {
+	settings := uc.configService.GetAutoDiscover(ctx)
+	if !settings.OnStartup() {
+		return nil, nil
+	}
+
+	now := time.Now()
+	if last := uc.configService.LastDiscoveryTime(ctx); now.Sub(last) < settings.CooldownDuration() {
+		uc.logger.Debug(ctx, "Skipping automatic discovery during its cooldown", "last_discovery", last)
+		return nil, nil
+	}
+
+	uc.logger.Info(ctx, "Discovering new repositories", "roots", settings.Roots, "max_depth", settings.Depth())
+	repos, err := uc.configService.DiscoverNewRepositories(ctx, settings.Roots, settings.Depth())
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to discover repositories", err)
+		return nil, gitfleetErrors.WrapRepositoryOperationError(gitfleetErrors.ErrFailedToGetRepositories, err)
+	}
+
+	if err := uc.configService.RecordDiscovery(ctx, now); err != nil {
+		uc.logger.Warn(ctx, "Failed to record the automatic discovery", "error", err)
+	}
+
+	if len(repos) == 0 {
+		return repos, nil
+	}
+
+	for _, repo := range repos {
+		uc.logger.Info(ctx, "Discovered repository", "name", repo.Name, "path", repo.Path)
+	}
+
+	// Discover again in the reloaded configuration, which may have registered some of them
+	reapply := func() error {
+		_, err := uc.configService.DiscoverNewRepositories(ctx, settings.Roots, settings.Depth())
+		return err
+	}
+	if err := uc.saveConfig(ctx, reapply); err != nil {
+		uc.logger.Error(ctx, "Failed to save configuration after discovery", err)
+		return nil, gitfleetErrors.WrapConfigSave(err)
+	}
+
+	return repos, nil
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/logger"
+	"go.uber.org/mock/gomock"
+)
+
+func TestManageConfigUseCase_AutoDiscover(t *testing.T) {
+	onStartup := &repositories.AutoDiscover{When: repositories.AutoDiscoverOnStartup, Roots: []string{"/src"}, Cooldown: "1h"}
+	discovered := []*entities.Repository{{Name: "new-api", Path: "/src/new-api"}}
+
+	tests := []struct {
+		name       string
+		setupMocks func(configService *services.MockConfigService)
+		expected   int
+	}{
+		{
+			name: "not configured",
+			setupMocks: func(configService *services.MockConfigService) {
+				configService.EXPECT().GetAutoDiscover(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "during the cooldown",
+			setupMocks: func(configService *services.MockConfigService) {
+				configService.EXPECT().GetAutoDiscover(gomock.Any()).Return(onStartup)
+				configService.EXPECT().LastDiscoveryTime(gomock.Any()).Return(time.Now().Add(-time.Minute))
+			},
+		},
+		{
+			name: "nothing new",
+			setupMocks: func(configService *services.MockConfigService) {
+				configService.EXPECT().GetAutoDiscover(gomock.Any()).Return(onStartup)
+				configService.EXPECT().LastDiscoveryTime(gomock.Any()).Return(time.Time{})
+				configService.EXPECT().DiscoverNewRepositories(gomock.Any(), []string{"/src"}, repositories.DefaultDiscoverMaxDepth).Return(nil, nil)
+				configService.EXPECT().RecordDiscovery(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "new repositories are saved",
+			setupMocks: func(configService *services.MockConfigService) {
+				configService.EXPECT().GetAutoDiscover(gomock.Any()).Return(onStartup)
+				configService.EXPECT().LastDiscoveryTime(gomock.Any()).Return(time.Now().Add(-2 * time.Hour))
+				configService.EXPECT().DiscoverNewRepositories(gomock.Any(), []string{"/src"}, repositories.DefaultDiscoverMaxDepth).Return(discovered, nil)
+				configService.EXPECT().RecordDiscovery(gomock.Any(), gomock.Any()).Return(nil)
+				configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(false, nil)
+				configService.EXPECT().SaveConfig(gomock.Any()).Return(nil)
+			},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			configService := services.NewMockConfigService(ctrl)
+			loggerService := logger.NewMockService(ctrl)
+			loggerService.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			loggerService.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(configService)
+
+			uc := NewManageConfigUseCase(nil, configService, nil, loggerService, nil)
+
+			repos, err := uc.AutoDiscover(context.Background())
+			if err != nil {
+				t.Fatalf("AutoDiscover() error = %v", err)
+			}
+			if len(repos) != tt.expected {
+				t.Errorf("AutoDiscover() = %v, want %d repositories", repos, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// CleanInput represents input for cleaning the untracked files of repositories
+type CleanInput struct {
+	Groups    []string `json:"groups"`
+	Force     bool     `json:"force,omitempty"`
+	Confirmed bool     `json:"confirmed,omitempty"`
+}
+
+// RepositoryClean holds the untracked paths removed from a repository, or the ones that
+// would be removed in a dry run
+type RepositoryClean struct {
+	Repository string   `json:"repository"`
+	Paths      []string `json:"paths"`
+	DryRun     bool     `json:"dry_run,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// Clean lists the untracked files and directories git clean -fd would remove from each
+// repository of the groups, sorted by name. Only with Force are they removed, once the
+// repositories having some are confirmed. Repositories that cannot be cleaned are
+// reported with an error.
+func (uc *ExecuteCommandUseCase) Clean(ctx context.Context, input *CleanInput) ([]*RepositoryClean, error) {
+	uc.logger.Info(ctx, "Starting clean", "groups", input.Groups, "force", input.Force)
+
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	// The dry run comes first so that only the repositories with files to remove are confirmed
+	cleans := make([]*RepositoryClean, 0, len(repos))
+	var dirty []*entities.Repository
+	for _, repo := range repos {
+		clean := &RepositoryClean{Repository: repo.Name, DryRun: true}
+		cleans = append(cleans, clean)
+
+		paths, err := uc.gitRepo.CleanUntracked(ctx, repo, true)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to list untracked files", "repository", repo.Name, "error", err)
+			clean.Error = err.Error()
+			continue
+		}
+		clean.Paths = paths
+		if len(paths) > 0 {
+			dirty = append(dirty, repo)
+		}
+	}
+
+	if !input.Force || len(dirty) == 0 {
+		return cleans, nil
+	}
+
+	if !input.Confirmed {
+		command := entities.NewGitCommand([]string{"clean", "-fd"})
+		if err := uc.confirmDangerousCommand(ctx, command, dirty); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, repo := range repos {
+		clean := cleans[i]
+		clean.DryRun = false
+		if len(clean.Paths) == 0 {
+			continue
+		}
+
+		paths, err := uc.gitRepo.CleanUntracked(ctx, repo, false)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to clean untracked files", "repository", repo.Name, "error", err)
+			clean.Paths = nil
+			clean.Error = err.Error()
+			continue
+		}
+		clean.Paths = paths
+	}
+
+	return cleans, nil
+}
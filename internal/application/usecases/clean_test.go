@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	inputPort "github.com/qskkk/git-fleet/v2/internal/application/ports/input"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestClean(t *testing.T) {
+	tests := []struct {
+		name         string
+		force        bool
+		confirmed    bool
+		expectPrompt bool
+		answer       bool
+		expectClean  bool
+	}{
+		{name: "dry run by default"},
+		{name: "declined", force: true, expectPrompt: true},
+		{name: "accepted", force: true, expectPrompt: true, answer: true, expectClean: true},
+		{name: "confirmed with --yes", force: true, confirmed: true, expectClean: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := repositories.NewMockGitRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			confirmer := inputPort.NewMockConfirmationPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, nil, nil, logger, nil)
+			useCase.SetConfirmer(confirmer)
+
+			ctx := context.Background()
+			web := &entities.Repository{Name: "web"}
+			api := &entities.Repository{Name: "api"}
+			broken := &entities.Repository{Name: "broken"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, broken, api}, nil)
+			gitRepo.EXPECT().CleanUntracked(ctx, web, true).Return([]string{"build/", "notes.txt"}, nil)
+			gitRepo.EXPECT().CleanUntracked(ctx, api, true).Return([]string{}, nil)
+			gitRepo.EXPECT().CleanUntracked(ctx, broken, true).Return(nil, errors.New("not a git repository"))
+			if tt.expectPrompt {
+				confirmer.EXPECT().Confirm(ctx, "⚠️  About to run 'clean -fd' on 1 repositories").Return(tt.answer, nil)
+			}
+			if tt.expectClean {
+				gitRepo.EXPECT().CleanUntracked(ctx, web, false).Return([]string{"build/", "notes.txt"}, nil)
+			}
+
+			cleans, err := useCase.Clean(ctx, &CleanInput{Groups: []string{"all"}, Force: tt.force, Confirmed: tt.confirmed})
+			if tt.expectPrompt && !tt.answer {
+				if !gferrors.IsError(err, gferrors.ErrCommandNotConfirmed) {
+					t.Errorf("Expected ErrCommandNotConfirmed, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if len(cleans) != 3 || cleans[0].Repository != "api" || cleans[1].Repository != "broken" || cleans[2].Repository != "web" {
+				t.Fatalf("Expected the repositories sorted by name, got %+v", cleans)
+			}
+			if len(cleans[2].Paths) != 2 || cleans[2].DryRun != !tt.force {
+				t.Errorf("Expected web to list its 2 paths with dry run %v, got %+v", !tt.force, cleans[2])
+			}
+			if cleans[1].Error == "" {
+				t.Errorf("Expected broken to report its error, got %+v", cleans[1])
+			}
+		})
+	}
+}
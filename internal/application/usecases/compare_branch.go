@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// BranchComparison holds how many commits the current branch of a repository is ahead
+// and behind of another branch
+type BranchComparison struct {
+	Repository string `json:"repository"`
+	Ahead      int    `json:"ahead"`
+	Behind     int    `json:"behind"`
+	Skipped    string `json:"skipped,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// CompareBranch returns how many commits the current branch of each repository of the
+// groups is ahead and behind of the branch, sorted by name. Repositories without the
+// branch are skipped, while those whose counts cannot be read are reported with an error.
+func (uc *StatusReportUseCase) CompareBranch(ctx context.Context, groups []string, branch string) ([]*BranchComparison, error) {
+	if branch == "" || strings.HasPrefix(branch, "-") {
+		return nil, errors.ErrUsageCompareBranch
+	}
+
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	comparisons := make([]*BranchComparison, 0, len(repos))
+	for _, repo := range repos {
+		comparison := &BranchComparison{Repository: repo.Name}
+		comparisons = append(comparisons, comparison)
+
+		ahead, behind, err := uc.gitRepo.GetAheadBehindBranch(ctx, repo, branch)
+		switch {
+		case errors.IsError(err, errors.ErrBranchNotFound):
+			comparison.Skipped = NoSuchBranchReason + " " + branch
+		case err != nil:
+			uc.logger.Warn(ctx, "Failed to compare branch", "repository", repo.Name, "branch", branch, "error", err)
+			comparison.Error = err.Error()
+		default:
+			comparison.Ahead, comparison.Behind = ahead, behind
+		}
+	}
+
+	return comparisons, nil
+}
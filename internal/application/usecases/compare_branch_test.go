@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestStatusReportUseCase_CompareBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	legacy := &entities.Repository{Name: "legacy", Path: "/path/legacy"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, legacy, broken, api}, nil)
+	mockGitRepo.EXPECT().GetAheadBehindBranch(ctx, web, "main").Return(0, 4, nil)
+	mockGitRepo.EXPECT().GetAheadBehindBranch(ctx, api, "main").Return(3, 2, nil)
+	mockGitRepo.EXPECT().GetAheadBehindBranch(ctx, legacy, "main").Return(0, 0, errors.ErrBranchNotFound)
+	mockGitRepo.EXPECT().GetAheadBehindBranch(ctx, broken, "main").Return(0, 0, errors.ErrFailedToCompareBranch)
+	mockLogger.EXPECT().Warn(ctx, "Failed to compare branch", gomock.Any()).Times(1)
+
+	comparisons, err := usecase.CompareBranch(ctx, []string{"all"}, "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(comparisons) != 4 {
+		t.Fatalf("CompareBranch() returned %d repositories, want 4", len(comparisons))
+	}
+	gotAPI, gotBroken, gotLegacy, gotWeb := comparisons[0], comparisons[1], comparisons[2], comparisons[3]
+	if gotAPI.Repository != "api" || gotBroken.Repository != "broken" || gotLegacy.Repository != "legacy" || gotWeb.Repository != "web" {
+		t.Errorf("CompareBranch() should sort the repositories by name")
+	}
+	if gotAPI.Ahead != 3 || gotAPI.Behind != 2 || gotWeb.Ahead != 0 || gotWeb.Behind != 4 {
+		t.Errorf("CompareBranch() counts = api %d/%d, web %d/%d", gotAPI.Ahead, gotAPI.Behind, gotWeb.Ahead, gotWeb.Behind)
+	}
+	if gotLegacy.Skipped != NoSuchBranchReason+" main" || gotLegacy.Error != "" {
+		t.Errorf("repository without the branch should be skipped, got %+v", gotLegacy)
+	}
+	if gotBroken.Error == "" {
+		t.Error("repository that cannot be compared should report an error")
+	}
+}
+
+func TestStatusReportUseCase_CompareBranch_InvalidBranch(t *testing.T) {
+	usecase := &StatusReportUseCase{}
+
+	for _, branch := range []string{"", "--all"} {
+		if _, err := usecase.CompareBranch(context.Background(), []string{"all"}, branch); !errors.IsError(err, errors.ErrUsageCompareBranch) {
+			t.Errorf("CompareBranch(%q) error = %v, want ErrUsageCompareBranch", branch, err)
+		}
+	}
+}
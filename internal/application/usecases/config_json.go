@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+)
+
+// ResolvedConfig is the configuration as gf resolves it, printed by config show --json
+type ResolvedConfig struct {
+	Version        int                   `json:"version"`
+	Theme          string                `json:"theme,omitempty"`
+	BorderStyle    string                `json:"border_style,omitempty"`
+	SummaryMetrics []string              `json:"summary_metrics,omitempty"`
+	WorktreePath   string                `json:"worktree_path,omitempty"`
+	Repositories   []*ResolvedRepository `json:"repositories"`
+	Groups         []*ResolvedGroup      `json:"groups"`
+	Tags           map[string][]string   `json:"tags"`
+}
+
+// ResolvedRepository is a repository with its absolute path and parsed timeout
+type ResolvedRepository struct {
+	Name            string            `json:"name"`
+	Path            string            `json:"path"`
+	Description     string            `json:"description,omitempty"`
+	Remote          string            `json:"remote,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Timeout         string            `json:"timeout,omitempty"`
+	Env             map[string]string `json:"env,omitempty"`
+	DependsOn       []string          `json:"depends_on,omitempty"`
+	BlockedCommands []string          `json:"blocked_commands,omitempty"`
+}
+
+// ResolvedGroup is a group with the repositories it selects. Composed groups list the
+// members of their composition and Error is set when it cannot be resolved.
+type ResolvedGroup struct {
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	DefaultCommand string   `json:"default_command,omitempty"`
+	Composition    string   `json:"composition,omitempty"`
+	Repositories   []string `json:"repositories"`
+	Error          string   `json:"error,omitempty"`
+}
+
+// resolveConfig builds the resolved view of the configuration, with repositories and
+// groups sorted by name
+func resolveConfig(config *repositories.Config) *ResolvedConfig {
+	resolved := &ResolvedConfig{
+		Version:        config.Version,
+		Theme:          config.Theme,
+		BorderStyle:    config.BorderStyle,
+		SummaryMetrics: config.SummaryMetrics,
+		WorktreePath:   config.WorktreePath,
+		Repositories:   make([]*ResolvedRepository, 0, len(config.Repositories)),
+		Groups:         make([]*ResolvedGroup, 0, len(config.Groups)),
+		Tags:           make(map[string][]string),
+	}
+
+	repos := config.GetAllRepositories()
+	sortRepositories(repos, SortByName)
+	for _, repo := range repos {
+		resolved.Repositories = append(resolved.Repositories, resolveRepository(repo))
+		for _, tag := range repo.Tags {
+			resolved.Tags[tag] = append(resolved.Tags[tag], repo.Name)
+		}
+	}
+
+	names := config.GetGroupNames()
+	sort.Strings(names)
+	for _, name := range names {
+		resolved.Groups = append(resolved.Groups, resolveGroup(config, name, config.Groups[name]))
+	}
+
+	return resolved
+}
+
+// resolveRepository returns the resolved view of a repository
+func resolveRepository(repo *entities.Repository) *ResolvedRepository {
+	path, err := filepath.Abs(repo.Path)
+	if err != nil {
+		path = filepath.Clean(repo.Path)
+	}
+
+	resolved := &ResolvedRepository{
+		Name:            repo.Name,
+		Path:            path,
+		Description:     repo.Description,
+		Remote:          repo.Remote,
+		Tags:            repo.Tags,
+		Env:             repo.Env,
+		DependsOn:       repo.DependsOn,
+		BlockedCommands: repo.BlockedCommands,
+	}
+	if repo.Timeout > 0 {
+		resolved.Timeout = repo.Timeout.String()
+	}
+	return resolved
+}
+
+// resolveGroup returns the resolved view of the group configured under name
+func resolveGroup(config *repositories.Config, name string, group *entities.Group) *ResolvedGroup {
+	resolved := &ResolvedGroup{
+		Name:           name,
+		Description:    group.Description,
+		DefaultCommand: group.DefaultCommand,
+		Repositories:   []string{},
+	}
+	if group.IsComposed() {
+		resolved.Composition = group.Composition.String()
+	}
+
+	members, err := config.GroupRepositoryNames(name)
+	if err != nil {
+		resolved.Error = err.Error()
+		return resolved
+	}
+	if members != nil {
+		resolved.Repositories = members
+	}
+	return resolved
+}
+
+// formatResolvedConfig renders the resolved configuration as an indented JSON document
+func formatResolvedConfig(resolved *ResolvedConfig) (string, error) {
+	data, err := json.MarshalIndent(resolved, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// RepositoryMatchCount holds the number of lines of a repository matching a pattern
+type RepositoryMatchCount struct {
+	Repository string `json:"repository"`
+	Matches    int    `json:"matches"`
+	Error      string `json:"error,omitempty"`
+}
+
+// CountMatches counts the lines matching the pattern in the repositories of the groups
+// with git grep -c, sorted by repository name. Repositories without matches are returned
+// with 0, and repositories where git grep failed are reported with an error.
+func (uc *ExecuteCommandUseCase) CountMatches(ctx context.Context, groups []string, pattern string) ([]*RepositoryMatchCount, error) {
+	greps, err := uc.Grep(ctx, &GrepInput{Groups: groups, Pattern: pattern, Count: true})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]*RepositoryMatchCount, 0, len(greps))
+	for _, grep := range greps {
+		count := &RepositoryMatchCount{Repository: grep.Repository, Error: grep.Error}
+		for _, line := range grep.Matches {
+			count.Matches += parseGrepCount(line)
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, nil
+}
+
+// parseGrepCount returns the count of a "file:count" line of git grep -c, or 0 when
+// the line has none. The count follows the last colon since paths may contain colons.
+func parseGrepCount(line string) int {
+	index := strings.LastIndex(line, ":")
+	if index < 0 {
+		return 0
+	}
+	count, err := strconv.Atoi(line[index+1:])
+	if err != nil {
+		return 0
+	}
+	return count
+}
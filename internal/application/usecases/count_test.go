@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestCountMatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, newValidGitRepository(ctrl), executorRepo, configService, nil, nil, logger, nil)
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api"}
+	docs := &entities.Repository{Name: "docs"}
+	broken := &entities.Repository{Name: "broken"}
+
+	summary := entities.NewSummary()
+	matched := entities.NewExecutionResult("api", "git grep -c -e TODO")
+	matched.MarkAsSuccess("main.go:3\ncmd/a:b.go:9\n", 0)
+	summary.AddResult(*matched)
+	noMatch := entities.NewExecutionResult("docs", "git grep -c -e TODO")
+	noMatch.MarkAsFailed("", 1, "exit status 1")
+	summary.AddResult(*noMatch)
+	failed := entities.NewExecutionResult("broken", "git grep -c -e TODO")
+	failed.MarkAsFailed("fatal: not a git repository\n", 128, "exit status 128")
+	summary.AddResult(*failed)
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{docs, broken, api}, nil)
+	executorRepo.EXPECT().ExecuteInParallel(ctx, []*entities.Repository{api, broken, docs}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+			if got := strings.Join(cmd.Args, " "); got != "grep -c -e TODO" {
+				t.Errorf("Expected git grep -c -e TODO, got %s", got)
+			}
+			return summary, nil
+		})
+
+	counts, err := useCase.CountMatches(ctx, []string{"all"}, "TODO")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(counts) != 3 || counts[0].Repository != "api" || counts[1].Repository != "broken" || counts[2].Repository != "docs" {
+		t.Fatalf("Expected every repository sorted by name, got %+v", counts)
+	}
+	if counts[0].Matches != 12 {
+		t.Errorf("Expected api to count 12 matches, got %d", counts[0].Matches)
+	}
+	if counts[1].Error != "fatal: not a git repository" {
+		t.Errorf("Expected broken to report its error, got %+v", counts[1])
+	}
+	if counts[2].Error != "" || counts[2].Matches != 0 {
+		t.Errorf("Expected docs to count 0 matches without error, got %+v", counts[2])
+	}
+
+	if _, err := useCase.CountMatches(ctx, []string{"all"}, ""); !gferrors.IsError(err, gferrors.ErrUsageGrep) {
+		t.Errorf("Expected ErrUsageGrep without a pattern, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// GetDivergedRepositories returns the repositories of the given groups whose branch is
+// both ahead and behind its upstream, sorted by name. Repositories without an upstream
+// or that can simply be pushed or fast-forwarded are left out, while those whose counts
+// cannot be read are reported with an error.
+func (uc *StatusReportUseCase) GetDivergedRepositories(ctx context.Context, groups []string) ([]*DivergedRepository, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	var diverged []*DivergedRepository
+	for _, repo := range repos {
+		ahead, behind, err := uc.gitRepo.GetAheadBehind(ctx, repo)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get ahead/behind counts", "repository", repo.Name, "error", err)
+			diverged = append(diverged, &DivergedRepository{Repository: repo.Name, Error: err.Error()})
+			continue
+		}
+		if ahead > 0 && behind > 0 {
+			diverged = append(diverged, &DivergedRepository{Repository: repo.Name, Ahead: ahead, Behind: behind})
+		}
+	}
+
+	return diverged, nil
+}
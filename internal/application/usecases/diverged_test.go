@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+)
+
+func TestStatusReportUseCase_GetDivergedRepositories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	ahead := &entities.Repository{Name: "cli", Path: "/path/cli"}
+	local := &entities.Repository{Name: "local", Path: "/path/local"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, local, ahead, broken, api}, nil)
+	mockGitRepo.EXPECT().GetAheadBehind(ctx, web).Return(1, 4, nil)
+	mockGitRepo.EXPECT().GetAheadBehind(ctx, api).Return(3, 2, nil)
+	mockGitRepo.EXPECT().GetAheadBehind(ctx, ahead).Return(2, 0, nil)
+	mockGitRepo.EXPECT().GetAheadBehind(ctx, local).Return(0, 0, nil)
+	mockGitRepo.EXPECT().GetAheadBehind(ctx, broken).Return(0, 0, errors.New("unexpected rev-list output"))
+	mockLogger.EXPECT().Warn(ctx, "Failed to get ahead/behind counts", gomock.Any()).Times(1)
+
+	diverged, err := usecase.GetDivergedRepositories(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, repo := range diverged {
+		names = append(names, repo.Repository)
+	}
+	if len(diverged) != 3 || names[0] != "api" || names[1] != "broken" || names[2] != "web" {
+		t.Fatalf("GetDivergedRepositories() should return the diverged and unreadable repositories by name, got %v", names)
+	}
+	if diverged[0].Ahead != 3 || diverged[0].Behind != 2 {
+		t.Errorf("api counts = %d ahead, %d behind, want 3 and 2", diverged[0].Ahead, diverged[0].Behind)
+	}
+	if diverged[1].Error == "" {
+		t.Error("unreadable repository should report an error")
+	}
+}
@@ -3,9 +3,12 @@ package usecases
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/input"
 	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
@@ -24,6 +27,10 @@ type ExecuteCommandUseCase struct {
 	validationService services.ValidationService
 	logger            services.LoggingService
 	presenter         output.PresenterPort
+	confirmer         input.ConfirmationPort
+	prompter          input.PromptPort
+	notifier          output.NotifierPort
+	stateRepo         repositories.StateRepository
 }
 
 // NewExecuteCommandUseCase creates a new ExecuteCommandUseCase
@@ -49,13 +56,54 @@ func NewExecuteCommandUseCase(
 	}
 }
 
+// SetConfirmer sets the port used to confirm dangerous commands. Without one,
+// dangerous commands run without prompting.
+func (uc *ExecuteCommandUseCase) SetConfirmer(confirmer input.ConfirmationPort) {
+	uc.confirmer = confirmer
+}
+
+// SetPrompter sets the port used to ask for the commit message of --interactive-commit.
+// Without one, the message cannot be asked for.
+func (uc *ExecuteCommandUseCase) SetPrompter(prompter input.PromptPort) {
+	uc.prompter = prompter
+}
+
+// SetNotifier sets the port used to announce the end of executions run with Notify
+func (uc *ExecuteCommandUseCase) SetNotifier(notifier output.NotifierPort) {
+	uc.notifier = notifier
+}
+
+// SetStateRepository sets the store the last operation of each repository is recorded in.
+// Without one, operations are not recorded.
+func (uc *ExecuteCommandUseCase) SetStateRepository(stateRepo repositories.StateRepository) {
+	uc.stateRepo = stateRepo
+}
+
 // ExecuteCommandInput represents input for command execution
 type ExecuteCommandInput struct {
-	Groups       []string `json:"groups"`
-	CommandStr   string   `json:"command"`
-	Parallel     bool     `json:"parallel"`
-	AllowFailure bool     `json:"allow_failure"`
-	Timeout      int      `json:"timeout,omitempty"`
+	Groups       []string          `json:"groups"`
+	Repositories []string          `json:"repositories,omitempty"`
+	DirtyOnly    bool              `json:"dirty_only,omitempty"`
+	CommandStr   string            `json:"command"`
+	Parallel     bool              `json:"parallel"`
+	InOrder      bool              `json:"in_order,omitempty"`
+	RepoOrder    []string          `json:"repo_order,omitempty"`
+	Env          map[string]string `json:"env,omitempty"`
+	Notify       bool              `json:"notify,omitempty"`
+	AllowFailure bool              `json:"allow_failure"`
+	FailFast     bool              `json:"fail_fast,omitempty"`
+	Timeout      int               `json:"timeout,omitempty"`
+	Confirmed    bool              `json:"confirmed,omitempty"`
+	LogDir       string            `json:"log_dir,omitempty"`
+	OnBranch     string            `json:"on_branch,omitempty"`
+	SkipLocked   bool              `json:"skip_locked,omitempty"`
+	Retries      int               `json:"retries,omitempty"`
+	RetryOn      []string          `json:"retry_on,omitempty"`
+	CommitPrompt bool              `json:"commit_prompt,omitempty"`
+	GitJobs      int               `json:"git_jobs,omitempty"`
+	WorkDir      string            `json:"work_dir,omitempty"`
+	ForceUnsafe  bool              `json:"force_unsafe,omitempty"`
+	OnDirty      string            `json:"on_dirty,omitempty"`
 }
 
 // ExecuteCommandOutput represents output from command execution
@@ -87,6 +135,20 @@ func (uc *ExecuteCommandUseCase) Execute(ctx context.Context, input *ExecuteComm
 		command.Timeout = time.Duration(input.Timeout) * time.Second
 	}
 	command.AllowFailure = input.AllowFailure
+	command.FailFast = input.FailFast
+	command.Retries = input.Retries
+	command.RetryOn = input.RetryOn
+	command.Env = input.Env
+	command.WorkingDir = input.WorkDir
+
+	if input.CommitPrompt && (!command.IsGitCommand() || command.Subcommand() != "commit") {
+		return nil, errors.ErrUsageCommitPrompt
+	}
+
+	// Let git parallelize its own work, leaving commands that do not accept --jobs alone
+	if input.GitJobs > 0 && !command.SetGitJobs(input.GitJobs) {
+		uc.logger.Warn(ctx, "Ignoring --git-jobs for a command that does not accept it", "command", command.Subcommand())
+	}
 
 	// Validate command
 	if err := uc.validationService.ValidateCommand(ctx, command); err != nil {
@@ -106,6 +168,24 @@ func (uc *ExecuteCommandUseCase) Execute(ctx context.Context, input *ExecuteComm
 		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
 	}
 
+	// Restrict to the requested repositories, if any
+	if len(input.Repositories) > 0 {
+		repositories = filterRepositoriesByName(repositories, input.Repositories)
+	}
+
+	// Restrict to the repositories with uncommitted changes when they are the selection
+	if input.DirtyOnly {
+		_, repositories = uc.splitDirtyRepositories(ctx, repositories)
+		if len(repositories) == 0 {
+			uc.logger.Info(ctx, "No repositories with uncommitted changes", "groups", input.Groups)
+			return &ExecuteCommandOutput{
+				Summary:         entities.NewSummary(),
+				FormattedOutput: "No repositories with uncommitted changes",
+				Success:         true,
+			}, nil
+		}
+	}
+
 	if len(repositories) == 0 {
 		uc.logger.Warn(ctx, "No repositories found for specified groups", "groups", input.Groups)
 		return &ExecuteCommandOutput{
@@ -115,11 +195,119 @@ func (uc *ExecuteCommandUseCase) Execute(ctx context.Context, input *ExecuteComm
 		}, nil
 	}
 
+	// Leave out repositories whose path could make a broad command disastrous, unless
+	// running there anyway was requested and confirmed
+	safe, unsafe := uc.splitUnsafeRepositories(ctx, repositories)
+	switch {
+	case len(unsafe) > 0 && input.ForceUnsafe:
+		if !input.Confirmed {
+			if err := uc.confirmUnsafeRepositories(ctx, command, unsafe); err != nil {
+				return nil, err
+			}
+		}
+		unsafe = nil
+	case len(unsafe) > 0:
+		uc.logger.Warn(ctx, "Skipping repositories whose path is not a safe git repository", "repositories", len(unsafe))
+		repositories = safe
+	}
+
+	// Move the repositories given in the requested order to the front
+	if len(input.RepoOrder) > 0 {
+		var unknown []string
+		repositories, unknown = reorderRepositories(repositories, input.RepoOrder)
+		if len(unknown) > 0 {
+			uc.logger.Warn(ctx, "Ignoring unknown repositories in the requested order", "repositories", unknown)
+		}
+	}
+
+	// Leave out repositories whose configuration blocks this command
+	repositories, blocked := splitBlockedRepositories(repositories, command)
+	if len(blocked) > 0 {
+		uc.logger.Warn(ctx, "Skipping repositories that block the command", "command", command.Subcommand(), "repositories", len(blocked))
+	}
+
+	// Leave out repositories that are not on the requested branch
+	var offBranch []*entities.Repository
+	if input.OnBranch != "" {
+		repositories, offBranch = uc.splitOffBranchRepositories(ctx, repositories, input.OnBranch)
+	}
+
+	// Leave out repositories without the requested working directory
+	var noWorkDir []*entities.Repository
+	if input.WorkDir != "" {
+		repositories, noWorkDir = uc.splitMissingWorkDirRepositories(ctx, repositories, input.WorkDir)
+	}
+
+	// Leave out repositories locked by another git process when requested
+	var locked []*entities.Repository
+	if input.SkipLocked {
+		repositories, locked = uc.splitLockedRepositories(ctx, repositories)
+		if len(locked) > 0 {
+			uc.logger.Warn(ctx, "Skipping repositories locked by another process", "repositories", len(locked))
+		}
+	}
+
+	// Skip or stash around the repositories with uncommitted changes as the policy requests
+	var dirty []*entities.Repository
+	if appliesDirtyPolicy(command) {
+		switch input.OnDirty {
+		case OnDirtySkip:
+			repositories, dirty = uc.splitDirtyRepositories(ctx, repositories)
+		case OnDirtyStash:
+			command.SetAutostash()
+		}
+	}
+
+	// Leave out clean repositories when committing, where git would fail with nothing to commit
+	repositories, clean := uc.splitCleanRepositories(ctx, repositories, command)
+
+	// Ask once for the message of a commit given without one, committing only staged changes
+	var unstaged []*entities.Repository
+	if input.CommitPrompt && !hasCommitMessage(command) {
+		repositories, unstaged = uc.splitUnstagedRepositories(ctx, repositories, command)
+		if len(repositories) > 0 {
+			message, err := uc.promptCommitMessage(ctx, input.Confirmed)
+			if err != nil {
+				return nil, err
+			}
+			command.Args = append(command.Args, "-m", message)
+		}
+	}
+
+	// Run dependencies before the repositories depending on them
+	if input.InOrder {
+		repositories, err = entities.SortByDependencies(repositories)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to order repositories by dependency", err, "groups", input.Groups)
+			return nil, err
+		}
+	}
+
+	// Ask before running a dangerous command on the fleet
+	if len(repositories) > 0 && command.IsDangerous() && !input.Confirmed {
+		if err := uc.confirmDangerousCommand(ctx, command, repositories); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the log directory before running so that an unusable one is reported up front
+	if input.LogDir != "" {
+		if err := createExecutionLogDir(input.LogDir); err != nil {
+			uc.logger.Error(ctx, "Failed to create log directory", err, "path", input.LogDir)
+			return nil, errors.WrapExecutionLogError(input.LogDir, err)
+		}
+	}
+
 	// Execute command
-	var summary *entities.Summary
-	if input.Parallel {
+	summary := entities.NewSummary()
+	switch {
+	case len(repositories) == 0:
+		summary.Finalize()
+	case input.InOrder:
+		summary, err = uc.executorRepo.ExecuteInOrder(ctx, repositories, command)
+	case input.Parallel:
 		summary, err = uc.executorRepo.ExecuteInParallel(ctx, repositories, command)
-	} else {
+	default:
 		summary, err = uc.executorRepo.ExecuteSequential(ctx, repositories, command)
 	}
 
@@ -128,6 +316,17 @@ func (uc *ExecuteCommandUseCase) Execute(ctx context.Context, input *ExecuteComm
 		return nil, errors.WrapFailedToExecuteCommand(err)
 	}
 
+	for _, u := range unsafe {
+		addSkippedResults(summary, []*entities.Repository{u.repo}, command, u.reason)
+	}
+	addSkippedResults(summary, blocked, command, BlockedCommandReason)
+	addSkippedResults(summary, offBranch, command, NotOnBranchReason+" "+input.OnBranch)
+	addSkippedResults(summary, noWorkDir, command, NoWorkDirReason+" "+input.WorkDir)
+	addSkippedResults(summary, locked, command, LockedReason)
+	addSkippedResults(summary, dirty, command, UncommittedChangesReason)
+	addSkippedResults(summary, clean, command, NothingToCommitReason)
+	addSkippedResults(summary, unstaged, command, NothingStagedReason)
+
 	// Format output
 	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
 	if err != nil {
@@ -157,6 +356,16 @@ func (uc *ExecuteCommandUseCase) Execute(ctx context.Context, input *ExecuteComm
 		uc.logger.Debug(ctx, result.Output)
 	}
 
+	if input.LogDir != "" {
+		uc.writeExecutionLogs(ctx, input.LogDir, summary)
+	}
+
+	uc.recordLastOperations(ctx, summary)
+
+	if input.Notify {
+		uc.notifyCompletion(ctx, input.Groups, command, summary)
+	}
+
 	return &ExecuteCommandOutput{
 		Summary:         summary,
 		FormattedOutput: formattedOutput,
@@ -164,6 +373,549 @@ func (uc *ExecuteCommandUseCase) Execute(ctx context.Context, input *ExecuteComm
 	}, nil
 }
 
+// TagReleaseInput represents input for tagging a release across groups
+type TagReleaseInput struct {
+	Groups  []string `json:"groups"`
+	Version string   `json:"version"`
+	Message string   `json:"message,omitempty"`
+	NoPush  bool     `json:"no_push,omitempty"`
+}
+
+// TagRelease creates an annotated tag for the version in each repository of the groups
+// and pushes it to origin, unless NoPush is set. Repositories already having the tag are
+// skipped.
+func (uc *ExecuteCommandUseCase) TagRelease(ctx context.Context, input *TagReleaseInput) (*ExecuteCommandOutput, error) {
+	uc.logger.Info(ctx, "Starting release tagging", "groups", input.Groups, "version", input.Version)
+
+	if len(input.Groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+	if input.Version == "" || strings.HasPrefix(input.Version, "-") || strings.ContainsAny(input.Version, " \t") {
+		return nil, errors.ErrUsageTagRelease
+	}
+
+	repositories, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	command := tagReleaseCommand(input)
+
+	// Leave out repositories already having the tag. Repositories whose tags cannot be
+	// read are kept so that git reports the problem.
+	untagged := make([]*entities.Repository, 0, len(repositories))
+	var tagged []*entities.Repository
+	for _, repo := range repositories {
+		exists, err := uc.gitRepo.HasTag(ctx, repo, input.Version)
+		if err == nil && exists {
+			tagged = append(tagged, repo)
+		} else {
+			untagged = append(untagged, repo)
+		}
+	}
+
+	summary := entities.NewSummary()
+	if len(untagged) > 0 {
+		summary, err = uc.executorRepo.ExecuteInParallel(ctx, untagged, command)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to tag release", err, "version", input.Version, "repositories", len(untagged))
+			return nil, errors.WrapFailedToExecuteCommand(err)
+		}
+	} else {
+		summary.Finalize()
+	}
+
+	addSkippedResults(summary, tagged, command, AlreadyTaggedReason)
+
+	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to format output", err)
+		formattedOutput = "Error formatting output"
+	}
+
+	return &ExecuteCommandOutput{
+		Summary:         summary,
+		FormattedOutput: formattedOutput,
+		Success:         !summary.HasFailures(),
+	}, nil
+}
+
+// tagReleaseCommand returns the command creating the release tag, followed by its push
+// to origin unless NoPush is set
+func tagReleaseCommand(input *TagReleaseInput) *entities.Command {
+	message := input.Message
+	if message == "" {
+		message = "Release " + input.Version
+	}
+
+	// The message is quoted for the shell so that it reaches git as a single argument
+	script := fmt.Sprintf("git tag -a %s -m %s", quoteShellWord(input.Version), quoteShellWord(message))
+	if !input.NoPush {
+		// The push only runs once the tag exists
+		script += " && git push origin " + quoteShellWord("refs/tags/"+input.Version)
+	}
+	return entities.NewShellCommand([]string{script})
+}
+
+// quoteShellWord quotes a value for use as a single POSIX shell word
+func quoteShellWord(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// ResetToUpstreamInput represents input for resetting repositories to their upstream
+type ResetToUpstreamInput struct {
+	Groups    []string `json:"groups"`
+	Force     bool     `json:"force,omitempty"`
+	Confirmed bool     `json:"confirmed,omitempty"`
+}
+
+// ResetToUpstream fetches each repository of the groups and hard-resets it to its
+// upstream branch. Unless Force is set, repositories with uncommitted changes or with
+// commits ahead of their upstream are skipped, as the reset would lose that work.
+func (uc *ExecuteCommandUseCase) ResetToUpstream(ctx context.Context, input *ResetToUpstreamInput) (*ExecuteCommandOutput, error) {
+	uc.logger.Info(ctx, "Starting reset to upstream", "groups", input.Groups, "force", input.Force)
+
+	if len(input.Groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+
+	repositories, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	command := resetToUpstreamCommand()
+
+	resettable, protected := repositories, []*entities.Repository(nil)
+	if !input.Force {
+		resettable, protected = uc.splitLocalWorkRepositories(ctx, repositories)
+	}
+
+	if len(resettable) > 0 && !input.Confirmed {
+		if err := uc.confirmDangerousCommand(ctx, command, resettable); err != nil {
+			return nil, err
+		}
+	}
+
+	summary := entities.NewSummary()
+	if len(resettable) > 0 {
+		summary, err = uc.executorRepo.ExecuteInParallel(ctx, resettable, command)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to reset to upstream", err, "repositories", len(resettable))
+			return nil, errors.WrapFailedToExecuteCommand(err)
+		}
+	} else {
+		summary.Finalize()
+	}
+
+	addSkippedResults(summary, protected, command, WouldLoseLocalWorkReason)
+
+	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to format output", err)
+		formattedOutput = "Error formatting output"
+	}
+
+	return &ExecuteCommandOutput{
+		Summary:         summary,
+		FormattedOutput: formattedOutput,
+		Success:         !summary.HasFailures(),
+	}, nil
+}
+
+// resetToUpstreamCommand returns the command fetching the repository and hard-resetting
+// it to its upstream branch once the fetch succeeded
+func resetToUpstreamCommand() *entities.Command {
+	return entities.NewShellCommand([]string{"git fetch && git reset --hard @{u}"})
+}
+
+// splitLocalWorkRepositories separates the repositories with uncommitted changes or
+// commits ahead of their upstream from the others. Repositories whose state cannot be
+// read are protected too, since a reset could lose work there.
+func (uc *ExecuteCommandUseCase) splitLocalWorkRepositories(ctx context.Context, repositories []*entities.Repository) (resettable, protected []*entities.Repository) {
+	resettable = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		if uc.hasLocalWork(ctx, repo) {
+			protected = append(protected, repo)
+		} else {
+			resettable = append(resettable, repo)
+		}
+	}
+
+	return resettable, protected
+}
+
+// hasLocalWork reports whether a hard reset of the repository could lose local work
+func (uc *ExecuteCommandUseCase) hasLocalWork(ctx context.Context, repo *entities.Repository) bool {
+	hasChanges, err := uc.gitRepo.HasUncommittedChanges(ctx, repo)
+	if err != nil {
+		uc.logger.Debug(ctx, "Failed to check uncommitted changes", "repository", repo.Name, "error", err)
+		return true
+	}
+	if hasChanges {
+		return true
+	}
+
+	ahead, _, err := uc.gitRepo.GetAheadBehind(ctx, repo)
+	if err != nil {
+		uc.logger.Debug(ctx, "Failed to get ahead count", "repository", repo.Name, "error", err)
+		return true
+	}
+
+	return ahead > 0
+}
+
+// AmendInput represents input for amending the last commit across groups
+type AmendInput struct {
+	Groups    []string `json:"groups"`
+	Message   string   `json:"message,omitempty"`
+	Force     bool     `json:"force,omitempty"`
+	Confirmed bool     `json:"confirmed,omitempty"`
+}
+
+// Amend amends the last commit of each repository of the groups with the staged changes,
+// keeping its message unless Message is set. Repositories without commits, or with nothing
+// staged when the message is kept, are skipped. Unless Force is set, repositories whose
+// last commit is already on their upstream are skipped too, as amending rewrites it.
+func (uc *ExecuteCommandUseCase) Amend(ctx context.Context, input *AmendInput) (*ExecuteCommandOutput, error) {
+	uc.logger.Info(ctx, "Starting commit amend", "groups", input.Groups, "force", input.Force)
+
+	if len(input.Groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+
+	repositories, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	command := amendCommand(input.Message)
+
+	amendable := make([]*entities.Repository, 0, len(repositories))
+	skipped := make(map[string][]*entities.Repository)
+	for _, repo := range repositories {
+		if reason := uc.amendSkipReason(ctx, repo, input); reason != "" {
+			skipped[reason] = append(skipped[reason], repo)
+		} else {
+			amendable = append(amendable, repo)
+		}
+	}
+
+	if len(amendable) > 0 && !input.Confirmed {
+		if err := uc.confirmDangerousCommand(ctx, command, amendable); err != nil {
+			return nil, err
+		}
+	}
+
+	summary := entities.NewSummary()
+	if len(amendable) > 0 {
+		summary, err = uc.executorRepo.ExecuteInParallel(ctx, amendable, command)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to amend commits", err, "repositories", len(amendable))
+			return nil, errors.WrapFailedToExecuteCommand(err)
+		}
+	} else {
+		summary.Finalize()
+	}
+
+	for _, reason := range []string{NoCommitsReason, NothingStagedReason, AlreadyPushedReason} {
+		addSkippedResults(summary, skipped[reason], command, reason)
+	}
+
+	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to format output", err)
+		formattedOutput = "Error formatting output"
+	}
+
+	return &ExecuteCommandOutput{
+		Summary:         summary,
+		FormattedOutput: formattedOutput,
+		Success:         !summary.HasFailures(),
+	}, nil
+}
+
+// amendCommand returns the command amending the last commit, with a new message when given
+func amendCommand(message string) *entities.Command {
+	if message == "" {
+		return entities.NewGitCommand([]string{"commit", "--amend", "--no-edit"})
+	}
+	return entities.NewGitCommand([]string{"commit", "--amend", "-m", message})
+}
+
+// amendSkipReason returns why the last commit of the repository must not be amended,
+// or an empty string when it can be. Repositories without upstream have not pushed it.
+func (uc *ExecuteCommandUseCase) amendSkipReason(ctx context.Context, repo *entities.Repository, input *AmendInput) string {
+	if _, err := uc.gitRepo.GetLastCommit(ctx, repo); err != nil {
+		uc.logger.Debug(ctx, "Failed to get last commit", "repository", repo.Name, "error", err)
+		return NoCommitsReason
+	}
+
+	// A new message is worth amending even without staged changes
+	if input.Message == "" {
+		stat, err := uc.gitRepo.GetDiffStat(ctx, repo, true)
+		if err == nil && stat.FilesChanged == 0 {
+			return NothingStagedReason
+		}
+	}
+
+	if !input.Force {
+		ahead, _, err := uc.gitRepo.GetAheadBehind(ctx, repo)
+		if err == nil && ahead == 0 {
+			return AlreadyPushedReason
+		}
+	}
+
+	return ""
+}
+
+// GrepInput represents input for searching the repositories of groups
+type GrepInput struct {
+	Groups    []string `json:"groups"`
+	Pattern   string   `json:"pattern"`
+	FilesOnly bool     `json:"files_only,omitempty"`
+	Count     bool     `json:"count,omitempty"`
+}
+
+// RepositoryGrep holds the matches of a search in a repository: "file:line:text" lines,
+// file paths with FilesOnly or "file:count" lines with Count
+type RepositoryGrep struct {
+	Repository string   `json:"repository"`
+	Matches    []string `json:"matches"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// Grep runs git grep in the repositories of the groups and returns their matches, sorted
+// by repository name. Repositories without matches are returned with no matches, and
+// repositories where git grep failed are reported with an error.
+func (uc *ExecuteCommandUseCase) Grep(ctx context.Context, input *GrepInput) ([]*RepositoryGrep, error) {
+	uc.logger.Info(ctx, "Searching repositories", "groups", input.Groups, "pattern", input.Pattern)
+
+	if input.Pattern == "" {
+		return nil, errors.ErrUsageGrep
+	}
+
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	greps := make([]*RepositoryGrep, 0, len(repos))
+	if len(repos) == 0 {
+		return greps, nil
+	}
+
+	summary, err := uc.executorRepo.ExecuteInParallel(ctx, repos, grepCommand(input))
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to search repositories", err, "pattern", input.Pattern, "repositories", len(repos))
+		return nil, errors.WrapFailedToExecuteCommand(err)
+	}
+
+	results := make(map[string]entities.ExecutionResult, len(summary.Results))
+	for _, result := range summary.Results {
+		results[result.Repository] = result
+	}
+
+	for _, repo := range repos {
+		grep := &RepositoryGrep{Repository: repo.Name, Matches: []string{}}
+		greps = append(greps, grep)
+
+		result, ok := results[repo.Name]
+		switch {
+		case !ok:
+			grep.Error = "no result"
+		case result.IsSuccess():
+			grep.Matches = splitLines(result.Output)
+		case result.IsFailed() && result.ExitCode == 1 && strings.TrimSpace(result.ErrorOutput) == "":
+			// git grep exits with 1 when nothing matches
+		default:
+			grep.Error = strings.TrimSpace(result.ErrorOutput)
+			if grep.Error == "" {
+				grep.Error = result.ErrorMessage
+			}
+			uc.logger.Warn(ctx, "Failed to search repository", "repository", repo.Name, "error", grep.Error)
+		}
+	}
+
+	return greps, nil
+}
+
+// grepCommand returns the git grep command searching for the pattern, which is given
+// with -e so that patterns starting with a dash are not read as options
+func grepCommand(input *GrepInput) *entities.Command {
+	args := []string{"grep", "-n"}
+	switch {
+	case input.Count:
+		args = []string{"grep", "-c"}
+	case input.FilesOnly:
+		args = []string{"grep", "-l"}
+	}
+	return entities.NewGitCommand(append(args, "-e", input.Pattern))
+}
+
+// splitLines returns the non-empty lines of a command output
+func splitLines(output string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimRight(line, "\r"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// RepositoryPrune holds the remote-tracking branches pruned in a repository
+type RepositoryPrune struct {
+	Repository string   `json:"repository"`
+	Remote     string   `json:"remote"`
+	Pruned     []string `json:"pruned"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// IsClean returns true if there was nothing to prune
+func (p *RepositoryPrune) IsClean() bool {
+	return p.Error == "" && len(p.Pruned) == 0
+}
+
+// PruneRemotes deletes the stale remote-tracking branches of the configured remote of each
+// repository in the groups, sorted by name. Repositories that cannot be pruned are reported
+// with an error.
+func (uc *ExecuteCommandUseCase) PruneRemotes(ctx context.Context, groups []string) ([]*RepositoryPrune, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	prunes := make([]*RepositoryPrune, 0, len(repos))
+	for _, repo := range repos {
+		prune := &RepositoryPrune{Repository: repo.Name, Remote: repo.RemoteName()}
+		prunes = append(prunes, prune)
+
+		pruned, err := uc.gitRepo.PruneRemote(ctx, repo, prune.Remote)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to prune remote", "repository", repo.Name, "remote", prune.Remote, "error", err)
+			prune.Error = err.Error()
+			continue
+		}
+
+		prune.Pruned = pruned
+	}
+
+	return prunes, nil
+}
+
+// PruneTags fetches the configured remote of each repository in the groups with --prune
+// and --prune-tags, sorted by name, recording the local tags deleted because they no longer
+// exist on the remote. Repositories that cannot be fetched are reported with an error.
+func (uc *ExecuteCommandUseCase) PruneTags(ctx context.Context, groups []string) ([]*RepositoryPrune, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	prunes := make([]*RepositoryPrune, 0, len(repos))
+	for _, repo := range repos {
+		prune := &RepositoryPrune{Repository: repo.Name, Remote: repo.RemoteName()}
+		prunes = append(prunes, prune)
+
+		tags, err := uc.gitRepo.FetchPruneTags(ctx, repo, prune.Remote)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to prune tags", "repository", repo.Name, "remote", prune.Remote, "error", err)
+			prune.Error = err.Error()
+			continue
+		}
+
+		prune.Pruned = tags
+	}
+
+	return prunes, nil
+}
+
+// RepositoryBranchCleanup holds the merged local branches deleted in a repository,
+// or the ones that would be deleted in a dry run
+type RepositoryBranchCleanup struct {
+	Repository    string   `json:"repository"`
+	DefaultBranch string   `json:"default_branch,omitempty"`
+	Branches      []string `json:"branches"`
+	DryRun        bool     `json:"dry_run,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// CleanupBranches deletes the local branches merged into the default branch of each
+// repository in the groups, sorted by name. The default and current branches are kept.
+// With dryRun, the branches are only listed. Repositories that cannot be cleaned up are
+// reported with an error.
+func (uc *ExecuteCommandUseCase) CleanupBranches(ctx context.Context, groups []string, dryRun bool) ([]*RepositoryBranchCleanup, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	cleanups := make([]*RepositoryBranchCleanup, 0, len(repos))
+	for _, repo := range repos {
+		cleanup := &RepositoryBranchCleanup{Repository: repo.Name, DryRun: dryRun}
+		cleanups = append(cleanups, cleanup)
+
+		if err := uc.cleanupRepositoryBranches(ctx, repo, cleanup); err != nil {
+			uc.logger.Warn(ctx, "Failed to clean up branches", "repository", repo.Name, "error", err)
+			cleanup.Error = err.Error()
+		}
+	}
+
+	return cleanups, nil
+}
+
+// cleanupRepositoryBranches finds the merged branches of a repository and deletes them,
+// recording on the cleanup the ones deleted before any error
+func (uc *ExecuteCommandUseCase) cleanupRepositoryBranches(ctx context.Context, repo *entities.Repository, cleanup *RepositoryBranchCleanup) error {
+	defaultBranch, err := uc.gitRepo.GetDefaultBranch(ctx, repo)
+	if err != nil {
+		return err
+	}
+	cleanup.DefaultBranch = defaultBranch
+
+	current, err := uc.gitRepo.GetBranch(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	merged, err := uc.gitRepo.GetMergedBranches(ctx, repo, defaultBranch)
+	if err != nil {
+		return err
+	}
+
+	cleanup.Branches = []string{}
+	for _, branch := range merged {
+		if branch == defaultBranch || branch == current {
+			continue
+		}
+
+		if !cleanup.DryRun {
+			if err := uc.gitRepo.DeleteBranch(ctx, repo, branch); err != nil {
+				return err
+			}
+		}
+		cleanup.Branches = append(cleanup.Branches, branch)
+	}
+
+	return nil
+}
+
 // executeBuiltInCommand handles built-in commands
 func (uc *ExecuteCommandUseCase) executeBuiltInCommand(ctx context.Context, cmdName string, groups []string) (*ExecuteCommandOutput, error) {
 	output, err := uc.executionService.ExecuteBuiltInCommand(ctx, cmdName, groups)
@@ -183,6 +935,82 @@ func (uc *ExecuteCommandUseCase) executeBuiltInCommand(ctx context.Context, cmdN
 	}, nil
 }
 
+// confirmDangerousCommand asks the confirmer to accept the command and its targets
+func (uc *ExecuteCommandUseCase) confirmDangerousCommand(ctx context.Context, command *entities.Command, repositories []*entities.Repository) error {
+	if uc.confirmer == nil {
+		return nil
+	}
+
+	prompt := fmt.Sprintf("⚠️  About to run '%s' on %d repositories", command.GetFullCommand(), len(repositories))
+	confirmed, err := uc.confirmer.Confirm(ctx, prompt)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		uc.logger.Warn(ctx, "Dangerous command was not confirmed", "command", command.GetFullCommand())
+		return errors.ErrCommandNotConfirmed
+	}
+
+	return nil
+}
+
+// notifyCompletion sends a notification with the execution counts. Notifications are a
+// convenience, so failures are only logged.
+func (uc *ExecuteCommandUseCase) notifyCompletion(ctx context.Context, groups []string, command *entities.Command, summary *entities.Summary) {
+	if uc.notifier == nil {
+		return
+	}
+
+	title := fmt.Sprintf("GitFleet: %s on @%s", command.GetFullCommand(), strings.Join(groups, ", @"))
+	message := fmt.Sprintf("✅ %d succeeded, ❌ %d failed", summary.SuccessfulExecutions, summary.FailedExecutions)
+	if skipped := summary.SkippedCount(); skipped > 0 {
+		message += fmt.Sprintf(", ⏭️ %d skipped", skipped)
+	}
+
+	if err := uc.notifier.Notify(ctx, title, message); err != nil {
+		uc.logger.Debug(ctx, "Failed to send notification", "error", err)
+	}
+}
+
+// recordLastOperations records the command as the last operation of the repositories it
+// ran in, leaving the skipped and cancelled ones untouched. Failing to record is not an
+// execution failure.
+func (uc *ExecuteCommandUseCase) recordLastOperations(ctx context.Context, summary *entities.Summary) {
+	if uc.stateRepo == nil {
+		return
+	}
+
+	operations := make(map[string]*entities.LastOperation)
+	for _, result := range summary.Results {
+		if result.IsSkipped() || result.IsCancelled() {
+			continue
+		}
+		at := result.EndTime
+		if at.IsZero() {
+			at = result.StartTime
+		}
+		operations[result.Repository] = &entities.LastOperation{Command: result.Command, Time: at, Status: result.Status}
+	}
+	if len(operations) == 0 {
+		return
+	}
+
+	if err := uc.stateRepo.RecordOperations(ctx, operations); err != nil {
+		uc.logger.Warn(ctx, "Failed to record the last operations", "error", err)
+	}
+}
+
+// writeExecutionLogs writes the log file of each repository of the run. Files that cannot
+// be written are reported as warnings since the command already ran.
+func (uc *ExecuteCommandUseCase) writeExecutionLogs(ctx context.Context, dir string, summary *entities.Summary) {
+	for _, result := range summary.Results {
+		if err := writeExecutionLog(dir, result); err != nil {
+			uc.logger.Warn(ctx, "Failed to write execution log", "repository", result.Repository, "error", err)
+		}
+	}
+}
+
 // validateInput validates the command execution input
 func (uc *ExecuteCommandUseCase) validateInput(input *ExecuteCommandInput) error {
 	if len(input.Groups) == 0 {
@@ -200,6 +1028,298 @@ func (uc *ExecuteCommandUseCase) validateInput(input *ExecuteCommandInput) error
 	return nil
 }
 
+// Reasons recorded on the results of skipped repositories
+const (
+	BlockedCommandReason     = "command blocked by config"
+	NothingToCommitReason    = "nothing to commit"
+	AlreadyTaggedReason      = "already tagged"
+	NotOnBranchReason        = "not on branch"
+	WouldLoseLocalWorkReason = "would lose local work"
+	WorktreeExistsReason     = "worktree already exists"
+	NoCommitsReason          = "no commits"
+	NothingStagedReason      = "nothing staged"
+	AlreadyPushedReason      = "already pushed"
+	LockedReason             = "locked by another process"
+	NoSuchRemoteReason       = "no such remote"
+	NoSuchBranchReason       = "no such branch"
+	NoSubmodulesReason       = "no submodules"
+	NoWorkDirReason          = "no directory"
+	RootPathReason           = "path is a filesystem root"
+	HomePathReason           = "path is the home directory"
+	NotAGitRepositoryReason  = entities.NotAGitRepositoryMessage
+	UncommittedChangesReason = "uncommitted changes"
+	PatchDoesNotApplyReason  = "patch does not apply"
+)
+
+// splitBlockedRepositories separates the repositories that block the command from the others
+func splitBlockedRepositories(repositories []*entities.Repository, command *entities.Command) (allowed, blocked []*entities.Repository) {
+	allowed = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		if repo.IsCommandBlocked(command) {
+			blocked = append(blocked, repo)
+		} else {
+			allowed = append(allowed, repo)
+		}
+	}
+
+	return allowed, blocked
+}
+
+// splitOffBranchRepositories separates the repositories whose current branch is not the
+// given branch from the others. Repositories whose branch cannot be read are left out too.
+func (uc *ExecuteCommandUseCase) splitOffBranchRepositories(ctx context.Context, repositories []*entities.Repository, branch string) (onBranch, offBranch []*entities.Repository) {
+	onBranch = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		current, err := uc.gitRepo.GetBranch(ctx, repo)
+		if err != nil {
+			uc.logger.Debug(ctx, "Failed to get current branch", "repository", repo.Name, "error", err)
+		}
+		if err == nil && current == branch {
+			onBranch = append(onBranch, repo)
+		} else {
+			offBranch = append(offBranch, repo)
+		}
+	}
+
+	return onBranch, offBranch
+}
+
+// unsafeRepository is a repository that commands must not run in, with the reason why
+type unsafeRepository struct {
+	repo   *entities.Repository
+	reason string
+}
+
+// splitUnsafeRepositories separates the repositories whose path is a filesystem root,
+// the home directory or not a git repository from the others, which a broken
+// configuration could otherwise turn into a command run over a whole disk
+func (uc *ExecuteCommandUseCase) splitUnsafeRepositories(ctx context.Context, repositories []*entities.Repository) (safe []*entities.Repository, unsafe []unsafeRepository) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		uc.logger.Debug(ctx, "Failed to get home directory", "error", err)
+	}
+
+	safe = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		if reason := uc.unsafePathReason(ctx, repo, home); reason != "" {
+			unsafe = append(unsafe, unsafeRepository{repo: repo, reason: reason})
+		} else {
+			safe = append(safe, repo)
+		}
+	}
+
+	return safe, unsafe
+}
+
+// unsafePathReason returns why commands must not run in the repository path, or an empty
+// string when they can
+func (uc *ExecuteCommandUseCase) unsafePathReason(ctx context.Context, repo *entities.Repository, home string) string {
+	path := filepath.Clean(repo.Path)
+	switch {
+	case filepath.IsAbs(path) && filepath.Dir(path) == path:
+		return RootPathReason
+	case home != "" && path == filepath.Clean(home):
+		return HomePathReason
+	case !uc.gitRepo.IsValidRepository(ctx, repo.Path):
+		return NotAGitRepositoryReason
+	}
+	return ""
+}
+
+// confirmUnsafeRepositories asks the confirmer to accept running the command in
+// repositories whose path is not a safe git repository
+func (uc *ExecuteCommandUseCase) confirmUnsafeRepositories(ctx context.Context, command *entities.Command, unsafe []unsafeRepository) error {
+	if uc.confirmer == nil {
+		return nil
+	}
+
+	paths := make([]string, 0, len(unsafe))
+	for _, u := range unsafe {
+		paths = append(paths, fmt.Sprintf("%s (%s)", u.repo.Path, u.reason))
+	}
+
+	prompt := fmt.Sprintf("⚠️  About to run '%s' in unsafe paths: %s", command.GetFullCommand(), strings.Join(paths, ", "))
+	confirmed, err := uc.confirmer.Confirm(ctx, prompt)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		uc.logger.Warn(ctx, "Running in unsafe paths was not confirmed", "command", command.GetFullCommand())
+		return errors.ErrCommandNotConfirmed
+	}
+
+	return nil
+}
+
+// splitMissingWorkDirRepositories separates the repositories lacking the working
+// directory, relative to their path, from the others
+func (uc *ExecuteCommandUseCase) splitMissingWorkDirRepositories(ctx context.Context, repositories []*entities.Repository, workDir string) (found, missing []*entities.Repository) {
+	found = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		if uc.gitRepo.IsValidDirectory(ctx, filepath.Join(repo.Path, workDir)) {
+			found = append(found, repo)
+		} else {
+			missing = append(missing, repo)
+		}
+	}
+
+	return found, missing
+}
+
+// splitLockedRepositories separates the repositories holding an index.lock or HEAD.lock
+// from the others. Repositories whose lock files cannot be checked are kept so that git
+// reports the problem.
+func (uc *ExecuteCommandUseCase) splitLockedRepositories(ctx context.Context, repositories []*entities.Repository) (unlocked, locked []*entities.Repository) {
+	unlocked = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		lockFile, err := uc.gitRepo.GetLockFile(ctx, repo)
+		if err != nil {
+			uc.logger.Debug(ctx, "Failed to check lock files", "repository", repo.Name, "error", err)
+		}
+		if err == nil && lockFile != "" {
+			locked = append(locked, repo)
+		} else {
+			unlocked = append(unlocked, repo)
+		}
+	}
+
+	return unlocked, locked
+}
+
+// splitCleanRepositories separates the repositories without changes from the others
+// for commit commands, unless --allow-empty is given. Repositories whose changes
+// cannot be read are kept so that git reports the problem.
+func (uc *ExecuteCommandUseCase) splitCleanRepositories(ctx context.Context, repositories []*entities.Repository, command *entities.Command) (dirty, clean []*entities.Repository) {
+	if command.Subcommand() != "commit" || command.HasOption("--allow-empty") {
+		return repositories, nil
+	}
+
+	dirty = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		hasChanges, err := uc.gitRepo.HasUncommittedChanges(ctx, repo)
+		if err == nil && !hasChanges {
+			clean = append(clean, repo)
+		} else {
+			dirty = append(dirty, repo)
+		}
+	}
+
+	return dirty, clean
+}
+
+// commitMessageOptions are the commit options giving the message of the commit
+var commitMessageOptions = []string{"-m", "--message", "-F", "--file", "-C", "--reuse-message"}
+
+// hasCommitMessage reports whether the commit command is given its message
+func hasCommitMessage(command *entities.Command) bool {
+	for _, option := range commitMessageOptions {
+		if command.HasOption(option) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitUnstagedRepositories separates the repositories without staged changes from the
+// others, unless the commit stages the changes itself with -a. Repositories whose
+// changes cannot be read are kept so that git reports the problem.
+func (uc *ExecuteCommandUseCase) splitUnstagedRepositories(ctx context.Context, repositories []*entities.Repository, command *entities.Command) (staged, unstaged []*entities.Repository) {
+	if command.HasOption("-a") || command.HasOption("--all") {
+		return repositories, nil
+	}
+
+	staged = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		stat, err := uc.gitRepo.GetDiffStat(ctx, repo, true)
+		if err == nil && stat.FilesChanged == 0 {
+			unstaged = append(unstaged, repo)
+		} else {
+			staged = append(staged, repo)
+		}
+	}
+
+	return staged, unstaged
+}
+
+// promptCommitMessage asks the prompter for a commit message. It fails when the message
+// cannot be asked for, with --yes or without a terminal, or when none is typed.
+func (uc *ExecuteCommandUseCase) promptCommitMessage(ctx context.Context, confirmed bool) (string, error) {
+	if confirmed || uc.prompter == nil {
+		return "", errors.ErrNoCommitMessage
+	}
+
+	message, err := uc.prompter.Prompt(ctx, "📝 Commit message")
+	if err != nil {
+		uc.logger.Debug(ctx, "Failed to read commit message", "error", err)
+		return "", errors.ErrNoCommitMessage
+	}
+	if message == "" {
+		return "", errors.ErrNoCommitMessage
+	}
+
+	return message, nil
+}
+
+// addSkippedResults records the repositories as skipped in the summary
+func addSkippedResults(summary *entities.Summary, repositories []*entities.Repository, command *entities.Command, reason string) {
+	for _, repo := range repositories {
+		result := entities.NewExecutionResult(repo.Name, command.GetFullCommand())
+		result.MarkAsSkipped(reason)
+		summary.AddResult(*result)
+	}
+}
+
+// filterRepositoriesByName keeps only the repositories whose name is in names
+func filterRepositoriesByName(repositories []*entities.Repository, names []string) []*entities.Repository {
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	filtered := make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		if wanted[repo.Name] {
+			filtered = append(filtered, repo)
+		}
+	}
+
+	return filtered
+}
+
+// reorderRepositories moves the repositories named in order to the front, in that order,
+// followed by the others in their original order. It also returns the names in order
+// that match none of the repositories.
+func reorderRepositories(repositories []*entities.Repository, order []string) (ordered []*entities.Repository, unknown []string) {
+	byName := make(map[string]*entities.Repository, len(repositories))
+	for _, repo := range repositories {
+		byName[repo.Name] = repo
+	}
+
+	ordered = make([]*entities.Repository, 0, len(repositories))
+	placed := make(map[string]bool, len(order))
+	for _, name := range order {
+		repo, exists := byName[name]
+		if !exists {
+			unknown = append(unknown, name)
+			continue
+		}
+		if placed[name] {
+			continue
+		}
+		ordered = append(ordered, repo)
+		placed[name] = true
+	}
+
+	for _, repo := range repositories {
+		if !placed[repo.Name] {
+			ordered = append(ordered, repo)
+		}
+	}
+
+	return ordered, unknown
+}
+
 // GetAvailableCommands returns available commands
 func (uc *ExecuteCommandUseCase) GetAvailableCommands(ctx context.Context) ([]string, error) {
 	return uc.executionService.GetAvailableCommands(ctx)
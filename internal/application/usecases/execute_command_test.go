@@ -3,23 +3,38 @@ package usecases
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 
 	"go.uber.org/mock/gomock"
 
+	inputPort "github.com/qskkk/git-fleet/v2/internal/application/ports/input"
 	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 	loggerPkg "github.com/qskkk/git-fleet/v2/internal/pkg/logger"
 )
 
+// newValidGitRepository returns a git repository mock for which every path is a git
+// repository, so that executions are not skipped as unsafe
+func newValidGitRepository(ctrl *gomock.Controller) *repositories.MockGitRepository {
+	gitRepo := repositories.NewMockGitRepository(ctrl)
+	gitRepo.EXPECT().IsValidRepository(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	return gitRepo
+}
+
 func TestNewExecuteCommandUseCase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	configRepo := repositories.NewMockConfigRepository(ctrl)
-	gitRepo := repositories.NewMockGitRepository(ctrl)
+	gitRepo := newValidGitRepository(ctrl)
 	executorRepo := repositories.NewMockExecutorRepository(ctrl)
 	configService := services.NewMockConfigService(ctrl)
 	executionService := services.NewMockExecutionService(ctrl)
@@ -48,7 +63,7 @@ func TestExecuteCommand_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	configRepo := repositories.NewMockConfigRepository(ctrl)
-	gitRepo := repositories.NewMockGitRepository(ctrl)
+	gitRepo := newValidGitRepository(ctrl)
 	executorRepo := repositories.NewMockExecutorRepository(ctrl)
 	configService := services.NewMockConfigService(ctrl)
 	executionService := services.NewMockExecutionService(ctrl)
@@ -110,12 +125,82 @@ func TestExecuteCommand_Success(t *testing.T) {
 	}
 }
 
+func TestExecuteCommand_DangerousCommandConfirmation(t *testing.T) {
+	tests := []struct {
+		name          string
+		confirmed     bool
+		expectPrompt  bool
+		answer        bool
+		expectExecute bool
+	}{
+		{name: "declined", expectPrompt: true, answer: false, expectExecute: false},
+		{name: "accepted", expectPrompt: true, answer: true, expectExecute: true},
+		{name: "confirmed with --yes", confirmed: true, expectExecute: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+			confirmer := inputPort.NewMockConfirmationPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, newValidGitRepository(ctrl), executorRepo, configService, executionService, validationService, logger, presenter)
+			useCase.SetConfirmer(confirmer)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{
+				Groups:     []string{"test-group"},
+				CommandStr: "reset --hard",
+				Confirmed:  tt.confirmed,
+			}
+			cmd := entities.NewGitCommand([]string{"reset", "--hard"})
+			repos := []*entities.Repository{
+				{Name: "repo1", Path: "/path/to/repo1"},
+				{Name: "repo2", Path: "/path/to/repo2"},
+			}
+			summary := entities.NewSummary()
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, "reset --hard").Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("reset").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"test-group"}).Return(repos, nil)
+
+			if tt.expectPrompt {
+				confirmer.EXPECT().Confirm(ctx, "⚠️  About to run 'reset --hard' on 2 repositories").Return(tt.answer, nil)
+			}
+			if tt.expectExecute {
+				executorRepo.EXPECT().ExecuteSequential(ctx, repos, cmd).Return(summary, nil)
+				presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+			}
+
+			_, err := useCase.Execute(ctx, input)
+
+			if tt.expectExecute && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if !tt.expectExecute && !gferrors.IsError(err, gferrors.ErrCommandNotConfirmed) {
+				t.Errorf("Expected ErrCommandNotConfirmed, got %v", err)
+			}
+		})
+	}
+}
+
 func TestExecuteCommand_ParseError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	configRepo := repositories.NewMockConfigRepository(ctrl)
-	gitRepo := repositories.NewMockGitRepository(ctrl)
+	gitRepo := newValidGitRepository(ctrl)
 	executorRepo := repositories.NewMockExecutorRepository(ctrl)
 	configService := services.NewMockConfigService(ctrl)
 	executionService := services.NewMockExecutionService(ctrl)
@@ -157,7 +242,7 @@ func TestExecuteCommand_NoRepositoriesFound(t *testing.T) {
 	defer ctrl.Finish()
 
 	configRepo := repositories.NewMockConfigRepository(ctrl)
-	gitRepo := repositories.NewMockGitRepository(ctrl)
+	gitRepo := newValidGitRepository(ctrl)
 	executorRepo := repositories.NewMockExecutorRepository(ctrl)
 	configService := services.NewMockConfigService(ctrl)
 	executionService := services.NewMockExecutionService(ctrl)
@@ -211,3 +296,1441 @@ func TestExecuteCommand_NoRepositoriesFound(t *testing.T) {
 		t.Errorf("Expected 'No repositories found for specified groups', got %s", output.FormattedOutput)
 	}
 }
+
+func TestFilterRepositoriesByName(t *testing.T) {
+	repos := []*entities.Repository{
+		{Name: "repo1", Path: "/path/to/repo1"},
+		{Name: "repo2", Path: "/path/to/repo2"},
+		{Name: "repo3", Path: "/path/to/repo3"},
+	}
+
+	filtered := filterRepositoriesByName(repos, []string{"repo3", "repo1", "unknown"})
+
+	if len(filtered) != 2 {
+		t.Fatalf("Expected 2 repositories, got %d", len(filtered))
+	}
+	if filtered[0].Name != "repo1" || filtered[1].Name != "repo3" {
+		t.Errorf("Expected [repo1 repo3] in original order, got [%s %s]", filtered[0].Name, filtered[1].Name)
+	}
+}
+
+func TestExecuteCommand_BlockedRepositoriesAreSkipped(t *testing.T) {
+	tests := []struct {
+		name          string
+		repos         []*entities.Repository
+		expectAllowed []string
+		expectSkipped int
+	}{
+		{
+			name: "blocked repository is left out",
+			repos: []*entities.Repository{
+				{Name: "app", Path: "/path/to/app"},
+				{Name: "mirror", Path: "/path/to/mirror", BlockedCommands: []string{"push"}},
+			},
+			expectAllowed: []string{"app"},
+			expectSkipped: 1,
+		},
+		{
+			name: "all repositories blocked",
+			repos: []*entities.Repository{
+				{Name: "mirror", Path: "/path/to/mirror", BlockedCommands: []string{"push"}},
+			},
+			expectSkipped: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, newValidGitRepository(ctrl), executorRepo, configService, executionService, validationService, logger, presenter)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{
+				Groups:     []string{"test-group"},
+				CommandStr: "push",
+			}
+			cmd := entities.NewGitCommand([]string{"push"})
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, "push").Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("push").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"test-group"}).Return(tt.repos, nil)
+			presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+			// The executor must never see a blocked repository
+			if len(tt.expectAllowed) > 0 {
+				executorRepo.EXPECT().ExecuteSequential(ctx, gomock.Any(), cmd).DoAndReturn(
+					func(_ context.Context, repos []*entities.Repository, _ *entities.Command) (*entities.Summary, error) {
+						if len(repos) != len(tt.expectAllowed) || repos[0].Name != tt.expectAllowed[0] {
+							t.Errorf("executor called with %v, want %v", repos, tt.expectAllowed)
+						}
+						return entities.NewSummary(), nil
+					})
+			}
+
+			result, err := useCase.Execute(ctx, input)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got := result.Summary.SkippedCount(); got != tt.expectSkipped {
+				t.Errorf("SkippedCount() = %d, want %d", got, tt.expectSkipped)
+			}
+			for _, res := range result.Summary.Results {
+				if res.IsSkipped() && res.ErrorMessage != BlockedCommandReason {
+					t.Errorf("skipped result message = %q, want %q", res.ErrorMessage, BlockedCommandReason)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_CommitSkipsCleanRepositories(t *testing.T) {
+	tests := []struct {
+		name          string
+		commandStr    string
+		expectChecks  bool
+		expectAllowed int
+		expectSkipped int
+	}{
+		{name: "clean repository is skipped", commandStr: "commit -a -m fix", expectChecks: true, expectAllowed: 1, expectSkipped: 1},
+		{name: "allow-empty bypasses the check", commandStr: "commit --allow-empty -m empty", expectAllowed: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := newValidGitRepository(ctrl)
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{Groups: []string{"test-group"}, CommandStr: tt.commandStr}
+			cmd := entities.NewGitCommand(strings.Fields(tt.commandStr))
+			dirty := &entities.Repository{Name: "dirty", Path: "/path/to/dirty"}
+			clean := &entities.Repository{Name: "clean", Path: "/path/to/clean"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, tt.commandStr).Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("commit").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"test-group"}).Return([]*entities.Repository{dirty, clean}, nil)
+			presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+			if tt.expectChecks {
+				gitRepo.EXPECT().HasUncommittedChanges(ctx, dirty).Return(true, nil)
+				gitRepo.EXPECT().HasUncommittedChanges(ctx, clean).Return(false, nil)
+			}
+
+			executorRepo.EXPECT().ExecuteSequential(ctx, gomock.Any(), cmd).DoAndReturn(
+				func(_ context.Context, repos []*entities.Repository, _ *entities.Command) (*entities.Summary, error) {
+					if len(repos) != tt.expectAllowed {
+						t.Errorf("executor called with %d repositories, want %d", len(repos), tt.expectAllowed)
+					}
+					return entities.NewSummary(), nil
+				})
+
+			result, err := useCase.Execute(ctx, input)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got := result.Summary.SkippedCount(); got != tt.expectSkipped {
+				t.Errorf("SkippedCount() = %d, want %d", got, tt.expectSkipped)
+			}
+			if result.Summary.HasFailures() {
+				t.Error("clean repositories should not be reported as failures")
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_InOrder(t *testing.T) {
+	tests := []struct {
+		name          string
+		repos         []*entities.Repository
+		expectedOrder []string
+		expectedErr   error
+	}{
+		{
+			name: "dependencies are executed first",
+			repos: []*entities.Repository{
+				{Name: "app", DependsOn: []string{"lib"}},
+				{Name: "lib"},
+			},
+			expectedOrder: []string{"lib", "app"},
+		},
+		{
+			name: "cycle is rejected",
+			repos: []*entities.Repository{
+				{Name: "app", DependsOn: []string{"lib"}},
+				{Name: "lib", DependsOn: []string{"app"}},
+			},
+			expectedErr: gferrors.ErrDependencyCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, newValidGitRepository(ctrl), executorRepo, configService, executionService, validationService, logger, presenter)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{Groups: []string{"test-group"}, CommandStr: "pull", InOrder: true}
+			cmd := entities.NewGitCommand([]string{"pull"})
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, "pull").Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("pull").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"test-group"}).Return(tt.repos, nil)
+
+			if tt.expectedErr == nil {
+				presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+				executorRepo.EXPECT().ExecuteInOrder(ctx, gomock.Any(), cmd).DoAndReturn(
+					func(_ context.Context, repos []*entities.Repository, _ *entities.Command) (*entities.Summary, error) {
+						for i, name := range tt.expectedOrder {
+							if repos[i].Name != name {
+								t.Errorf("executor called with %s at %d, want %s", repos[i].Name, i, name)
+							}
+						}
+						return entities.NewSummary(), nil
+					})
+			}
+
+			_, err := useCase.Execute(ctx, input)
+			if tt.expectedErr != nil {
+				if !gferrors.IsError(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReorderRepositories(t *testing.T) {
+	repos := []*entities.Repository{{Name: "api"}, {Name: "docs"}, {Name: "web"}, {Name: "cli"}}
+
+	tests := []struct {
+		name            string
+		order           []string
+		expectedOrder   []string
+		expectedUnknown []string
+	}{
+		{name: "listed repositories come first", order: []string{"web", "cli"}, expectedOrder: []string{"web", "cli", "api", "docs"}},
+		{name: "unknown names are reported", order: []string{"missing", "docs"}, expectedOrder: []string{"docs", "api", "web", "cli"}, expectedUnknown: []string{"missing"}},
+		{name: "duplicates are placed once", order: []string{"cli", "cli"}, expectedOrder: []string{"cli", "api", "docs", "web"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, unknown := reorderRepositories(repos, tt.order)
+
+			names := make([]string, len(ordered))
+			for i, repo := range ordered {
+				names[i] = repo.Name
+			}
+			if strings.Join(names, ",") != strings.Join(tt.expectedOrder, ",") {
+				t.Errorf("reorderRepositories() order = %v, want %v", names, tt.expectedOrder)
+			}
+			if strings.Join(unknown, ",") != strings.Join(tt.expectedUnknown, ",") {
+				t.Errorf("reorderRepositories() unknown = %v, want %v", unknown, tt.expectedUnknown)
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_Notify(t *testing.T) {
+	tests := []struct {
+		name        string
+		notify      bool
+		notifierErr error
+	}{
+		{name: "notification sent", notify: true},
+		{name: "notifier failure is ignored", notify: true, notifierErr: gferrors.ErrNotifierUnavailable},
+		{name: "no notification without the option", notify: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+			notifier := output.NewMockNotifierPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, newValidGitRepository(ctrl), executorRepo, configService, executionService, validationService, logger, presenter)
+			useCase.SetNotifier(notifier)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{Groups: []string{"api", "web"}, CommandStr: "fetch", Notify: tt.notify}
+			cmd := entities.NewGitCommand([]string{"fetch"})
+			repos := []*entities.Repository{{Name: "repo1"}}
+
+			summary := entities.NewSummary()
+			success := entities.NewExecutionResult("repo1", "git fetch")
+			success.MarkAsSuccess("", 0)
+			summary.AddResult(*success)
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, "fetch").Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("fetch").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"api", "web"}).Return(repos, nil)
+			executorRepo.EXPECT().ExecuteSequential(ctx, repos, cmd).Return(summary, nil)
+			presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+
+			if tt.notify {
+				notifier.EXPECT().Notify(ctx, "GitFleet: fetch on @api, @web", "✅ 1 succeeded, ❌ 0 failed").Return(tt.notifierErr)
+			}
+
+			if _, err := useCase.Execute(ctx, input); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_LogDir(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	executionService := services.NewMockExecutionService(ctrl)
+	validationService := services.NewMockValidationService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, newValidGitRepository(ctrl), executorRepo, configService, executionService, validationService, logger, presenter)
+
+	ctx := context.Background()
+	logDir := filepath.Join(t.TempDir(), "logs")
+	input := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "fetch", LogDir: logDir}
+	cmd := entities.NewGitCommand([]string{"fetch"})
+	repos := []*entities.Repository{{Name: "org/api"}, {Name: "web"}}
+
+	summary := entities.NewSummary()
+	for _, repo := range repos {
+		result := entities.NewExecutionResult(repo.Name, "git fetch")
+		result.MarkAsSuccess("fetched "+repo.Name, 0)
+		summary.AddResult(*result)
+	}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+	executionService.EXPECT().ParseCommand(ctx, "fetch").Return(cmd, nil)
+	validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+	executionService.EXPECT().IsBuiltInCommand("fetch").Return(false)
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return(repos, nil)
+	executorRepo.EXPECT().ExecuteSequential(ctx, repos, cmd).Return(summary, nil)
+	presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+
+	if _, err := useCase.Execute(ctx, input); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for file, output := range map[string]string{"org_api.log": "fetched org/api", "web.log": "fetched web"} {
+		data, err := os.ReadFile(filepath.Join(logDir, file))
+		if err != nil {
+			t.Fatalf("Expected %s to be written: %v", file, err)
+		}
+		if !strings.Contains(string(data), output) {
+			t.Errorf("Expected %s to contain %q, got:\n%s", file, output, data)
+		}
+	}
+}
+
+func TestExecuteCommand_OnBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	executionService := services.NewMockExecutionService(ctrl)
+	validationService := services.NewMockValidationService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+
+	ctx := context.Background()
+	input := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "pull", OnBranch: "feature-x"}
+	cmd := entities.NewGitCommand([]string{"pull"})
+	api := &entities.Repository{Name: "api"}
+	web := &entities.Repository{Name: "web"}
+	broken := &entities.Repository{Name: "broken"}
+
+	summary := entities.NewSummary()
+	success := entities.NewExecutionResult("api", "git pull")
+	success.MarkAsSuccess("", 0)
+	summary.AddResult(*success)
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+	executionService.EXPECT().ParseCommand(ctx, "pull").Return(cmd, nil)
+	validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+	executionService.EXPECT().IsBuiltInCommand("pull").Return(false)
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, web, broken}, nil)
+	gitRepo.EXPECT().GetBranch(ctx, api).Return("feature-x", nil)
+	gitRepo.EXPECT().GetBranch(ctx, web).Return("main", nil)
+	gitRepo.EXPECT().GetBranch(ctx, broken).Return("", errors.New("not a git repository"))
+	executorRepo.EXPECT().ExecuteSequential(ctx, []*entities.Repository{api}, cmd).Return(summary, nil)
+	presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+
+	result, err := useCase.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Summary.SkippedCount() != 2 {
+		t.Fatalf("Expected web and broken to be skipped, got %d skipped", result.Summary.SkippedCount())
+	}
+	for _, r := range result.Summary.Results {
+		if r.IsSkipped() && r.ErrorMessage != "not on branch feature-x" {
+			t.Errorf("Expected %s to be skipped as not on branch feature-x, got %q", r.Repository, r.ErrorMessage)
+		}
+	}
+}
+
+func TestExecuteCommand_SkipLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	executionService := services.NewMockExecutionService(ctrl)
+	validationService := services.NewMockValidationService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+
+	ctx := context.Background()
+	input := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "pull", SkipLocked: true}
+	cmd := entities.NewGitCommand([]string{"pull"})
+	api := &entities.Repository{Name: "api"}
+	web := &entities.Repository{Name: "web"}
+	broken := &entities.Repository{Name: "broken"}
+
+	summary := entities.NewSummary()
+	success := entities.NewExecutionResult("api", "git pull")
+	success.MarkAsSuccess("", 0)
+	summary.AddResult(*success)
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+	executionService.EXPECT().ParseCommand(ctx, "pull").Return(cmd, nil)
+	validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+	executionService.EXPECT().IsBuiltInCommand("pull").Return(false)
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, web, broken}, nil)
+	gitRepo.EXPECT().GetLockFile(ctx, api).Return("", nil)
+	gitRepo.EXPECT().GetLockFile(ctx, web).Return("/src/web/.git/index.lock", nil)
+	gitRepo.EXPECT().GetLockFile(ctx, broken).Return("", errors.New("not a git repository"))
+	executorRepo.EXPECT().ExecuteSequential(ctx, []*entities.Repository{api, broken}, cmd).Return(summary, nil)
+	presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+
+	result, err := useCase.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Summary.SkippedCount() != 1 {
+		t.Fatalf("Expected only web to be skipped, got %d skipped", result.Summary.SkippedCount())
+	}
+	for _, r := range result.Summary.Results {
+		if r.IsSkipped() && (r.Repository != "web" || r.ErrorMessage != LockedReason) {
+			t.Errorf("Expected web to be skipped as locked, got %s: %q", r.Repository, r.ErrorMessage)
+		}
+	}
+}
+
+func TestExecuteCommand_OnDirty(t *testing.T) {
+	tests := []struct {
+		name         string
+		command      string
+		onDirty      string
+		expectedArgs []string
+		autostash    bool
+		skipped      bool
+	}{
+		{name: "fail", command: "pull", onDirty: OnDirtyFail, expectedArgs: []string{"pull"}},
+		{name: "skip", command: "pull", onDirty: OnDirtySkip, expectedArgs: []string{"pull"}, skipped: true},
+		{name: "stash pull", command: "pull", onDirty: OnDirtyStash, expectedArgs: []string{"pull", "--autostash"}},
+		{name: "stash checkout", command: "checkout main", onDirty: OnDirtyStash, expectedArgs: []string{"checkout", "main"}, autostash: true},
+		{name: "other command", command: "fetch", onDirty: OnDirtySkip, expectedArgs: []string{"fetch"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := newValidGitRepository(ctrl)
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: tt.command, OnDirty: tt.onDirty}
+			cmd := entities.NewGitCommand(strings.Fields(tt.command))
+			api := &entities.Repository{Name: "api"}
+			web := &entities.Repository{Name: "web"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, tt.command).Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand(cmd.Args[0]).Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, web}, nil)
+
+			run := []*entities.Repository{api, web}
+			if tt.skipped {
+				gitRepo.EXPECT().HasUncommittedChanges(ctx, api).Return(false, nil)
+				gitRepo.EXPECT().HasUncommittedChanges(ctx, web).Return(true, nil)
+				run = []*entities.Repository{api}
+			}
+			executorRepo.EXPECT().ExecuteSequential(ctx, run, cmd).Return(entities.NewSummary(), nil)
+			presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+			result, err := useCase.Execute(ctx, input)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if !slices.Equal(cmd.Args, tt.expectedArgs) || cmd.Autostash != tt.autostash {
+				t.Errorf("Expected %v with autostash %v, got %v with autostash %v", tt.expectedArgs, tt.autostash, cmd.Args, cmd.Autostash)
+			}
+			if tt.skipped {
+				if result.Summary.SkippedCount() != 1 || result.Summary.Results[0].ErrorMessage != UncommittedChangesReason {
+					t.Errorf("Expected web to be skipped with uncommitted changes, got %+v", result.Summary.Results)
+				}
+			} else if result.Summary.SkippedCount() != 0 {
+				t.Errorf("Expected no repository to be skipped, got %d", result.Summary.SkippedCount())
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_DirtyOnly(t *testing.T) {
+	tests := []struct {
+		name     string
+		webDirty bool
+		run      bool
+	}{
+		{name: "dirty repositories", webDirty: true, run: true},
+		{name: "no dirty repository", webDirty: false, run: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := newValidGitRepository(ctrl)
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{Groups: []string{"*"}, CommandStr: "stash", DirtyOnly: true}
+			cmd := entities.NewGitCommand([]string{"stash"})
+			api := &entities.Repository{Name: "api"}
+			web := &entities.Repository{Name: "web"}
+			broken := &entities.Repository{Name: "broken"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, "stash").Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("stash").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"*"}).Return([]*entities.Repository{api, broken, web}, nil)
+			gitRepo.EXPECT().HasUncommittedChanges(ctx, api).Return(false, nil)
+			gitRepo.EXPECT().HasUncommittedChanges(ctx, broken).Return(false, errors.New("not a git repository"))
+			gitRepo.EXPECT().HasUncommittedChanges(ctx, web).Return(tt.webDirty, nil)
+
+			if tt.run {
+				executorRepo.EXPECT().ExecuteSequential(ctx, []*entities.Repository{web}, cmd).Return(entities.NewSummary(), nil)
+				presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+			}
+
+			result, err := useCase.Execute(ctx, input)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !tt.run && (!result.Success || result.Summary.TotalCount() != 0) {
+				t.Errorf("Expected an empty successful run without dirty repositories, got %+v", result)
+			}
+			if result.Summary.SkippedCount() != 0 {
+				t.Errorf("Expected clean repositories to be left out rather than skipped, got %d skipped", result.Summary.SkippedCount())
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_UnsafePaths(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+
+	tests := []struct {
+		name         string
+		forceUnsafe  bool
+		expectPrompt bool
+		answer       bool
+		expectRun    int
+	}{
+		{name: "skipped", expectRun: 1},
+		{name: "forced and declined", forceUnsafe: true, expectPrompt: true},
+		{name: "forced and accepted", forceUnsafe: true, expectPrompt: true, answer: true, expectRun: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := repositories.NewMockGitRepository(ctrl)
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+			confirmer := inputPort.NewMockConfirmationPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+			useCase.SetConfirmer(confirmer)
+
+			ctx := context.Background()
+			input := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "pull", ForceUnsafe: tt.forceUnsafe}
+			cmd := entities.NewGitCommand([]string{"pull"})
+			api := &entities.Repository{Name: "api", Path: "/src/api"}
+			root := &entities.Repository{Name: "root", Path: "/"}
+			homeDir := &entities.Repository{Name: "home", Path: home + "/"}
+			plain := &entities.Repository{Name: "plain", Path: "/src/plain"}
+
+			summary := entities.NewSummary()
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, "pull").Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("pull").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, root, homeDir, plain}, nil)
+			gitRepo.EXPECT().IsValidRepository(ctx, "/src/api").Return(true)
+			gitRepo.EXPECT().IsValidRepository(ctx, "/src/plain").Return(false)
+			if tt.expectPrompt {
+				confirmer.EXPECT().Confirm(ctx, gomock.Any()).Return(tt.answer, nil)
+			}
+			if tt.expectRun > 0 {
+				executorRepo.EXPECT().ExecuteSequential(ctx, gomock.Len(tt.expectRun), cmd).Return(summary, nil)
+				presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+			}
+
+			result, err := useCase.Execute(ctx, input)
+			if tt.expectRun == 0 {
+				if !gferrors.IsError(err, gferrors.ErrCommandNotConfirmed) {
+					t.Errorf("Expected ErrCommandNotConfirmed, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			reasons := make(map[string]string)
+			for _, r := range result.Summary.Results {
+				if r.IsSkipped() {
+					reasons[r.Repository] = r.ErrorMessage
+				}
+			}
+			expected := map[string]string{"root": RootPathReason, "home": HomePathReason, "plain": NotAGitRepositoryReason}
+			if tt.forceUnsafe {
+				expected = map[string]string{}
+			}
+			if !reflect.DeepEqual(reasons, expected) {
+				t.Errorf("Expected skipped repositories %v, got %v", expected, reasons)
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_WorkDir(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	executionService := services.NewMockExecutionService(ctrl)
+	validationService := services.NewMockValidationService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+
+	ctx := context.Background()
+	input := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "npm ci", WorkDir: "web"}
+	cmd := entities.NewShellCommand([]string{"npm", "ci"})
+	mono := &entities.Repository{Name: "mono", Path: "/src/mono"}
+	api := &entities.Repository{Name: "api", Path: "/src/api"}
+
+	summary := entities.NewSummary()
+	success := entities.NewExecutionResult("mono", "npm ci")
+	success.MarkAsSuccess("", 0)
+	summary.AddResult(*success)
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+	executionService.EXPECT().ParseCommand(ctx, "npm ci").Return(cmd, nil)
+	validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+	executionService.EXPECT().IsBuiltInCommand("npm").Return(false)
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{mono, api}, nil)
+	gitRepo.EXPECT().IsValidDirectory(ctx, "/src/mono/web").Return(true)
+	gitRepo.EXPECT().IsValidDirectory(ctx, "/src/api/web").Return(false)
+	executorRepo.EXPECT().ExecuteSequential(ctx, []*entities.Repository{mono}, cmd).Return(summary, nil)
+	presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+
+	result, err := useCase.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cmd.WorkingDir != "web" {
+		t.Errorf("Expected the command to run in web, got %q", cmd.WorkingDir)
+	}
+	if result.Summary.SkippedCount() != 1 {
+		t.Fatalf("Expected only api to be skipped, got %d skipped", result.Summary.SkippedCount())
+	}
+	for _, r := range result.Summary.Results {
+		if r.IsSkipped() && (r.Repository != "api" || r.ErrorMessage != "no directory web") {
+			t.Errorf("Expected api to be skipped without a web directory, got %s: %q", r.Repository, r.ErrorMessage)
+		}
+	}
+}
+
+func TestExecuteCommand_CommitPrompt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	executionService := services.NewMockExecutionService(ctrl)
+	validationService := services.NewMockValidationService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+	prompter := inputPort.NewMockPromptPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+	useCase.SetPrompter(prompter)
+
+	ctx := context.Background()
+	request := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "commit", CommitPrompt: true}
+	cmd := entities.NewGitCommand([]string{"commit"})
+	api := &entities.Repository{Name: "api"}
+	web := &entities.Repository{Name: "web"}
+	docs := &entities.Repository{Name: "docs"}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+	executionService.EXPECT().ParseCommand(ctx, "commit").Return(cmd, nil)
+	validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+	executionService.EXPECT().IsBuiltInCommand("commit").Return(false)
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, web, docs}, nil)
+	gitRepo.EXPECT().HasUncommittedChanges(ctx, api).Return(true, nil)
+	gitRepo.EXPECT().HasUncommittedChanges(ctx, web).Return(true, nil)
+	gitRepo.EXPECT().HasUncommittedChanges(ctx, docs).Return(false, nil)
+	gitRepo.EXPECT().GetDiffStat(ctx, api, true).Return(&repositories.DiffStat{FilesChanged: 2}, nil)
+	gitRepo.EXPECT().GetDiffStat(ctx, web, true).Return(&repositories.DiffStat{}, nil)
+	prompter.EXPECT().Prompt(ctx, gomock.Any()).Return("Fix the build", nil).Times(1)
+	executorRepo.EXPECT().ExecuteSequential(ctx, []*entities.Repository{api}, cmd).DoAndReturn(
+		func(_ context.Context, _ []*entities.Repository, command *entities.Command) (*entities.Summary, error) {
+			if got := command.GetFullCommand(); got != "commit -m Fix the build" {
+				t.Errorf("executed command = %q, want the prompted message", got)
+			}
+			return entities.NewSummary(), nil
+		})
+	presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+	result, err := useCase.Execute(ctx, request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	skipped := map[string]string{}
+	for _, r := range result.Summary.Results {
+		if r.IsSkipped() {
+			skipped[r.Repository] = r.ErrorMessage
+		}
+	}
+	if skipped["web"] != NothingStagedReason || skipped["docs"] != NothingToCommitReason || len(skipped) != 2 {
+		t.Errorf("skipped = %v, want web with nothing staged and docs with nothing to commit", skipped)
+	}
+}
+
+func TestExecuteCommand_CommitPrompt_NoMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		confirmed bool
+		prompted  bool
+		message   string
+		err       error
+	}{
+		{name: "yes never prompts", confirmed: true},
+		{name: "no terminal", prompted: true, err: gferrors.ErrNoTerminal},
+		{name: "empty message", prompted: true, message: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := newValidGitRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			executionService := services.NewMockExecutionService(ctrl)
+			validationService := services.NewMockValidationService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			prompter := inputPort.NewMockPromptPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, executionService, validationService, logger, nil)
+			useCase.SetPrompter(prompter)
+
+			ctx := context.Background()
+			request := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "commit", CommitPrompt: true, Confirmed: tt.confirmed}
+			cmd := entities.NewGitCommand([]string{"commit"})
+			api := &entities.Repository{Name: "api"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			executionService.EXPECT().ParseCommand(ctx, "commit").Return(cmd, nil)
+			validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+			executionService.EXPECT().IsBuiltInCommand("commit").Return(false)
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api}, nil)
+			gitRepo.EXPECT().HasUncommittedChanges(ctx, api).Return(true, nil)
+			gitRepo.EXPECT().GetDiffStat(ctx, api, true).Return(&repositories.DiffStat{FilesChanged: 1}, nil)
+			if tt.prompted {
+				prompter.EXPECT().Prompt(ctx, gomock.Any()).Return(tt.message, tt.err)
+			}
+
+			_, err := useCase.Execute(ctx, request)
+			if !gferrors.IsError(err, gferrors.ErrNoCommitMessage) {
+				t.Errorf("Execute() error = %v, want %v", err, gferrors.ErrNoCommitMessage)
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_CommitPrompt_NotACommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executionService := services.NewMockExecutionService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, executionService, nil, logger, nil)
+
+	ctx := context.Background()
+	cmd := entities.NewGitCommand([]string{"pull"})
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	executionService.EXPECT().ParseCommand(ctx, "pull").Return(cmd, nil)
+
+	_, err := useCase.Execute(ctx, &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "pull", CommitPrompt: true})
+	if !gferrors.IsError(err, gferrors.ErrUsageCommitPrompt) {
+		t.Errorf("Execute() error = %v, want %v", err, gferrors.ErrUsageCommitPrompt)
+	}
+}
+
+func TestTagRelease(t *testing.T) {
+	tests := []struct {
+		name           string
+		noPush         bool
+		expectedScript string
+	}{
+		{
+			name:           "tag and push",
+			expectedScript: "git tag -a 'v1.2.0' -m 'Release v1.2.0' && git push origin 'refs/tags/v1.2.0'",
+		},
+		{
+			name:           "no push",
+			noPush:         true,
+			expectedScript: "git tag -a 'v1.2.0' -m 'Release v1.2.0'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := newValidGitRepository(ctrl)
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, nil, nil, logger, presenter)
+
+			ctx := context.Background()
+			api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+			web := &entities.Repository{Name: "web", Path: "/path/to/web"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"release"}).Return([]*entities.Repository{api, web}, nil)
+			gitRepo.EXPECT().HasTag(ctx, api, "v1.2.0").Return(false, nil)
+			gitRepo.EXPECT().HasTag(ctx, web, "v1.2.0").Return(true, nil)
+			presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+			executorRepo.EXPECT().ExecuteInParallel(ctx, []*entities.Repository{api}, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+					if cmd.GetFullCommand() != tt.expectedScript {
+						t.Errorf("command = %q, want %q", cmd.GetFullCommand(), tt.expectedScript)
+					}
+					summary := entities.NewSummary()
+					result := entities.NewExecutionResult("api", cmd.GetFullCommand())
+					result.MarkAsSuccess("", 0)
+					summary.AddResult(*result)
+					return summary, nil
+				})
+
+			output, err := useCase.TagRelease(ctx, &TagReleaseInput{Groups: []string{"release"}, Version: "v1.2.0", NoPush: tt.noPush})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !output.Success {
+				t.Error("Expected success")
+			}
+			if output.Summary.SkippedCount() != 1 || output.Summary.Results[1].ErrorMessage != AlreadyTaggedReason {
+				t.Errorf("Expected web to be skipped as already tagged, got %+v", output.Summary.Results)
+			}
+		})
+	}
+}
+
+func TestTagRelease_InvalidVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := services.NewMockLoggingService(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, nil, nil, logger, nil)
+
+	for _, version := range []string{"", "--force", "v1 v2"} {
+		_, err := useCase.TagRelease(context.Background(), &TagReleaseInput{Groups: []string{"release"}, Version: version})
+		if !gferrors.IsError(err, gferrors.ErrUsageTagRelease) {
+			t.Errorf("TagRelease(%q) error = %v, want ErrUsageTagRelease", version, err)
+		}
+	}
+}
+
+func TestQuoteShellWord(t *testing.T) {
+	if got := quoteShellWord("it's done"); got != `'it'\''s done'` {
+		t.Errorf("quoteShellWord() = %s", got)
+	}
+}
+
+func TestResetToUpstream(t *testing.T) {
+	tests := []struct {
+		name              string
+		force             bool
+		expectedReset     []string
+		expectedProtected int
+	}{
+		{
+			name:              "protects local work",
+			expectedReset:     []string{"api"},
+			expectedProtected: 2,
+		},
+		{
+			name:          "force resets all",
+			force:         true,
+			expectedReset: []string{"api", "web", "cli"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := newValidGitRepository(ctrl)
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, nil, nil, logger, presenter)
+
+			ctx := context.Background()
+			api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+			web := &entities.Repository{Name: "web", Path: "/path/to/web"}
+			cli := &entities.Repository{Name: "cli", Path: "/path/to/cli"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, web, cli}, nil)
+			if !tt.force {
+				gitRepo.EXPECT().HasUncommittedChanges(ctx, api).Return(false, nil)
+				gitRepo.EXPECT().GetAheadBehind(ctx, api).Return(0, 3, nil)
+				gitRepo.EXPECT().HasUncommittedChanges(ctx, web).Return(true, nil)
+				gitRepo.EXPECT().HasUncommittedChanges(ctx, cli).Return(false, nil)
+				gitRepo.EXPECT().GetAheadBehind(ctx, cli).Return(1, 0, nil)
+			}
+			presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+			executorRepo.EXPECT().ExecuteInParallel(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+					if cmd.GetFullCommand() != "git fetch && git reset --hard @{u}" {
+						t.Errorf("command = %q, want the fetch and reset", cmd.GetFullCommand())
+					}
+					summary := entities.NewSummary()
+					var names []string
+					for _, repo := range repos {
+						names = append(names, repo.Name)
+						result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+						result.MarkAsSuccess("", 0)
+						summary.AddResult(*result)
+					}
+					if !reflect.DeepEqual(names, tt.expectedReset) {
+						t.Errorf("reset repositories = %v, want %v", names, tt.expectedReset)
+					}
+					return summary, nil
+				})
+
+			output, err := useCase.ResetToUpstream(ctx, &ResetToUpstreamInput{Groups: []string{"all"}, Force: tt.force, Confirmed: true})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !output.Success {
+				t.Error("Expected success")
+			}
+			if output.Summary.SkippedCount() != tt.expectedProtected {
+				t.Errorf("Expected %d skipped repositories, got %+v", tt.expectedProtected, output.Summary.Results)
+			}
+			for _, result := range output.Summary.Results {
+				if result.IsSkipped() && result.ErrorMessage != WouldLoseLocalWorkReason {
+					t.Errorf("Expected %s to be skipped as it would lose local work, got %q", result.Repository, result.ErrorMessage)
+				}
+			}
+		})
+	}
+}
+
+func TestAmend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+	confirmer := inputPort.NewMockConfirmationPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, nil, nil, logger, presenter)
+	useCase.SetConfirmer(confirmer)
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+	empty := &entities.Repository{Name: "empty", Path: "/path/to/empty"}
+	clean := &entities.Repository{Name: "clean", Path: "/path/to/clean"}
+	pushed := &entities.Repository{Name: "pushed", Path: "/path/to/pushed"}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, empty, clean, pushed}, nil)
+
+	gitRepo.EXPECT().GetLastCommit(ctx, api).Return(&repositories.CommitInfo{Hash: "abc"}, nil)
+	gitRepo.EXPECT().GetDiffStat(ctx, api, true).Return(&repositories.DiffStat{FilesChanged: 1}, nil)
+	gitRepo.EXPECT().GetAheadBehind(ctx, api).Return(1, 0, nil)
+	gitRepo.EXPECT().GetLastCommit(ctx, empty).Return(nil, errors.New("does not have any commits yet"))
+	gitRepo.EXPECT().GetLastCommit(ctx, clean).Return(&repositories.CommitInfo{Hash: "def"}, nil)
+	gitRepo.EXPECT().GetDiffStat(ctx, clean, true).Return(&repositories.DiffStat{}, nil)
+	gitRepo.EXPECT().GetLastCommit(ctx, pushed).Return(&repositories.CommitInfo{Hash: "ghi"}, nil)
+	gitRepo.EXPECT().GetDiffStat(ctx, pushed, true).Return(&repositories.DiffStat{FilesChanged: 2}, nil)
+	gitRepo.EXPECT().GetAheadBehind(ctx, pushed).Return(0, 0, nil)
+
+	confirmer.EXPECT().Confirm(ctx, gomock.Any()).Return(true, nil)
+	presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+	executorRepo.EXPECT().ExecuteInParallel(ctx, []*entities.Repository{api}, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+			if cmd.GetFullCommand() != "commit --amend --no-edit" {
+				t.Errorf("command = %q, want commit --amend --no-edit", cmd.GetFullCommand())
+			}
+			summary := entities.NewSummary()
+			result := entities.NewExecutionResult("api", cmd.GetFullCommand())
+			result.MarkAsSuccess("", 0)
+			summary.AddResult(*result)
+			return summary, nil
+		})
+
+	output, err := useCase.Amend(ctx, &AmendInput{Groups: []string{"all"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !output.Success {
+		t.Error("Expected success")
+	}
+
+	reasons := make(map[string]string)
+	for _, result := range output.Summary.Results {
+		if result.IsSkipped() {
+			reasons[result.Repository] = result.ErrorMessage
+		}
+	}
+	want := map[string]string{"empty": NoCommitsReason, "clean": NothingStagedReason, "pushed": AlreadyPushedReason}
+	if !reflect.DeepEqual(reasons, want) {
+		t.Errorf("Expected skipped repositories %v, got %v", want, reasons)
+	}
+}
+
+func TestAmend_NotConfirmed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	confirmer := inputPort.NewMockConfirmationPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, nil, nil, logger, nil)
+	useCase.SetConfirmer(confirmer)
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api}, nil)
+	gitRepo.EXPECT().GetLastCommit(ctx, api).Return(&repositories.CommitInfo{Hash: "abc"}, nil)
+	gitRepo.EXPECT().GetAheadBehind(ctx, api).Return(0, 0, nil).Times(0)
+	confirmer.EXPECT().Confirm(ctx, gomock.Any()).Return(false, nil)
+
+	_, err := useCase.Amend(ctx, &AmendInput{Groups: []string{"all"}, Message: "Reword", Force: true})
+	if !gferrors.IsError(err, gferrors.ErrCommandNotConfirmed) {
+		t.Errorf("Expected ErrCommandNotConfirmed, got %v", err)
+	}
+}
+
+func TestPruneRemotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, nil, nil, logger, nil)
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/to/web", Remote: "upstream"}
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/to/broken"}
+
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, api, broken}, nil)
+	gitRepo.EXPECT().PruneRemote(ctx, api, "origin").Return([]string{"origin/old"}, nil)
+	gitRepo.EXPECT().PruneRemote(ctx, broken, "origin").Return(nil, errors.New("not a git repository"))
+	gitRepo.EXPECT().PruneRemote(ctx, web, "upstream").Return(nil, nil)
+
+	prunes, err := useCase.PruneRemotes(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(prunes) != 3 || prunes[0].Repository != "api" || prunes[1].Repository != "broken" || prunes[2].Repository != "web" {
+		t.Fatalf("Expected prunes sorted by name, got %+v", prunes)
+	}
+	if len(prunes[0].Pruned) != 1 || prunes[0].IsClean() {
+		t.Errorf("Expected api to have one pruned branch, got %+v", prunes[0])
+	}
+	if prunes[1].Error == "" || prunes[1].IsClean() {
+		t.Errorf("Expected broken to report its error, got %+v", prunes[1])
+	}
+	if prunes[2].Remote != "upstream" || !prunes[2].IsClean() {
+		t.Errorf("Expected web to be clean on its configured remote, got %+v", prunes[2])
+	}
+}
+
+func TestPruneTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, nil, nil, logger, nil)
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/to/web", Remote: "upstream"}
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/to/broken"}
+
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, api, broken}, nil)
+	gitRepo.EXPECT().FetchPruneTags(ctx, api, "origin").Return([]string{"v1.0", "v1.1"}, nil)
+	gitRepo.EXPECT().FetchPruneTags(ctx, broken, "origin").Return(nil, errors.New("not a git repository"))
+	gitRepo.EXPECT().FetchPruneTags(ctx, web, "upstream").Return(nil, nil)
+
+	prunes, err := useCase.PruneTags(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(prunes) != 3 || prunes[0].Repository != "api" || prunes[1].Repository != "broken" || prunes[2].Repository != "web" {
+		t.Fatalf("Expected prunes sorted by name, got %+v", prunes)
+	}
+	if len(prunes[0].Pruned) != 2 {
+		t.Errorf("Expected api to have two deleted tags, got %+v", prunes[0])
+	}
+	if prunes[1].Error == "" {
+		t.Errorf("Expected broken to report its error, got %+v", prunes[1])
+	}
+	if prunes[2].Remote != "upstream" || !prunes[2].IsClean() {
+		t.Errorf("Expected web to be clean on its configured remote, got %+v", prunes[2])
+	}
+}
+
+func TestCleanupBranches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, nil, nil, logger, nil)
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/to/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/to/broken"}
+
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, api, broken}, nil)
+	gitRepo.EXPECT().GetDefaultBranch(ctx, api).Return("main", nil)
+	gitRepo.EXPECT().GetBranch(ctx, api).Return("feature-c", nil)
+	gitRepo.EXPECT().GetMergedBranches(ctx, api, "main").Return([]string{"feature-a", "feature-c", "main"}, nil)
+	gitRepo.EXPECT().DeleteBranch(ctx, api, "feature-a").Return(nil)
+	gitRepo.EXPECT().GetDefaultBranch(ctx, broken).Return("", gferrors.ErrDefaultBranchNotFound)
+	gitRepo.EXPECT().GetDefaultBranch(ctx, web).Return("master", nil)
+	gitRepo.EXPECT().GetBranch(ctx, web).Return("master", nil)
+	gitRepo.EXPECT().GetMergedBranches(ctx, web, "master").Return([]string{"master"}, nil)
+
+	cleanups, err := useCase.CleanupBranches(ctx, []string{"all"}, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(cleanups) != 3 || cleanups[0].Repository != "api" || cleanups[1].Repository != "broken" || cleanups[2].Repository != "web" {
+		t.Fatalf("Expected cleanups sorted by name, got %+v", cleanups)
+	}
+	if len(cleanups[0].Branches) != 1 || cleanups[0].Branches[0] != "feature-a" {
+		t.Errorf("Expected api to delete only feature-a, got %+v", cleanups[0])
+	}
+	if cleanups[1].Error == "" {
+		t.Errorf("Expected broken to report its error, got %+v", cleanups[1])
+	}
+	if cleanups[2].DefaultBranch != "master" || len(cleanups[2].Branches) != 0 {
+		t.Errorf("Expected web to have nothing to delete, got %+v", cleanups[2])
+	}
+}
+
+func TestCleanupBranches_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, nil, nil, logger, nil)
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"api"}).Return([]*entities.Repository{api}, nil)
+	gitRepo.EXPECT().GetDefaultBranch(ctx, api).Return("main", nil)
+	gitRepo.EXPECT().GetBranch(ctx, api).Return("main", nil)
+	gitRepo.EXPECT().GetMergedBranches(ctx, api, "main").Return([]string{"feature-a", "feature-b", "main"}, nil)
+	gitRepo.EXPECT().DeleteBranch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	cleanups, err := useCase.CleanupBranches(ctx, []string{"api"}, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(cleanups) != 1 || !cleanups[0].DryRun || len(cleanups[0].Branches) != 2 {
+		t.Errorf("Expected the two merged branches to be listed without deleting them, got %+v", cleanups)
+	}
+}
+
+func TestGrep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, newValidGitRepository(ctrl), executorRepo, configService, nil, nil, logger, nil)
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api"}
+	docs := &entities.Repository{Name: "docs"}
+	broken := &entities.Repository{Name: "broken"}
+
+	summary := entities.NewSummary()
+	matched := entities.NewExecutionResult("api", "git grep -l -e TODO")
+	matched.MarkAsSuccess("main.go\nutil.go\n", 0)
+	summary.AddResult(*matched)
+	noMatch := entities.NewExecutionResult("docs", "git grep -l -e TODO")
+	noMatch.MarkAsFailed("", 1, "exit status 1")
+	summary.AddResult(*noMatch)
+	failed := entities.NewExecutionResult("broken", "git grep -l -e TODO")
+	failed.MarkAsFailed("fatal: not a git repository\n", 128, "exit status 128")
+	summary.AddResult(*failed)
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{docs, broken, api}, nil)
+	executorRepo.EXPECT().ExecuteInParallel(ctx, []*entities.Repository{api, broken, docs}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+			if got := strings.Join(cmd.Args, " "); got != "grep -l -e TODO" {
+				t.Errorf("Expected git grep -l -e TODO, got %s", got)
+			}
+			return summary, nil
+		})
+
+	greps, err := useCase.Grep(ctx, &GrepInput{Groups: []string{"all"}, Pattern: "TODO", FilesOnly: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(greps) != 3 || greps[0].Repository != "api" || greps[1].Repository != "broken" || greps[2].Repository != "docs" {
+		t.Fatalf("Expected results sorted by name, got %+v", greps)
+	}
+	if strings.Join(greps[0].Matches, ",") != "main.go,util.go" {
+		t.Errorf("Expected api to match main.go and util.go, got %v", greps[0].Matches)
+	}
+	if greps[1].Error != "fatal: not a git repository" {
+		t.Errorf("Expected broken to report its error, got %+v", greps[1])
+	}
+	if greps[2].Error != "" || len(greps[2].Matches) != 0 {
+		t.Errorf("Expected docs to have no matches and no error, got %+v", greps[2])
+	}
+
+	if _, err := useCase.Grep(ctx, &GrepInput{Groups: []string{"all"}}); !gferrors.IsError(err, gferrors.ErrUsageGrep) {
+		t.Errorf("Expected ErrUsageGrep without a pattern, got %v", err)
+	}
+}
+
+func TestExecuteCommand_RecordLastOperations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateRepo := repositories.NewMockStateRepository(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, nil, nil, logger, nil)
+	useCase.SetStateRepository(stateRepo)
+
+	ctx := context.Background()
+	summary := entities.NewSummary()
+	success := entities.NewExecutionResult("api", "git pull")
+	success.MarkAsSuccess("", 0)
+	failed := entities.NewExecutionResult("web", "git pull")
+	failed.MarkAsFailed("", 1, "conflict")
+	skipped := entities.NewExecutionResult("docs", "git pull")
+	skipped.MarkAsSkipped("dirty")
+	summary.AddResult(*success)
+	summary.AddResult(*failed)
+	summary.AddResult(*skipped)
+
+	stateRepo.EXPECT().RecordOperations(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, operations map[string]*entities.LastOperation) error {
+		if len(operations) != 2 || operations["docs"] != nil {
+			t.Errorf("Expected the skipped repository to be left out, got %v", operations)
+		}
+		if op := operations["web"]; op == nil || op.Command != "git pull" || op.Status != entities.ExecutionStatusFailed || op.Time.IsZero() {
+			t.Errorf("Expected the failed pull of web to be recorded, got %+v", op)
+		}
+		return gferrors.ErrFailedToWriteState
+	})
+	logger.EXPECT().Warn(ctx, "Failed to record the last operations", "error", gferrors.ErrFailedToWriteState)
+
+	useCase.recordLastOperations(ctx, summary)
+}
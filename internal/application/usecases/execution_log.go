@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+// executionLogExtension is the extension of the per-repository execution log files
+const executionLogExtension = ".log"
+
+// executionLogFileName returns the log file name of a repository, replacing the
+// characters that cannot appear in a file name so that names such as "org/api"
+// stay inside the log directory
+func executionLogFileName(repository string) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', 0:
+			return '_'
+		}
+		return r
+	}, repository)
+
+	if strings.Trim(name, ".") == "" {
+		name = strings.Repeat("_", len(name)+1)
+	}
+
+	return name + executionLogExtension
+}
+
+// formatExecutionLog renders the full record of an execution on a repository
+func formatExecutionLog(result entities.ExecutionResult) string {
+	var log bytes.Buffer
+
+	fmt.Fprintf(&log, "Repository: %s\n", result.Repository)
+	fmt.Fprintf(&log, "Command: %s\n", result.Command)
+	fmt.Fprintf(&log, "Status: %s\n", result.Status)
+	fmt.Fprintf(&log, "Exit code: %d\n", result.ExitCode)
+	fmt.Fprintf(&log, "Started: %s\n", result.StartTime.Format(time.RFC3339))
+	if !result.EndTime.IsZero() {
+		fmt.Fprintf(&log, "Finished: %s\n", result.EndTime.Format(time.RFC3339))
+	}
+	fmt.Fprintf(&log, "Duration: %s\n", result.Duration)
+	if result.ErrorMessage != "" {
+		fmt.Fprintf(&log, "Error: %s\n", result.ErrorMessage)
+	}
+	if result.Warning != "" {
+		fmt.Fprintf(&log, "Warning: %s\n", result.Warning)
+	}
+
+	fmt.Fprintf(&log, "\n--- stdout ---\n%s", result.Output)
+	if result.Output != "" && !strings.HasSuffix(result.Output, "\n") {
+		log.WriteString("\n")
+	}
+	fmt.Fprintf(&log, "--- stderr ---\n%s", result.ErrorOutput)
+	if result.ErrorOutput != "" && !strings.HasSuffix(result.ErrorOutput, "\n") {
+		log.WriteString("\n")
+	}
+
+	return log.String()
+}
+
+// createExecutionLogDir creates the log directory and its parents when missing
+func createExecutionLogDir(dir string) error {
+	return os.MkdirAll(dir, 0755)
+}
+
+// writeExecutionLog writes the log file of an execution in the log directory
+func writeExecutionLog(dir string, result entities.ExecutionResult) error {
+	path := filepath.Join(dir, executionLogFileName(result.Repository))
+	return os.WriteFile(path, []byte(formatExecutionLog(result)), 0644)
+}
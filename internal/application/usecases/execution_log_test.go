@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+func TestExecutionLogFileName(t *testing.T) {
+	tests := map[string]string{
+		"api":         "api.log",
+		"org/api":     "org_api.log",
+		`team\web`:    "team_web.log",
+		"..":          "___.log",
+		"api.service": "api.service.log",
+	}
+
+	for repository, expected := range tests {
+		if got := executionLogFileName(repository); got != expected {
+			t.Errorf("executionLogFileName(%q) = %q, want %q", repository, got, expected)
+		}
+	}
+}
+
+func TestWriteExecutionLog(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "logs", "run")
+	if err := createExecutionLogDir(dir); err != nil {
+		t.Fatalf("createExecutionLogDir() error = %v", err)
+	}
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	result := entities.ExecutionResult{
+		Repository:   "org/api",
+		Command:      "git pull",
+		Status:       entities.ExecutionStatusFailed,
+		Output:       "Updating 1a2b3c..4d5e6f",
+		ErrorOutput:  "error: could not merge\n",
+		ExitCode:     1,
+		StartTime:    start,
+		EndTime:      start.Add(2 * time.Second),
+		Duration:     2 * time.Second,
+		ErrorMessage: "exit status 1",
+	}
+
+	if err := writeExecutionLog(dir, result); err != nil {
+		t.Fatalf("writeExecutionLog() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "org_api.log"))
+	if err != nil {
+		t.Fatalf("Failed to read the log file: %v", err)
+	}
+
+	log := string(data)
+	for _, want := range []string{
+		"Repository: org/api",
+		"Command: git pull",
+		"Status: failed",
+		"Exit code: 1",
+		"Started: 2024-05-01T10:00:00Z",
+		"Finished: 2024-05-01T10:00:02Z",
+		"Error: exit status 1",
+		"--- stdout ---\nUpdating 1a2b3c..4d5e6f\n",
+		"--- stderr ---\nerror: could not merge\n",
+	} {
+		if !strings.Contains(log, want) {
+			t.Errorf("log should contain %q, got:\n%s", want, log)
+		}
+	}
+}
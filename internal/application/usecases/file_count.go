@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// RepositoryFileCount holds the number of files tracked in a repository
+type RepositoryFileCount struct {
+	Repository string `json:"repository"`
+	Files      int    `json:"files"`
+	Error      string `json:"error,omitempty"`
+}
+
+// GetTrackedFileCounts returns the number of tracked files of the repositories in the
+// given groups, largest first. Repositories that cannot be read are reported with an
+// error after the others.
+func (uc *StatusReportUseCase) GetTrackedFileCounts(ctx context.Context, groups []string) ([]*RepositoryFileCount, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	counts := make([]*RepositoryFileCount, 0, len(repos))
+	for _, repo := range repos {
+		count := &RepositoryFileCount{Repository: repo.Name}
+		counts = append(counts, count)
+
+		files, err := uc.gitRepo.CountTrackedFiles(ctx, repo)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to count tracked files", "repository", repo.Name, "error", err)
+			count.Error = err.Error()
+			continue
+		}
+		count.Files = files
+	}
+
+	sort.SliceStable(counts, func(i, j int) bool {
+		if (counts[i].Error == "") != (counts[j].Error == "") {
+			return counts[i].Error == ""
+		}
+		return counts[i].Files > counts[j].Files
+	})
+
+	return counts, nil
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+)
+
+func TestStatusReportUseCase_GetTrackedFileCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	empty := &entities.Repository{Name: "empty", Path: "/path/empty"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, broken, empty, api}, nil)
+	mockGitRepo.EXPECT().CountTrackedFiles(ctx, api).Return(12, nil)
+	mockGitRepo.EXPECT().CountTrackedFiles(ctx, web).Return(340, nil)
+	mockGitRepo.EXPECT().CountTrackedFiles(ctx, empty).Return(0, nil)
+	mockGitRepo.EXPECT().CountTrackedFiles(ctx, broken).Return(0, errors.New("not a git repository"))
+	mockLogger.EXPECT().Warn(ctx, "Failed to count tracked files", gomock.Any()).Times(1)
+
+	counts, err := usecase.GetTrackedFileCounts(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, count := range counts {
+		names = append(names, count.Repository)
+	}
+	if len(counts) != 4 || names[0] != "web" || names[1] != "api" || names[2] != "empty" || names[3] != "broken" {
+		t.Fatalf("GetTrackedFileCounts() should return the largest repositories first and errors last, got %v", names)
+	}
+	if counts[0].Files != 340 || counts[2].Files != 0 {
+		t.Errorf("GetTrackedFileCounts() counts = %d and %d, want 340 and 0", counts[0].Files, counts[2].Files)
+	}
+	if counts[3].Error == "" {
+		t.Error("unreadable repository should report an error")
+	}
+}
@@ -3,7 +3,11 @@ package usecases
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
 
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/input"
 	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
@@ -22,7 +26,11 @@ type ManageConfigUCI interface {
 	DiscoverRepositories(ctx context.Context) error
 	GetGroups(ctx context.Context) ([]*entities.Group, error)
 	GetRepositories(ctx context.Context) ([]*entities.Repository, error)
+	GetRepositoriesForGroups(ctx context.Context, groups []string) ([]*entities.Repository, error)
 	SetTheme(ctx context.Context, theme string) error
+	BackupConfig(ctx context.Context) (string, error)
+	ListConfigBackups(ctx context.Context) ([]string, error)
+	RestoreConfig(ctx context.Context, name string) error
 }
 
 // ManageConfigUseCase handles configuration management operations
@@ -32,6 +40,7 @@ type ManageConfigUseCase struct {
 	validationService services.ValidationService
 	logger            services.LoggingService
 	presenter         output.PresenterPort
+	confirmer         input.ConfirmationPort
 }
 
 // NewManageConfigUseCase creates a new ManageConfigUseCase
@@ -51,12 +60,35 @@ func NewManageConfigUseCase(
 	}
 }
 
+// SetConfirmer sets the port used to ask before reloading a configuration file changed
+// since it was loaded. Without a confirmer, the file is reloaded without asking.
+func (uc *ManageConfigUseCase) SetConfirmer(confirmer input.ConfirmationPort) {
+	uc.confirmer = confirmer
+}
+
 // ShowConfigInput represents input for showing configuration
 type ShowConfigInput struct {
 	ShowGroups       bool   `json:"show_groups"`
 	ShowRepositories bool   `json:"show_repositories"`
 	ShowValidation   bool   `json:"show_validation"`
 	GroupName        string `json:"group_name,omitempty"`
+	JSON             bool   `json:"json"`
+	SortBy           string `json:"sort_by,omitempty"`
+}
+
+// Keys accepted by ShowConfigInput.SortBy
+const (
+	ConfigSortByName       = "name"
+	ConfigSortByPath       = "path"
+	ConfigSortByGroupCount = "group-count"
+)
+
+// ConfigSortKeys lists the supported config show sort keys
+var ConfigSortKeys = []string{ConfigSortByName, ConfigSortByPath, ConfigSortByGroupCount}
+
+// IsValidConfigSortKey checks if the key is a supported config show sort key
+func IsValidConfigSortKey(key string) bool {
+	return slices.Contains(ConfigSortKeys, key)
 }
 
 // ShowConfigOutput represents output from showing configuration
@@ -84,6 +116,10 @@ type AddGroupInput struct {
 func (uc *ManageConfigUseCase) ShowConfig(ctx context.Context, input *ShowConfigInput) (*ShowConfigOutput, error) {
 	uc.logger.Info(ctx, "Showing configuration", "input", input)
 
+	if input.SortBy != "" && !IsValidConfigSortKey(input.SortBy) {
+		return nil, gitfleetErrors.WrapInvalidSortKey(input.SortBy, ConfigSortKeys)
+	}
+
 	// Load current configuration
 	config, err := uc.configRepo.Load(ctx)
 	if err != nil {
@@ -102,8 +138,24 @@ func (uc *ManageConfigUseCase) ShowConfig(ctx context.Context, input *ShowConfig
 		}
 	}
 
-	// Format output
-	formattedOutput, err := uc.presenter.PresentConfig(ctx, config)
+	// The JSON variant is meant for tooling and is resolved rather than presented
+	if input.JSON {
+		resolved := resolveConfig(config)
+		formattedOutput, err := formatResolvedConfig(resolved)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to encode configuration as JSON", err)
+			return nil, err
+		}
+		return &ShowConfigOutput{
+			FormattedOutput:  formattedOutput,
+			Config:           resolved,
+			IsValid:          isValid,
+			ValidationErrors: validationErrors,
+		}, nil
+	}
+
+	// Format output, the presenter receiving the repositories and groups already sorted
+	formattedOutput, err := uc.presenter.PresentConfig(ctx, sortConfigListing(config, input.SortBy))
 	if err != nil {
 		uc.logger.Error(ctx, "Failed to format configuration output", err)
 		// Don't fail the entire operation for formatting errors
@@ -143,7 +195,9 @@ func (uc *ManageConfigUseCase) AddRepository(ctx context.Context, input *AddRepo
 	}
 
 	// Save configuration
-	if err := uc.configService.SaveConfig(ctx); err != nil {
+	if err := uc.saveConfig(ctx, func() error {
+		return uc.configService.AddRepository(ctx, input.Name, input.Path)
+	}); err != nil {
 		uc.logger.Error(ctx, "Failed to save configuration", err)
 		return gitfleetErrors.WrapRepositoryOperationError(gitfleetErrors.ErrFailedToSaveConfig, err)
 	}
@@ -167,7 +221,9 @@ func (uc *ManageConfigUseCase) RemoveRepository(ctx context.Context, name string
 	}
 
 	// Save configuration
-	if err := uc.configService.SaveConfig(ctx); err != nil {
+	if err := uc.saveConfig(ctx, func() error {
+		return uc.configService.RemoveRepository(ctx, name)
+	}); err != nil {
 		uc.logger.Error(ctx, "Failed to save configuration", err)
 		return gitfleetErrors.WrapRepositoryOperationError(gitfleetErrors.ErrFailedToSaveConfig, err)
 	}
@@ -205,7 +261,9 @@ func (uc *ManageConfigUseCase) AddGroup(ctx context.Context, input *AddGroupInpu
 	}
 
 	// Save configuration
-	if err := uc.configService.SaveConfig(ctx); err != nil {
+	if err := uc.saveConfig(ctx, func() error {
+		return uc.configService.AddGroup(ctx, group)
+	}); err != nil {
 		uc.logger.Error(ctx, "Failed to save configuration", err)
 		return gitfleetErrors.WrapConfigSave(err)
 	}
@@ -229,7 +287,9 @@ func (uc *ManageConfigUseCase) RemoveGroup(ctx context.Context, name string) err
 	}
 
 	// Save configuration
-	if err := uc.configService.SaveConfig(ctx); err != nil {
+	if err := uc.saveConfig(ctx, func() error {
+		return uc.configService.RemoveGroup(ctx, name)
+	}); err != nil {
 		uc.logger.Error(ctx, "Failed to save configuration", err)
 		return gitfleetErrors.WrapConfigSave(err)
 	}
@@ -298,8 +358,12 @@ func (uc *ManageConfigUseCase) DiscoverRepositories(ctx context.Context) error {
 		return nil
 	}
 
-	// Save the updated configuration
-	if err := uc.configService.SaveConfig(ctx); err != nil {
+	// Discover again in the reloaded configuration, which may have registered some of them
+	reapply := func() error {
+		_, err := uc.configService.DiscoverRepositories(ctx)
+		return err
+	}
+	if err := uc.saveConfig(ctx, reapply); err != nil {
 		uc.logger.Error(ctx, "Failed to save configuration after discovery", err)
 		return gitfleetErrors.WrapConfigSave(err)
 	}
@@ -319,6 +383,11 @@ func (uc *ManageConfigUseCase) GetRepositories(ctx context.Context) ([]*entities
 	return uc.configService.GetAllRepositories(ctx)
 }
 
+// GetRepositoriesForGroups returns the repositories selected by the given groups
+func (uc *ManageConfigUseCase) GetRepositoriesForGroups(ctx context.Context, groups []string) ([]*entities.Repository, error) {
+	return uc.configService.GetRepositoriesForGroups(ctx, groups)
+}
+
 // SetTheme sets the UI theme
 func (uc *ManageConfigUseCase) SetTheme(ctx context.Context, theme string) error {
 	uc.logger.Info(ctx, "Setting theme", "theme", theme)
@@ -329,7 +398,9 @@ func (uc *ManageConfigUseCase) SetTheme(ctx context.Context, theme string) error
 	}
 
 	// Save configuration
-	if err := uc.configService.SaveConfig(ctx); err != nil {
+	if err := uc.saveConfig(ctx, func() error {
+		return uc.configService.SetTheme(ctx, theme)
+	}); err != nil {
 		uc.logger.Error(ctx, "Failed to save configuration", err)
 		return gitfleetErrors.WrapConfigSave(err)
 	}
@@ -337,3 +408,125 @@ func (uc *ManageConfigUseCase) SetTheme(ctx context.Context, theme string) error
 	uc.logger.Info(ctx, "Theme set successfully", "theme", theme)
 	return nil
 }
+
+// saveConfig saves the configuration once a change was applied to it. When the file
+// changed on disk since it was loaded, e.g. edited during a long run, the confirmer is
+// asked to reload it and apply the change again with reapply, so that the edits are
+// merged rather than overwritten. Declining leaves the file as it is.
+func (uc *ManageConfigUseCase) saveConfig(ctx context.Context, reapply func() error) error {
+	changed, err := uc.configService.ConfigChangedOnDisk(ctx)
+	if err != nil {
+		uc.logger.Warn(ctx, "Failed to check the configuration file for changes", "error", err)
+	}
+
+	if changed {
+		path := uc.configService.GetConfigPath()
+		if err := uc.confirmReload(ctx, path); err != nil {
+			return err
+		}
+
+		uc.logger.Warn(ctx, "Configuration file changed since it was loaded, reloading it to keep its changes",
+			"path", path)
+		if err := uc.configService.LoadConfig(ctx); err != nil {
+			return err
+		}
+		if err := reapply(); err != nil {
+			return err
+		}
+	}
+
+	return uc.configService.SaveConfig(ctx)
+}
+
+// confirmReload asks the confirmer to reload the configuration file changed since it was
+// loaded and apply the change to it
+func (uc *ManageConfigUseCase) confirmReload(ctx context.Context, path string) error {
+	if uc.confirmer == nil {
+		return nil
+	}
+
+	prompt := fmt.Sprintf("⚠️  %s changed since it was loaded, reload it and apply the change again", path)
+	confirmed, err := uc.confirmer.Confirm(ctx, prompt)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		uc.logger.Warn(ctx, "Reloading the changed configuration file was not confirmed", "path", path)
+		return gitfleetErrors.WrapConfigChangedOnDisk(path)
+	}
+
+	return nil
+}
+
+// BackupConfig writes a backup of the current configuration file and returns its path
+func (uc *ManageConfigUseCase) BackupConfig(ctx context.Context) (string, error) {
+	uc.logger.Info(ctx, "Backing up configuration")
+
+	path, err := uc.configRepo.Backup(ctx)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to back up configuration", err)
+		return "", err
+	}
+
+	uc.logger.Info(ctx, "Configuration backed up successfully", "path", path)
+	return path, nil
+}
+
+// ListConfigBackups returns the available configuration backups, newest first
+func (uc *ManageConfigUseCase) ListConfigBackups(ctx context.Context) ([]string, error) {
+	return uc.configRepo.ListBackups(ctx)
+}
+
+// RestoreConfig replaces the configuration with the named backup and reloads it
+func (uc *ManageConfigUseCase) RestoreConfig(ctx context.Context, name string) error {
+	uc.logger.Info(ctx, "Restoring configuration", "backup", name)
+
+	if err := uc.configRepo.RestoreBackup(ctx, name); err != nil {
+		uc.logger.Error(ctx, "Failed to restore configuration", err, "backup", name)
+		return err
+	}
+
+	// Reload so that the restored configuration is used from now on
+	if err := uc.configService.LoadConfig(ctx); err != nil {
+		uc.logger.Error(ctx, "Failed to load restored configuration", err)
+		return gitfleetErrors.WrapConfigLoad(err)
+	}
+
+	uc.logger.Info(ctx, "Configuration restored successfully", "backup", name)
+	return nil
+}
+
+// sortConfigListing returns the listing of the configuration sorted by the key. Without
+// a key the repositories follow the configured order; by name or path they ignore it,
+// and by group count the repositories in the most groups and the groups with the most
+// repositories come first. Ties are broken by name so that the listing is stable.
+func sortConfigListing(config *repositories.Config, sortBy string) *repositories.ConfigListing {
+	listing := repositories.NewConfigListing(config)
+
+	switch sortBy {
+	case ConfigSortByName:
+		sort.Strings(listing.Repositories)
+	case ConfigSortByPath:
+		sort.Strings(listing.Repositories)
+		sort.SliceStable(listing.Repositories, func(i, j int) bool {
+			return config.Repositories[listing.Repositories[i]].Path < config.Repositories[listing.Repositories[j]].Path
+		})
+	case ConfigSortByGroupCount:
+		groupCounts := make(map[string]int, len(config.Repositories))
+		for _, group := range config.Groups {
+			for _, name := range group.Repositories {
+				groupCounts[name]++
+			}
+		}
+		sort.Strings(listing.Repositories)
+		sort.SliceStable(listing.Repositories, func(i, j int) bool {
+			return groupCounts[listing.Repositories[i]] > groupCounts[listing.Repositories[j]]
+		})
+		sort.SliceStable(listing.Groups, func(i, j int) bool {
+			return len(config.Groups[listing.Groups[i]].Repositories) > len(config.Groups[listing.Groups[j]].Repositories)
+		})
+	}
+
+	return listing
+}
@@ -69,6 +69,21 @@ func (mr *MockManageConfigUCIMockRecorder) AddRepository(ctx, input any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRepository", reflect.TypeOf((*MockManageConfigUCI)(nil).AddRepository), ctx, input)
 }
 
+// BackupConfig mocks base method.
+func (m *MockManageConfigUCI) BackupConfig(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupConfig", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupConfig indicates an expected call of BackupConfig.
+func (mr *MockManageConfigUCIMockRecorder) BackupConfig(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupConfig", reflect.TypeOf((*MockManageConfigUCI)(nil).BackupConfig), ctx)
+}
+
 // CreateDefaultConfig mocks base method.
 func (m *MockManageConfigUCI) CreateDefaultConfig(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -127,6 +142,36 @@ func (mr *MockManageConfigUCIMockRecorder) GetRepositories(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositories", reflect.TypeOf((*MockManageConfigUCI)(nil).GetRepositories), ctx)
 }
 
+// GetRepositoriesForGroups mocks base method.
+func (m *MockManageConfigUCI) GetRepositoriesForGroups(ctx context.Context, groups []string) ([]*entities.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoriesForGroups", ctx, groups)
+	ret0, _ := ret[0].([]*entities.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoriesForGroups indicates an expected call of GetRepositoriesForGroups.
+func (mr *MockManageConfigUCIMockRecorder) GetRepositoriesForGroups(ctx, groups any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoriesForGroups", reflect.TypeOf((*MockManageConfigUCI)(nil).GetRepositoriesForGroups), ctx, groups)
+}
+
+// ListConfigBackups mocks base method.
+func (m *MockManageConfigUCI) ListConfigBackups(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConfigBackups", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigBackups indicates an expected call of ListConfigBackups.
+func (mr *MockManageConfigUCIMockRecorder) ListConfigBackups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigBackups", reflect.TypeOf((*MockManageConfigUCI)(nil).ListConfigBackups), ctx)
+}
+
 // RemoveGroup mocks base method.
 func (m *MockManageConfigUCI) RemoveGroup(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
@@ -155,6 +200,20 @@ func (mr *MockManageConfigUCIMockRecorder) RemoveRepository(ctx, name any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRepository", reflect.TypeOf((*MockManageConfigUCI)(nil).RemoveRepository), ctx, name)
 }
 
+// RestoreConfig mocks base method.
+func (m *MockManageConfigUCI) RestoreConfig(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreConfig", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreConfig indicates an expected call of RestoreConfig.
+func (mr *MockManageConfigUCIMockRecorder) RestoreConfig(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreConfig", reflect.TypeOf((*MockManageConfigUCI)(nil).RestoreConfig), ctx, name)
+}
+
 // SetTheme mocks base method.
 func (m *MockManageConfigUCI) SetTheme(ctx context.Context, theme string) error {
 	m.ctrl.T.Helper()
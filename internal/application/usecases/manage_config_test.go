@@ -2,13 +2,17 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"reflect"
 	"testing"
 
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/input"
 	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gitfleetErrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 	"github.com/qskkk/git-fleet/v2/internal/pkg/logger"
 	"go.uber.org/mock/gomock"
 )
@@ -127,11 +131,32 @@ func TestAddRepository(t *testing.T) {
 				loggerService.EXPECT().Info(gomock.Any(), "Adding repository", "name", "test-repo", "path", "/path/to/repo")
 				validationService.EXPECT().ValidatePath(gomock.Any(), "/path/to/repo").Return(nil)
 				configService.EXPECT().AddRepository(gomock.Any(), "test-repo", "/path/to/repo").Return(nil)
+				configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(false, nil)
 				configService.EXPECT().SaveConfig(gomock.Any()).Return(nil)
 				loggerService.EXPECT().Info(gomock.Any(), "Repository added successfully", "name", "test-repo")
 			},
 			expectedError: false,
 		},
+		{
+			name:     "configuration changed on disk",
+			repoName: "other-repo",
+			repoPath: "/path/to/other",
+			setupMocks: func() {
+				loggerService.EXPECT().Info(gomock.Any(), "Adding repository", "name", "other-repo", "path", "/path/to/other")
+				validationService.EXPECT().ValidatePath(gomock.Any(), "/path/to/other").Return(nil)
+				gomock.InOrder(
+					configService.EXPECT().AddRepository(gomock.Any(), "other-repo", "/path/to/other").Return(nil),
+					configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(true, nil),
+					configService.EXPECT().LoadConfig(gomock.Any()).Return(nil),
+					configService.EXPECT().AddRepository(gomock.Any(), "other-repo", "/path/to/other").Return(nil),
+					configService.EXPECT().SaveConfig(gomock.Any()).Return(nil),
+				)
+				configService.EXPECT().GetConfigPath().Return("/home/user/.gfconfig.json")
+				loggerService.EXPECT().Warn(gomock.Any(), "Configuration file changed since it was loaded, reloading it to keep its changes", "path", "/home/user/.gfconfig.json")
+				loggerService.EXPECT().Info(gomock.Any(), "Repository added successfully", "name", "other-repo")
+			},
+			expectedError: false,
+		},
 		{
 			name:     "validation error",
 			repoName: "test-repo",
@@ -166,6 +191,83 @@ func TestAddRepository(t *testing.T) {
 	}
 }
 
+func TestDiscoverRepositories(t *testing.T) {
+	discovered := []*entities.Repository{{Name: "api", Path: "/path/to/api"}}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(configService *services.MockConfigService, confirmer *input.MockConfirmationPort, loggerService *logger.MockService)
+		expectedError error
+	}{
+		{
+			name: "configuration unchanged on disk",
+			setupMocks: func(configService *services.MockConfigService, confirmer *input.MockConfirmationPort, loggerService *logger.MockService) {
+				gomock.InOrder(
+					configService.EXPECT().LoadConfig(gomock.Any()).Return(nil),
+					configService.EXPECT().DiscoverRepositories(gomock.Any()).Return(discovered, nil),
+					configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(false, nil),
+					configService.EXPECT().SaveConfig(gomock.Any()).Return(nil),
+				)
+			},
+		},
+		{
+			name: "reload confirmed",
+			setupMocks: func(configService *services.MockConfigService, confirmer *input.MockConfirmationPort, loggerService *logger.MockService) {
+				gomock.InOrder(
+					configService.EXPECT().LoadConfig(gomock.Any()).Return(nil),
+					configService.EXPECT().DiscoverRepositories(gomock.Any()).Return(discovered, nil),
+					configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(true, nil),
+					confirmer.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil),
+					configService.EXPECT().LoadConfig(gomock.Any()).Return(nil),
+					configService.EXPECT().DiscoverRepositories(gomock.Any()).Return(discovered, nil),
+					configService.EXPECT().SaveConfig(gomock.Any()).Return(nil),
+				)
+				configService.EXPECT().GetConfigPath().Return("/home/user/.gfconfig.json")
+				loggerService.EXPECT().Warn(gomock.Any(), "Configuration file changed since it was loaded, reloading it to keep its changes", "path", "/home/user/.gfconfig.json")
+			},
+		},
+		{
+			name: "reload declined",
+			setupMocks: func(configService *services.MockConfigService, confirmer *input.MockConfirmationPort, loggerService *logger.MockService) {
+				gomock.InOrder(
+					configService.EXPECT().LoadConfig(gomock.Any()).Return(nil),
+					configService.EXPECT().DiscoverRepositories(gomock.Any()).Return(discovered, nil),
+					configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(true, nil),
+					confirmer.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil),
+				)
+				configService.EXPECT().GetConfigPath().Return("/home/user/.gfconfig.json")
+				loggerService.EXPECT().Warn(gomock.Any(), "Reloading the changed configuration file was not confirmed", "path", "/home/user/.gfconfig.json")
+				loggerService.EXPECT().Error(gomock.Any(), "Failed to save configuration after discovery", gomock.Any())
+			},
+			expectedError: gitfleetErrors.ErrConfigChangedOnDisk,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			configService := services.NewMockConfigService(ctrl)
+			confirmer := input.NewMockConfirmationPort(ctrl)
+			loggerService := logger.NewMockService(ctrl)
+			loggerService.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			uc := NewManageConfigUseCase(repositories.NewMockConfigRepository(ctrl), configService, services.NewMockValidationService(ctrl), loggerService, output.NewMockPresenterPort(ctrl))
+			uc.SetConfirmer(confirmer)
+			tt.setupMocks(configService, confirmer, loggerService)
+
+			err := uc.DiscoverRepositories(context.Background())
+			if tt.expectedError == nil && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+			if tt.expectedError != nil && !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected %v but got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
 func TestRemoveRepository(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -190,6 +292,7 @@ func TestRemoveRepository(t *testing.T) {
 			setupMocks: func() {
 				loggerService.EXPECT().Info(gomock.Any(), "Removing repository", "name", "test-repo")
 				configService.EXPECT().RemoveRepository(gomock.Any(), "test-repo").Return(nil)
+				configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(false, nil)
 				configService.EXPECT().SaveConfig(gomock.Any()).Return(nil)
 				loggerService.EXPECT().Info(gomock.Any(), "Repository removed successfully", "name", "test-repo")
 			},
@@ -249,6 +352,7 @@ func TestAddGroup(t *testing.T) {
 				loggerService.EXPECT().Info(gomock.Any(), "Adding group", "name", "test-group", "repositories", []string{"repo1", "repo2"})
 				validationService.EXPECT().ValidateGroup(gomock.Any(), gomock.Any()).Return(nil)
 				configService.EXPECT().AddGroup(gomock.Any(), gomock.Any()).Return(nil)
+				configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(false, nil)
 				configService.EXPECT().SaveConfig(gomock.Any()).Return(nil)
 				loggerService.EXPECT().Info(gomock.Any(), "Group added successfully", "name", "test-group")
 			},
@@ -311,6 +415,7 @@ func TestRemoveGroup(t *testing.T) {
 			setupMocks: func() {
 				loggerService.EXPECT().Info(gomock.Any(), "Removing group", "name", "test-group")
 				configService.EXPECT().RemoveGroup(gomock.Any(), "test-group").Return(nil)
+				configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(false, nil)
 				configService.EXPECT().SaveConfig(gomock.Any()).Return(nil)
 				loggerService.EXPECT().Info(gomock.Any(), "Group removed successfully", "name", "test-group")
 			},
@@ -573,6 +678,33 @@ func TestGetRepositories(t *testing.T) {
 	}
 }
 
+func TestGetRepositoriesForGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	configRepo := repositories.NewMockConfigRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	validationService := services.NewMockValidationService(ctrl)
+	loggerService := logger.NewMockService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	uc := NewManageConfigUseCase(configRepo, configService, validationService, loggerService, presenter)
+
+	expectedRepos := []*entities.Repository{
+		{Name: "repo1", Path: "/path/to/repo1"},
+	}
+
+	configService.EXPECT().GetRepositoriesForGroups(gomock.Any(), []string{"group1"}).Return(expectedRepos, nil)
+
+	repos, err := uc.GetRepositoriesForGroups(context.Background(), []string{"group1"})
+	if err != nil {
+		t.Errorf("Expected no error but got: %v", err)
+	}
+	if len(repos) != len(expectedRepos) {
+		t.Errorf("Expected %d repositories but got %d", len(expectedRepos), len(repos))
+	}
+}
+
 func TestSetTheme(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -596,6 +728,7 @@ func TestSetTheme(t *testing.T) {
 			theme: "dark",
 			setupMocks: func() {
 				configService.EXPECT().SetTheme(gomock.Any(), "dark").Return(nil)
+				configService.EXPECT().ConfigChangedOnDisk(gomock.Any()).Return(false, nil)
 				configService.EXPECT().SaveConfig(gomock.Any()).Return(nil)
 				loggerService.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
 			},
@@ -629,3 +762,169 @@ func TestSetTheme(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*repositories.MockConfigRepository, *services.MockConfigService)
+		expectedError bool
+	}{
+		{
+			name: "restores and reloads",
+			setupMocks: func(configRepo *repositories.MockConfigRepository, configService *services.MockConfigService) {
+				configRepo.EXPECT().RestoreBackup(gomock.Any(), "backup.bak").Return(nil)
+				configService.EXPECT().LoadConfig(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "restore error",
+			setupMocks: func(configRepo *repositories.MockConfigRepository, configService *services.MockConfigService) {
+				configRepo.EXPECT().RestoreBackup(gomock.Any(), "backup.bak").Return(errors.New("not found"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "reload error",
+			setupMocks: func(configRepo *repositories.MockConfigRepository, configService *services.MockConfigService) {
+				configRepo.EXPECT().RestoreBackup(gomock.Any(), "backup.bak").Return(nil)
+				configService.EXPECT().LoadConfig(gomock.Any()).Return(errors.New("invalid"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			configRepo := repositories.NewMockConfigRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			loggerService := logger.NewMockService(ctrl)
+			loggerService.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			loggerService.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			loggerService.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(configRepo, configService)
+
+			uc := NewManageConfigUseCase(configRepo, configService, services.NewMockValidationService(ctrl), loggerService, output.NewMockPresenterPort(ctrl))
+
+			err := uc.RestoreConfig(context.Background(), "backup.bak")
+
+			if tt.expectedError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestShowConfig_JSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	configRepo := repositories.NewMockConfigRepository(ctrl)
+	loggerService := logger.NewMockService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+	uc := NewManageConfigUseCase(configRepo, services.NewMockConfigService(ctrl), services.NewMockValidationService(ctrl), loggerService, presenter)
+
+	config := &repositories.Config{
+		Version: 1,
+		Repositories: map[string]*repositories.RepositoryConfig{
+			"web": {Path: "/src/./web", Tags: []string{"frontend"}, Timeout: "30s"},
+			"api": {Path: "/src/api", Tags: []string{"backend"}, Description: "public API"},
+		},
+		Groups: map[string]*entities.Group{
+			"all":      {Name: "all", Repositories: []string{"api", "web"}},
+			"frontend": {Name: "frontend", Repositories: []string{"web"}},
+			"backend": {Name: "backend", Composition: &entities.GroupComposition{
+				Operation: entities.CompositionSubtract,
+				Groups:    []string{"all", "frontend"},
+			}},
+		},
+	}
+
+	loggerService.EXPECT().Info(gomock.Any(), "Showing configuration", "input", gomock.Any())
+	configRepo.EXPECT().Load(gomock.Any()).Return(config, nil)
+
+	result, err := uc.ShowConfig(context.Background(), &ShowConfigInput{JSON: true})
+	if err != nil {
+		t.Fatalf("ShowConfig returned error: %v", err)
+	}
+
+	var resolved ResolvedConfig
+	if err := json.Unmarshal([]byte(result.FormattedOutput), &resolved); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, result.FormattedOutput)
+	}
+
+	if len(resolved.Repositories) != 2 || resolved.Repositories[0].Name != "api" {
+		t.Fatalf("expected repositories sorted by name, got %+v", resolved.Repositories)
+	}
+	if api := resolved.Repositories[0]; api.Description != "public API" {
+		t.Errorf("expected the repository description, got %+v", api)
+	}
+	if web := resolved.Repositories[1]; web.Path != "/src/web" || web.Timeout != "30s" {
+		t.Errorf("expected cleaned path and parsed timeout, got %+v", web)
+	}
+	if len(resolved.Groups) != 3 || resolved.Groups[1].Name != "backend" {
+		t.Fatalf("expected groups sorted by name, got %+v", resolved.Groups)
+	}
+	if backend := resolved.Groups[1]; !reflect.DeepEqual(backend.Repositories, []string{"api"}) || backend.Composition == "" {
+		t.Errorf("expected composed group resolved to its members, got %+v", backend)
+	}
+	if !reflect.DeepEqual(resolved.Tags["frontend"], []string{"web"}) {
+		t.Errorf("expected tag members, got %v", resolved.Tags)
+	}
+}
+
+func TestSortConfigListing(t *testing.T) {
+	config := &repositories.Config{
+		Repositories: map[string]*repositories.RepositoryConfig{
+			"web":  {Path: "/src/a-web"},
+			"api":  {Path: "/src/c-api"},
+			"docs": {Path: "/src/b-docs"},
+		},
+		Order: []string{"web"},
+		Groups: map[string]*entities.Group{
+			"all":      {Name: "all", Repositories: []string{"api", "docs", "web"}},
+			"backend":  {Name: "backend", Repositories: []string{"api"}},
+			"services": {Name: "services", Repositories: []string{"api", "web"}},
+		},
+	}
+
+	tests := []struct {
+		sortBy       string
+		repositories []string
+		groups       []string
+	}{
+		{"", []string{"web", "api", "docs"}, []string{"all", "backend", "services"}},
+		{ConfigSortByName, []string{"api", "docs", "web"}, []string{"all", "backend", "services"}},
+		{ConfigSortByPath, []string{"web", "docs", "api"}, []string{"all", "backend", "services"}},
+		{ConfigSortByGroupCount, []string{"api", "web", "docs"}, []string{"all", "services", "backend"}},
+	}
+
+	for _, tt := range tests {
+		// Map iteration order varies between runs, so each sort is repeated to check it is stable
+		for range 20 {
+			listing := sortConfigListing(config, tt.sortBy)
+			if !reflect.DeepEqual(listing.Repositories, tt.repositories) || !reflect.DeepEqual(listing.Groups, tt.groups) {
+				t.Fatalf("sortConfigListing(%q) = %v, %v, want %v, %v", tt.sortBy, listing.Repositories, listing.Groups, tt.repositories, tt.groups)
+			}
+		}
+	}
+}
+
+func TestShowConfig_InvalidSortKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loggerService := logger.NewMockService(ctrl)
+	uc := NewManageConfigUseCase(repositories.NewMockConfigRepository(ctrl), services.NewMockConfigService(ctrl), services.NewMockValidationService(ctrl), loggerService, output.NewMockPresenterPort(ctrl))
+
+	loggerService.EXPECT().Info(gomock.Any(), "Showing configuration", "input", gomock.Any())
+
+	if _, err := uc.ShowConfig(context.Background(), &ShowConfigInput{SortBy: "dirty"}); !gitfleetErrors.IsError(err, gitfleetErrors.ErrInvalidSortKey) {
+		t.Errorf("expected ErrInvalidSortKey, got %v", err)
+	}
+}
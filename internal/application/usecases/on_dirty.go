@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+// Policies of ExecuteCommandInput.OnDirty for the repositories with uncommitted changes
+// when pulling or switching branches
+const (
+	OnDirtyFail  = "fail"
+	OnDirtySkip  = "skip"
+	OnDirtyStash = "stash"
+)
+
+// OnDirtyPolicies lists the supported policies for repositories with uncommitted changes
+var OnDirtyPolicies = []string{OnDirtyFail, OnDirtySkip, OnDirtyStash}
+
+// dirtyPolicySubcommands are the git subcommands the OnDirty policy applies to, which
+// refuse to run when uncommitted changes would be overwritten
+var dirtyPolicySubcommands = []string{"pull", "checkout", "switch"}
+
+// appliesDirtyPolicy reports whether the OnDirty policy applies to the command
+func appliesDirtyPolicy(command *entities.Command) bool {
+	return command.IsGitCommand() && slices.Contains(dirtyPolicySubcommands, command.Subcommand())
+}
+
+// splitDirtyRepositories separates the repositories with uncommitted changes from the
+// others. Repositories whose changes cannot be read are kept so that git reports the
+// problem.
+func (uc *ExecuteCommandUseCase) splitDirtyRepositories(ctx context.Context, repositories []*entities.Repository) (clean, dirty []*entities.Repository) {
+	clean = make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		hasChanges, err := uc.gitRepo.HasUncommittedChanges(ctx, repo)
+		if err != nil {
+			uc.logger.Debug(ctx, "Failed to check uncommitted changes", "repository", repo.Name, "error", err)
+		}
+		if err == nil && hasChanges {
+			dirty = append(dirty, repo)
+		} else {
+			clean = append(clean, repo)
+		}
+	}
+
+	return clean, dirty
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// upToDateMessages are printed by git pull when there was nothing to pull, by recent
+// and older versions
+var upToDateMessages = []string{"Already up to date", "Already up-to-date"}
+
+// divergedMessages are printed by git pull --ff-only when the branch has commits of its
+// own, lowercased
+var divergedMessages = []string{"not possible to fast-forward", "diverging branches"}
+
+// DivergedRepository is a repository whose branch could not be fast-forwarded because it
+// has commits of its own, with the number of commits on each side when they could be read
+type DivergedRepository struct {
+	Repository string `json:"repository"`
+	Ahead      int    `json:"ahead"`
+	Behind     int    `json:"behind"`
+	Error      string `json:"error,omitempty"`
+}
+
+// PullReport classifies the repositories of a fast-forward-only pull. Skipped
+// repositories are only part of the summary of the output.
+type PullReport struct {
+	Output   *ExecuteCommandOutput `json:"-"`
+	Updated  []string              `json:"updated"`
+	Current  []string              `json:"current"`
+	Diverged []*DivergedRepository `json:"diverged"`
+	Failed   []string              `json:"failed"`
+}
+
+// PullFastForward runs the pull of the input with --ff-only, adding it when missing, and
+// classifies each repository as updated, already current, diverged or failed. Diverged
+// repositories, which need a manual merge or rebase, are reported with their ahead/behind
+// counts.
+func (uc *ExecuteCommandUseCase) PullFastForward(ctx context.Context, input *ExecuteCommandInput) (*PullReport, error) {
+	fields := strings.Fields(input.CommandStr)
+	if len(fields) > 0 && fields[0] == "git" {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 || fields[0] != "pull" {
+		return nil, errors.ErrUsagePullReport
+	}
+
+	pullInput := *input
+	if !slices.Contains(fields, "--ff-only") {
+		pullInput.CommandStr += " --ff-only"
+	}
+
+	output, err := uc.Execute(ctx, &pullInput)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PullReport{Output: output}
+	var diverged []string
+	for _, result := range output.Summary.Results {
+		switch {
+		case result.IsSuccess() && isAlreadyUpToDate(result.Output):
+			report.Current = append(report.Current, result.Repository)
+		case result.IsSuccess():
+			report.Updated = append(report.Updated, result.Repository)
+		case result.IsFailed() && isDiverged(result.ErrorOutput):
+			diverged = append(diverged, result.Repository)
+		case !result.IsSkipped():
+			report.Failed = append(report.Failed, result.Repository)
+		}
+	}
+
+	report.Diverged = uc.divergedRepositories(ctx, input.Groups, diverged)
+	return report, nil
+}
+
+// isAlreadyUpToDate reports whether the output of a pull tells that there was nothing to pull
+func isAlreadyUpToDate(output string) bool {
+	for _, message := range upToDateMessages {
+		if strings.Contains(output, message) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDiverged reports whether the error output of a pull tells that it could not fast-forward
+func isDiverged(errorOutput string) bool {
+	errorOutput = strings.ToLower(errorOutput)
+	for _, message := range divergedMessages {
+		if strings.Contains(errorOutput, message) {
+			return true
+		}
+	}
+	return false
+}
+
+// divergedRepositories returns the named repositories of the groups with how far their
+// branch and its upstream went apart, sorted by name
+func (uc *ExecuteCommandUseCase) divergedRepositories(ctx context.Context, groups []string, names []string) []*DivergedRepository {
+	if len(names) == 0 {
+		return nil
+	}
+
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Warn(ctx, "Failed to get repositories for groups", "groups", groups, "error", err)
+	}
+	repos = filterRepositoriesByName(repos, names)
+	sortRepositories(repos, SortByName)
+
+	diverged := make([]*DivergedRepository, 0, len(repos))
+	for _, repo := range repos {
+		entry := &DivergedRepository{Repository: repo.Name}
+		diverged = append(diverged, entry)
+
+		ahead, behind, err := uc.gitRepo.GetAheadBehind(ctx, repo)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get ahead/behind counts", "repository", repo.Name, "error", err)
+			entry.Error = err.Error()
+			continue
+		}
+		entry.Ahead = ahead
+		entry.Behind = behind
+	}
+
+	return diverged
+}
@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+	loggerPkg "github.com/qskkk/git-fleet/v2/internal/pkg/logger"
+)
+
+func TestPullFastForward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := newValidGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	executionService := services.NewMockExecutionService(ctrl)
+	validationService := services.NewMockValidationService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, executionService, validationService, logger, presenter)
+
+	ctx := context.Background()
+	input := &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "pull", Parallel: true}
+	cmd := entities.NewGitCommand([]string{"pull", "--ff-only"})
+	api := &entities.Repository{Name: "api"}
+	web := &entities.Repository{Name: "web"}
+	legacy := &entities.Repository{Name: "legacy"}
+	broken := &entities.Repository{Name: "broken"}
+	repos := []*entities.Repository{api, web, legacy, broken}
+
+	summary := entities.NewSummary()
+	updated := entities.NewExecutionResult("api", "git pull --ff-only")
+	updated.MarkAsSuccess("Updating 1a2b3c..4d5e6f\nFast-forward\n", 0)
+	summary.AddResult(*updated)
+	current := entities.NewExecutionResult("web", "git pull --ff-only")
+	current.MarkAsSuccess("Already up to date.\n", 0)
+	summary.AddResult(*current)
+	diverged := entities.NewExecutionResult("legacy", "git pull --ff-only")
+	diverged.MarkAsFailed("hint: Diverging branches can't be fast-forwarded\nfatal: Not possible to fast-forward, aborting.\n", 128, "")
+	summary.AddResult(*diverged)
+	failed := entities.NewExecutionResult("broken", "git pull --ff-only")
+	failed.MarkAsFailed("fatal: Could not read from remote repository.\n", 128, "")
+	summary.AddResult(*failed)
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().GetLevel().Return(loggerPkg.WARN).AnyTimes()
+	executionService.EXPECT().ParseCommand(ctx, "pull --ff-only").Return(cmd, nil)
+	validationService.EXPECT().ValidateCommand(ctx, cmd).Return(nil)
+	executionService.EXPECT().IsBuiltInCommand("pull").Return(false)
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return(repos, nil).Times(2)
+	executorRepo.EXPECT().ExecuteInParallel(ctx, repos, cmd).Return(summary, nil)
+	presenter.EXPECT().PresentSummary(ctx, summary).Return("formatted output", nil)
+	gitRepo.EXPECT().GetAheadBehind(ctx, legacy).Return(2, 5, nil)
+
+	report, err := useCase.PullFastForward(ctx, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(report.Updated, []string{"api"}) || !reflect.DeepEqual(report.Current, []string{"web"}) || !reflect.DeepEqual(report.Failed, []string{"broken"}) {
+		t.Errorf("unexpected classification: updated %v, current %v, failed %v", report.Updated, report.Current, report.Failed)
+	}
+	expected := []*DivergedRepository{{Repository: "legacy", Ahead: 2, Behind: 5}}
+	if !reflect.DeepEqual(report.Diverged, expected) {
+		t.Errorf("Diverged = %+v, want %+v", report.Diverged, expected)
+	}
+	if input.CommandStr != "pull" {
+		t.Errorf("PullFastForward() should not change the input, got %q", input.CommandStr)
+	}
+}
+
+func TestPullFastForward_NotAPull(t *testing.T) {
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := useCase.PullFastForward(context.Background(), &ExecuteCommandInput{Groups: []string{"all"}, CommandStr: "fetch"})
+	if !gferrors.IsError(err, gferrors.ErrUsagePullReport) {
+		t.Errorf("PullFastForward() error = %v, want ErrUsagePullReport", err)
+	}
+}
@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// Warnings of the repositories whose rebase stopped on conflicts
+const (
+	RebaseConflictWarning = "rebase stopped on conflicts; resolve them and run git rebase --continue, or git rebase --abort"
+	RebaseAbortedWarning  = "rebase aborted on conflicts; the branch is unchanged"
+)
+
+// RebaseOntoInput represents input for rebasing the repositories of groups onto a branch
+type RebaseOntoInput struct {
+	Groups          []string `json:"groups"`
+	Branch          string   `json:"branch"`
+	AbortOnConflict bool     `json:"abort_on_conflict,omitempty"`
+}
+
+// RebaseOnto fetches each repository of the groups and rebases its current branch onto
+// the branch. Repositories whose rebase stops on conflicts are reported as warnings and
+// left mid-rebase for manual resolution, or restored with git rebase --abort when
+// AbortOnConflict is set. Other failures are reported as failures.
+func (uc *ExecuteCommandUseCase) RebaseOnto(ctx context.Context, input *RebaseOntoInput) (*ExecuteCommandOutput, error) {
+	uc.logger.Info(ctx, "Starting rebase", "groups", input.Groups, "branch", input.Branch, "abort_on_conflict", input.AbortOnConflict)
+
+	if len(input.Groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+	if input.Branch == "" || strings.HasPrefix(input.Branch, "-") {
+		return nil, errors.ErrUsageRebaseOnto
+	}
+
+	repositories, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	command := rebaseOntoCommand(input.Branch)
+
+	summary := entities.NewSummaryWithClock(uc.executorRepo.Clock())
+	if len(repositories) > 0 {
+		summary, err = uc.executorRepo.ExecuteInParallel(ctx, repositories, command)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to rebase", err, "branch", input.Branch, "repositories", len(repositories))
+			return nil, errors.WrapFailedToExecuteCommand(err)
+		}
+	} else {
+		summary.Finalize()
+	}
+
+	summary = uc.reportRebaseConflicts(ctx, summary, repositories, input.AbortOnConflict)
+
+	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to format output", err)
+		formattedOutput = "Error formatting output"
+	}
+
+	return &ExecuteCommandOutput{
+		Summary:         summary,
+		FormattedOutput: formattedOutput,
+		Success:         !summary.HasFailures(),
+	}, nil
+}
+
+// rebaseOntoCommand returns the command fetching the repository and rebasing it onto the
+// branch once the fetch succeeded
+func rebaseOntoCommand(branch string) *entities.Command {
+	return entities.NewShellCommand([]string{"git fetch && git rebase " + quoteShellWord(branch)})
+}
+
+// reportRebaseConflicts returns the summary with the failed repositories left mid-rebase
+// turned into warnings, aborting their rebase first with abort. A rebase that cannot be
+// aborted stays a failure.
+func (uc *ExecuteCommandUseCase) reportRebaseConflicts(ctx context.Context, summary *entities.Summary, repositories []*entities.Repository, abort bool) *entities.Summary {
+	byName := make(map[string]*entities.Repository, len(repositories))
+	for _, repo := range repositories {
+		byName[repo.Name] = repo
+	}
+
+	// The counts of the summary follow its results, so it is rebuilt
+	reported := entities.NewSummaryWithClock(uc.executorRepo.Clock())
+	reported.StartTime = summary.StartTime
+	for _, result := range summary.Results {
+		repo := byName[result.Repository]
+		if result.IsFailed() && repo != nil && uc.isRebasing(ctx, repo) {
+			uc.resolveRebaseConflict(ctx, repo, &result, abort)
+		}
+		reported.AddResult(result)
+	}
+	reported.EndTime = summary.EndTime
+
+	return reported
+}
+
+// isRebasing reports whether a rebase is in progress in the repository
+func (uc *ExecuteCommandUseCase) isRebasing(ctx context.Context, repo *entities.Repository) bool {
+	operation, err := uc.gitRepo.GetInProgressOperation(ctx, repo)
+	if err != nil {
+		uc.logger.Debug(ctx, "Failed to get in-progress operation", "repository", repo.Name, "error", err)
+		return false
+	}
+	return operation == entities.OperationRebase
+}
+
+// resolveRebaseConflict reports the conflicted rebase of the repository as a warning,
+// aborting it first with abort
+func (uc *ExecuteCommandUseCase) resolveRebaseConflict(ctx context.Context, repo *entities.Repository, result *entities.ExecutionResult, abort bool) {
+	if !abort {
+		result.MarkAsWarning(RebaseConflictWarning)
+		return
+	}
+
+	aborted, err := uc.gitRepo.ExecuteCommand(ctx, repo, entities.NewGitCommand([]string{"rebase", "--abort"}))
+	if err != nil || !aborted.IsSuccess() {
+		uc.logger.Warn(ctx, "Failed to abort rebase", "repository", repo.Name, "error", err)
+		result.ErrorMessage = "rebase stopped on conflicts and could not be aborted"
+		return
+	}
+	result.MarkAsWarning(RebaseAbortedWarning)
+}
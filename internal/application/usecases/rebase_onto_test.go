@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRebaseOnto(t *testing.T) {
+	tests := []struct {
+		name            string
+		abortOnConflict bool
+		expectedWarning string
+	}{
+		{name: "conflicts are left to resolve", expectedWarning: RebaseConflictWarning},
+		{name: "conflicts are aborted", abortOnConflict: true, expectedWarning: RebaseAbortedWarning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gitRepo := repositories.NewMockGitRepository(ctrl)
+			executorRepo := repositories.NewMockExecutorRepository(ctrl)
+			configService := services.NewMockConfigService(ctrl)
+			logger := services.NewMockLoggingService(ctrl)
+			presenter := output.NewMockPresenterPort(ctrl)
+
+			useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, nil, nil, logger, presenter)
+
+			ctx := context.Background()
+			api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+			web := &entities.Repository{Name: "web", Path: "/path/to/web"}
+			cli := &entities.Repository{Name: "cli", Path: "/path/to/cli"}
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"features"}).Return([]*entities.Repository{api, web, cli}, nil)
+			executorRepo.EXPECT().Clock().Return(clock.New()).AnyTimes()
+			executorRepo.EXPECT().ExecuteInParallel(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+					if cmd.GetFullCommand() != "git fetch && git rebase 'origin/main'" {
+						t.Errorf("command = %q, want the fetch and rebase", cmd.GetFullCommand())
+					}
+					summary := entities.NewSummary()
+					rebased := entities.NewExecutionResult("api", cmd.GetFullCommand())
+					rebased.MarkAsSuccess("", 0)
+					conflicted := entities.NewExecutionResult("web", cmd.GetFullCommand())
+					conflicted.MarkAsFailed("error: could not apply 1a2b3c", 1, "exit status 1")
+					failed := entities.NewExecutionResult("cli", cmd.GetFullCommand())
+					failed.MarkAsFailed("fatal: Could not read from remote repository.", 128, "exit status 128")
+					summary.AddResult(*rebased)
+					summary.AddResult(*conflicted)
+					summary.AddResult(*failed)
+					return summary, nil
+				})
+			gitRepo.EXPECT().GetInProgressOperation(ctx, web).Return(entities.OperationRebase, nil)
+			gitRepo.EXPECT().GetInProgressOperation(ctx, cli).Return("", nil)
+			if tt.abortOnConflict {
+				aborted := entities.NewExecutionResult("web", "git rebase --abort")
+				aborted.MarkAsSuccess("", 0)
+				gitRepo.EXPECT().ExecuteCommand(ctx, web, entities.NewGitCommand([]string{"rebase", "--abort"})).Return(aborted, nil)
+			}
+			presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+			output, err := useCase.RebaseOnto(ctx, &RebaseOntoInput{Groups: []string{"features"}, Branch: "origin/main", AbortOnConflict: tt.abortOnConflict})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			summary := output.Summary
+			if summary.SuccessfulCount() != 2 || summary.FailedCount() != 1 || output.Success {
+				t.Errorf("Expected the conflict to count as a success and the fetch failure as a failure, got %+v", summary.Results)
+			}
+			if warning := summary.Results[1].Warning; warning != tt.expectedWarning {
+				t.Errorf("web warning = %q, want %q", warning, tt.expectedWarning)
+			}
+		})
+	}
+}
+
+func TestRebaseOnto_UsesExecutorClock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, nil, executorRepo, configService, nil, nil, logger, presenter)
+
+	ctx := context.Background()
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"features"}).Return([]*entities.Repository{}, nil)
+	executorRepo.EXPECT().Clock().Return(clock.NewFake(start, time.Second)).AnyTimes()
+	presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+	output, err := useCase.RebaseOnto(ctx, &RebaseOntoInput{Groups: []string{"features"}, Branch: "origin/main"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !output.Summary.StartTime.Equal(start) || output.Summary.EndTime.Sub(output.Summary.StartTime) != time.Second {
+		t.Errorf("summary timed %v to %v, want the executor's clock", output.Summary.StartTime, output.Summary.EndTime)
+	}
+}
+
+func TestRebaseOnto_InvalidBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := services.NewMockLoggingService(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, nil, nil, logger, nil)
+
+	for _, branch := range []string{"", "--onto"} {
+		_, err := useCase.RebaseOnto(context.Background(), &RebaseOntoInput{Groups: []string{"all"}, Branch: branch})
+		if !errors.IsError(err, errors.ErrUsageRebaseOnto) {
+			t.Errorf("RebaseOnto(%q) error = %v, want %v", branch, err, errors.ErrUsageRebaseOnto)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// RemoteURL is a remote of a repository and its URL
+type RemoteURL struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// RepositoryRemotes holds the remotes of a repository. OtherHost is set when the host of
+// its main remote, origin unless configured, differs from the one most repositories use.
+type RepositoryRemotes struct {
+	Repository string      `json:"repository"`
+	Remotes    []RemoteURL `json:"remotes"`
+	Host       string      `json:"host,omitempty"`
+	OtherHost  bool        `json:"other_host,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// GetRemotes returns the remotes and their URLs of each repository of the given groups,
+// sorted by name. Repositories whose main remote is on another host than the one of the
+// majority are marked, as they may still point at an old host after a migration, while
+// those whose remotes cannot be read are reported with an error.
+func (uc *StatusReportUseCase) GetRemotes(ctx context.Context, groups []string) ([]*RepositoryRemotes, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	remotes := make([]*RepositoryRemotes, 0, len(repos))
+	hostCounts := make(map[string]int)
+	for _, repo := range repos {
+		repoRemotes := &RepositoryRemotes{Repository: repo.Name, Remotes: []RemoteURL{}}
+		remotes = append(remotes, repoRemotes)
+
+		names, err := uc.gitRepo.GetRemotes(ctx, repo)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get remotes", "repository", repo.Name, "error", err)
+			repoRemotes.Error = err.Error()
+			continue
+		}
+
+		for _, name := range names {
+			remoteURL, err := uc.gitRepo.GetRemoteURL(ctx, repo, name)
+			if err != nil {
+				uc.logger.Warn(ctx, "Failed to get remote URL", "repository", repo.Name, "remote", name, "error", err)
+				repoRemotes.Error = err.Error()
+				continue
+			}
+			repoRemotes.Remotes = append(repoRemotes.Remotes, RemoteURL{Name: name, URL: remoteURL})
+			if name == repo.RemoteName() {
+				repoRemotes.Host = remoteHost(remoteURL)
+			}
+		}
+
+		if repoRemotes.Host != "" {
+			hostCounts[repoRemotes.Host]++
+		}
+	}
+
+	if majority := majorityHost(hostCounts); majority != "" {
+		for _, repoRemotes := range remotes {
+			repoRemotes.OtherHost = repoRemotes.Host != "" && repoRemotes.Host != majority
+		}
+	}
+
+	return remotes, nil
+}
+
+// remoteHost returns the host of a remote URL, for both URLs and scp-like addresses such
+// as git@host:org/name.git, or an empty string for local paths
+func remoteHost(remoteURL string) string {
+	if strings.Contains(remoteURL, "://") {
+		parsed, err := url.Parse(remoteURL)
+		if err != nil {
+			return ""
+		}
+		return strings.ToLower(parsed.Hostname())
+	}
+
+	// scp-like addresses have a colon before any slash
+	colon := strings.Index(remoteURL, ":")
+	if colon < 0 || strings.Contains(remoteURL[:colon], "/") {
+		return ""
+	}
+	host := remoteURL[:colon]
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		host = host[at+1:]
+	}
+	return strings.ToLower(host)
+}
+
+// majorityHost returns the host used by the most repositories, or an empty string when
+// fewer than two hosts are used or the most used ones are tied
+func majorityHost(hostCounts map[string]int) string {
+	if len(hostCounts) < 2 {
+		return ""
+	}
+
+	majority, best, tied := "", 0, false
+	for host, count := range hostCounts {
+		switch {
+		case count > best:
+			majority, best, tied = host, count, false
+		case count == best:
+			tied = true
+		}
+	}
+
+	if tied {
+		return ""
+	}
+	return majority
+}
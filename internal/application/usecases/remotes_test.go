@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+)
+
+func TestStatusReportUseCase_GetRemotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	legacy := &entities.Repository{Name: "legacy", Path: "/path/legacy", Remote: "upstream"}
+	scratch := &entities.Repository{Name: "scratch", Path: "/path/scratch"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, scratch, legacy, broken, api}, nil)
+	mockGitRepo.EXPECT().GetRemotes(ctx, api).Return([]string{"origin", "fork"}, nil)
+	mockGitRepo.EXPECT().GetRemoteURL(ctx, api, "origin").Return("git@github.com:acme/api.git", nil)
+	mockGitRepo.EXPECT().GetRemoteURL(ctx, api, "fork").Return("https://gitlab.com/me/api.git", nil)
+	mockGitRepo.EXPECT().GetRemotes(ctx, web).Return([]string{"origin"}, nil)
+	mockGitRepo.EXPECT().GetRemoteURL(ctx, web, "origin").Return("https://GitHub.com/acme/web", nil)
+	mockGitRepo.EXPECT().GetRemotes(ctx, legacy).Return([]string{"upstream"}, nil)
+	mockGitRepo.EXPECT().GetRemoteURL(ctx, legacy, "upstream").Return("ssh://git@old.example.com:2222/acme/legacy.git", nil)
+	mockGitRepo.EXPECT().GetRemotes(ctx, scratch).Return([]string{}, nil)
+	mockGitRepo.EXPECT().GetRemotes(ctx, broken).Return(nil, errors.New("not a git repository"))
+	mockLogger.EXPECT().Warn(ctx, "Failed to get remotes", gomock.Any()).Times(1)
+
+	remotes, err := usecase.GetRemotes(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(remotes) != 5 || remotes[0].Repository != "api" || remotes[2].Repository != "legacy" {
+		t.Fatalf("GetRemotes() should return every repository by name, got %+v", remotes)
+	}
+	if api := remotes[0]; len(api.Remotes) != 2 || api.Host != "github.com" || api.OtherHost {
+		t.Errorf("api = %+v, want two remotes on the majority host", api)
+	}
+	if legacy := remotes[2]; legacy.Host != "old.example.com" || !legacy.OtherHost {
+		t.Errorf("legacy = %+v, want its configured remote flagged on another host", legacy)
+	}
+	if scratch := remotes[3]; len(scratch.Remotes) != 0 || scratch.OtherHost || scratch.Error != "" {
+		t.Errorf("scratch = %+v, want no remotes", scratch)
+	}
+	if broken := remotes[1]; broken.Error == "" {
+		t.Error("unreadable repository should report an error")
+	}
+}
+
+func TestRemoteHost(t *testing.T) {
+	tests := map[string]string{
+		"git@github.com:acme/api.git":         "github.com",
+		"https://user@GitLab.com/acme/api":    "gitlab.com",
+		"ssh://git@example.com:2222/acme/api": "example.com",
+		"github.com:acme/api.git":             "github.com",
+		"/srv/git/api.git":                    "",
+		"../api":                              "",
+	}
+
+	for remoteURL, want := range tests {
+		if got := remoteHost(remoteURL); got != want {
+			t.Errorf("remoteHost(%q) = %q, want %q", remoteURL, got, want)
+		}
+	}
+}
+
+func TestMajorityHost(t *testing.T) {
+	if got := majorityHost(map[string]int{"github.com": 3, "gitlab.com": 1}); got != "github.com" {
+		t.Errorf("majorityHost() = %q, want github.com", got)
+	}
+	if got := majorityHost(map[string]int{"github.com": 2, "gitlab.com": 2}); got != "" {
+		t.Errorf("majorityHost() with a tie = %q, want none", got)
+	}
+	if got := majorityHost(map[string]int{"github.com": 4}); got != "" {
+		t.Errorf("majorityHost() with a single host = %q, want none", got)
+	}
+}
@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// maxSizeWalkers bounds the number of repositories walked at the same time, so that
+// measuring a large group does not exhaust file descriptors or thrash the disk
+const maxSizeWalkers = 4
+
+// RepositorySize holds the disk usage of a repository
+type RepositorySize struct {
+	Repository string                 `json:"repository"`
+	Usage      repositories.DiskUsage `json:"usage"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// GetSizes returns the disk usage of the repositories in the given groups, largest first.
+// When gitOnly is true only the git directories are measured. Repositories that cannot
+// be measured are reported with an error after the others.
+func (uc *StatusReportUseCase) GetSizes(ctx context.Context, groups []string, gitOnly bool) ([]*RepositorySize, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	sizes := make([]*RepositorySize, len(repos))
+	semaphore := make(chan struct{}, maxSizeWalkers)
+	var wg sync.WaitGroup
+
+	for i, repo := range repos {
+		size := &RepositorySize{Repository: repo.Name}
+		sizes[i] = size
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			usage, err := uc.gitRepo.GetDiskUsage(ctx, repo, gitOnly)
+			if err != nil {
+				uc.logger.Warn(ctx, "Failed to get disk usage", "repository", repo.Name, "error", err)
+				size.Error = err.Error()
+				return
+			}
+			size.Usage = *usage
+		}()
+	}
+
+	wg.Wait()
+
+	sort.SliceStable(sizes, func(i, j int) bool {
+		if (sizes[i].Error == "") != (sizes[j].Error == "") {
+			return sizes[i].Error == ""
+		}
+		return sizes[i].Usage.Total() > sizes[j].Usage.Total()
+	})
+
+	return sizes, nil
+}
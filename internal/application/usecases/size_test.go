@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+)
+
+func TestStatusReportUseCase_GetSizes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{broken, api, web}, nil)
+	mockGitRepo.EXPECT().GetDiskUsage(ctx, api, true).Return(&repositories.DiskUsage{GitDir: 100}, nil)
+	mockGitRepo.EXPECT().GetDiskUsage(ctx, web, true).Return(&repositories.DiskUsage{GitDir: 300}, nil)
+	mockGitRepo.EXPECT().GetDiskUsage(ctx, broken, true).Return(nil, errors.New("not a git repository"))
+	mockLogger.EXPECT().Warn(ctx, "Failed to get disk usage", gomock.Any()).Times(1)
+
+	sizes, err := usecase.GetSizes(ctx, []string{"all"}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sizes) != 3 || sizes[0].Repository != "web" || sizes[1].Repository != "api" || sizes[2].Repository != "broken" {
+		t.Fatalf("GetSizes() should return the largest repositories first and errors last, got %+v", sizes)
+	}
+	if sizes[0].Usage.GitDir != 300 {
+		t.Errorf("GetSizes() git directory size = %d, want 300", sizes[0].Usage.GitDir)
+	}
+	if sizes[2].Error == "" {
+		t.Error("unreadable repository should report an error")
+	}
+}
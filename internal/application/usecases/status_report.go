@@ -2,6 +2,8 @@ package usecases
 
 import (
 	"context"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
@@ -19,6 +21,7 @@ type StatusReportUseCase struct {
 	statusService services.StatusService
 	logger        services.LoggingService
 	presenter     output.PresenterPort
+	stateRepo     repositories.StateRepository
 }
 
 // NewStatusReportUseCase creates a new StatusReportUseCase
@@ -40,6 +43,41 @@ func NewStatusReportUseCase(
 	}
 }
 
+// SetStateRepository sets the store the last operation of each repository is read from
+func (uc *StatusReportUseCase) SetStateRepository(stateRepo repositories.StateRepository) {
+	uc.stateRepo = stateRepo
+}
+
+// Keys accepted by StatusReportInput.SortBy
+const (
+	SortByName   = "name"
+	SortByDirty  = "dirty"
+	SortByBranch = "branch"
+	SortByAhead  = "ahead"
+	SortByConfig = "config"
+)
+
+// StatusSortKeys lists the supported status sort keys
+var StatusSortKeys = []string{SortByName, SortByDirty, SortByBranch, SortByAhead, SortByConfig}
+
+// IsValidStatusSortKey checks if the key is a supported status sort key
+func IsValidStatusSortKey(key string) bool {
+	return slices.Contains(StatusSortKeys, key)
+}
+
+// Keys accepted by StatusReportInput.GroupBy
+const (
+	GroupByTag = "tag"
+)
+
+// StatusGroupKeys lists the supported status grouping keys
+var StatusGroupKeys = []string{GroupByTag}
+
+// IsValidStatusGroupKey checks if the key is a supported status grouping key
+func IsValidStatusGroupKey(key string) bool {
+	return slices.Contains(StatusGroupKeys, key)
+}
+
 // StatusReportInput represents input for status reporting
 type StatusReportInput struct {
 	Groups      []string `json:"groups,omitempty"`
@@ -47,6 +85,11 @@ type StatusReportInput struct {
 	ShowAll     bool     `json:"show_all"`
 	Refresh     bool     `json:"refresh"`
 	ShowDetails bool     `json:"show_details"`
+	SortBy      string   `json:"sort_by,omitempty"`
+	GroupBy     string   `json:"group_by,omitempty"`
+	WithCommit  bool     `json:"with_commit"`
+	Status      string   `json:"status,omitempty"`
+	LastOp      bool     `json:"last_op"`
 }
 
 // StatusReportOutput represents output from status reporting
@@ -54,6 +97,9 @@ type StatusReportOutput struct {
 	Repositories    []*entities.Repository `json:"repositories"`
 	FormattedOutput string                 `json:"formatted_output"`
 	Summary         *StatusSummary         `json:"summary"`
+	// LastCommits maps repository names to their last commit when WithCommit is set;
+	// repositories without commits map to nil
+	LastCommits map[string]*repositories.CommitInfo `json:"last_commits,omitempty"`
 }
 
 // StatusSummary represents a summary of repository statuses
@@ -63,6 +109,7 @@ type StatusSummary struct {
 	ModifiedRepositories int `json:"modified_repositories"`
 	ErrorRepositories    int `json:"error_repositories"`
 	WarningRepositories  int `json:"warning_repositories"`
+	NotGitRepositories   int `json:"not_git_repositories"`
 }
 
 // GetStatus gets the status of repositories
@@ -120,6 +167,18 @@ func (uc *StatusReportUseCase) GetStatus(ctx context.Context, input *StatusRepor
 		}
 	}
 
+	// Keep the repositories in the requested status
+	if input.Status != "" {
+		repositories = filterRepositoriesByStatus(repositories, entities.RepositoryStatus(input.Status))
+	}
+
+	if input.LastOp {
+		uc.attachLastOperations(ctx, repositories)
+	}
+
+	// Order repositories once all statuses are collected
+	sortRepositories(repositories, input.SortBy)
+
 	// Create summary
 	summary := uc.createSummary(repositories)
 
@@ -131,7 +190,12 @@ func (uc *StatusReportUseCase) GetStatus(ctx context.Context, input *StatusRepor
 		groupFilter = input.Repository
 	}
 
-	formattedOutput, err := uc.presenter.PresentStatus(ctx, repositories, groupFilter)
+	var formattedOutput string
+	if input.GroupBy == GroupByTag {
+		formattedOutput, err = uc.presenter.PresentStatusByTag(ctx, repositories)
+	} else {
+		formattedOutput, err = uc.presenter.PresentStatus(ctx, repositories, groupFilter)
+	}
 	if err != nil {
 		uc.logger.Error(ctx, "Failed to format status output", err)
 		// Don't fail the entire operation for formatting errors
@@ -144,11 +208,82 @@ func (uc *StatusReportUseCase) GetStatus(ctx context.Context, input *StatusRepor
 		"modified", summary.ModifiedRepositories,
 		"errors", summary.ErrorRepositories)
 
-	return &StatusReportOutput{
+	report := &StatusReportOutput{
 		Repositories:    repositories,
 		FormattedOutput: formattedOutput,
 		Summary:         summary,
-	}, nil
+	}
+
+	if input.WithCommit {
+		report.LastCommits = uc.getLastCommits(ctx, repositories)
+	}
+
+	return report, nil
+}
+
+// attachLastOperations sets the last operation gf ran in each repository, leaving it nil
+// for repositories without one or when they cannot be read
+func (uc *StatusReportUseCase) attachLastOperations(ctx context.Context, repos []*entities.Repository) {
+	if uc.stateRepo == nil {
+		return
+	}
+
+	operations, err := uc.stateRepo.LastOperations(ctx)
+	if err != nil {
+		uc.logger.Warn(ctx, "Failed to read the last operations", "error", err)
+		return
+	}
+	for _, repo := range repos {
+		repo.LastOperation = operations[repo.Name]
+	}
+}
+
+// getLastCommits returns the last commit of each repository, or nil for
+// repositories that are not valid or have no commits yet
+func (uc *StatusReportUseCase) getLastCommits(ctx context.Context, repos []*entities.Repository) map[string]*repositories.CommitInfo {
+	lastCommits := make(map[string]*repositories.CommitInfo, len(repos))
+	for _, repo := range repos {
+		lastCommits[repo.Name] = nil
+		if !repo.IsValid {
+			continue
+		}
+
+		commit, err := uc.gitRepo.GetLastCommit(ctx, repo)
+		if err != nil {
+			uc.logger.Debug(ctx, "No last commit", "repository", repo.Name, "error", err)
+			continue
+		}
+		lastCommits[repo.Name] = commit
+	}
+	return lastCommits
+}
+
+// sortRepositories orders repositories by name, then stably by the given key.
+// Dirty and ahead put the largest counts first, while config keeps the order in which
+// the repositories were listed by the configuration.
+func sortRepositories(repositories []*entities.Repository, sortBy string) {
+	if sortBy == SortByConfig {
+		return
+	}
+
+	sort.SliceStable(repositories, func(i, j int) bool {
+		return repositories[i].Name < repositories[j].Name
+	})
+
+	switch sortBy {
+	case SortByDirty:
+		sort.SliceStable(repositories, func(i, j int) bool {
+			return repositories[i].ChangeCount() > repositories[j].ChangeCount()
+		})
+	case SortByBranch:
+		sort.SliceStable(repositories, func(i, j int) bool {
+			return repositories[i].Branch < repositories[j].Branch
+		})
+	case SortByAhead:
+		sort.SliceStable(repositories, func(i, j int) bool {
+			return repositories[i].Ahead > repositories[j].Ahead
+		})
+	}
 }
 
 // createSummary creates a summary from repository statuses
@@ -158,6 +293,11 @@ func (uc *StatusReportUseCase) createSummary(repositories []*entities.Repository
 	}
 
 	for _, repo := range repositories {
+		if repo.IsNotGitRepository() {
+			summary.NotGitRepositories++
+			continue
+		}
+
 		switch repo.Status {
 		case entities.StatusClean:
 			summary.CleanRepositories++
@@ -182,3 +322,138 @@ func (uc *StatusReportUseCase) GetRepository(ctx context.Context, name string) (
 func (uc *StatusReportUseCase) GetAllRepositories(ctx context.Context) ([]*entities.Repository, error) {
 	return uc.statusService.GetAllStatus(ctx)
 }
+
+// RepositoryDiffStat holds the unstaged and staged changes of a repository
+type RepositoryDiffStat struct {
+	Repository string                `json:"repository"`
+	Unstaged   repositories.DiffStat `json:"unstaged"`
+	Staged     repositories.DiffStat `json:"staged"`
+	Error      string                `json:"error,omitempty"`
+}
+
+// Total returns the unstaged and staged changes added together
+func (d *RepositoryDiffStat) Total() repositories.DiffStat {
+	total := d.Unstaged
+	total.Add(d.Staged)
+	return total
+}
+
+// GetDiffStats returns the diff statistics of the repositories in the given groups,
+// sorted by name. Repositories that cannot be read are reported with an error.
+func (uc *StatusReportUseCase) GetDiffStats(ctx context.Context, groups []string) ([]*RepositoryDiffStat, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	stats := make([]*RepositoryDiffStat, 0, len(repos))
+	for _, repo := range repos {
+		stat := &RepositoryDiffStat{Repository: repo.Name}
+		stats = append(stats, stat)
+
+		unstaged, err := uc.gitRepo.GetDiffStat(ctx, repo, false)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get diff stat", "repository", repo.Name, "error", err)
+			stat.Error = err.Error()
+			continue
+		}
+
+		staged, err := uc.gitRepo.GetDiffStat(ctx, repo, true)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get diff stat", "repository", repo.Name, "error", err)
+			stat.Error = err.Error()
+			continue
+		}
+
+		stat.Unstaged = *unstaged
+		stat.Staged = *staged
+	}
+
+	return stats, nil
+}
+
+// DefaultLargeFileThreshold is the size above which untracked files are reported by default
+const DefaultLargeFileThreshold int64 = 5 * 1024 * 1024
+
+// RepositoryLargeFiles holds the untracked files of a repository above the size threshold
+type RepositoryLargeFiles struct {
+	Repository string                       `json:"repository"`
+	Files      []repositories.UntrackedFile `json:"files,omitempty"`
+	Error      string                       `json:"error,omitempty"`
+}
+
+// GetLargeUntrackedFiles returns the untracked files larger than threshold in the
+// repositories of the given groups, sorted by repository name and largest file first.
+// Repositories that cannot be read are reported with an error.
+func (uc *StatusReportUseCase) GetLargeUntrackedFiles(ctx context.Context, groups []string, threshold int64) ([]*RepositoryLargeFiles, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	reports := make([]*RepositoryLargeFiles, 0, len(repos))
+	for _, repo := range repos {
+		report := &RepositoryLargeFiles{Repository: repo.Name}
+		reports = append(reports, report)
+
+		files, err := uc.gitRepo.GetUntrackedFiles(ctx, repo)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get untracked files", "repository", repo.Name, "error", err)
+			report.Error = err.Error()
+			continue
+		}
+
+		for _, file := range files {
+			if file.Size > threshold {
+				report.Files = append(report.Files, file)
+			}
+		}
+
+		sort.SliceStable(report.Files, func(i, j int) bool {
+			return report.Files[i].Size > report.Files[j].Size
+		})
+	}
+
+	return reports, nil
+}
+
+// GetRemoteURL returns the URL of the repository's origin remote, or of its first remote
+// when there is no origin. It returns an empty string for repositories without remotes.
+func (uc *StatusReportUseCase) GetRemoteURL(ctx context.Context, repo *entities.Repository) (string, error) {
+	remotes, err := uc.gitRepo.GetRemotes(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	if len(remotes) == 0 {
+		return "", nil
+	}
+
+	remote := remotes[0]
+	for _, name := range remotes {
+		if name == "origin" {
+			remote = name
+			break
+		}
+	}
+
+	return uc.gitRepo.GetRemoteURL(ctx, repo, remote)
+}
+
+// filterRepositoriesByStatus keeps only the repositories in the given status
+func filterRepositoriesByStatus(repositories []*entities.Repository, status entities.RepositoryStatus) []*entities.Repository {
+	filtered := make([]*entities.Repository, 0, len(repositories))
+	for _, repo := range repositories {
+		if repo.Status == status {
+			filtered = append(filtered, repo)
+		}
+	}
+
+	return filtered
+}
@@ -3,7 +3,9 @@ package usecases
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -86,6 +88,66 @@ func TestStatusReportUseCase_GetStatus_Success(t *testing.T) {
 	}
 }
 
+func TestStatusReportUseCase_GetStatus_GroupByTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatusService := services.NewMockStatusService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	mockPresenter := output.NewMockPresenterPort(ctrl)
+
+	ctx := context.Background()
+	repos := []*entities.Repository{
+		{Name: "repo1", Path: "/path/to/repo1", Status: entities.StatusClean, Tags: []string{"backend"}},
+	}
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockStatusService.EXPECT().GetAllStatus(ctx).Return(repos, nil)
+	mockPresenter.EXPECT().PresentStatusByTag(ctx, repos).Return("by tag", nil)
+
+	usecase := NewStatusReportUseCase(nil, nil, nil, mockStatusService, mockLogger, mockPresenter)
+
+	result, err := usecase.GetStatus(ctx, &StatusReportInput{GroupBy: GroupByTag})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.FormattedOutput != "by tag" {
+		t.Errorf("Expected the status grouped by tag, got %q", result.FormattedOutput)
+	}
+}
+
+func TestStatusReportUseCase_GetStatus_StatusFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatusService := services.NewMockStatusService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	mockPresenter := output.NewMockPresenterPort(ctrl)
+
+	ctx := context.Background()
+	clean := &entities.Repository{Name: "clean", Status: entities.StatusClean}
+	modified := &entities.Repository{Name: "modified", Status: entities.StatusModified, ModifiedFiles: 1}
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockStatusService.EXPECT().GetAllStatus(ctx).Return([]*entities.Repository{clean, modified}, nil)
+	mockPresenter.EXPECT().PresentStatus(ctx, []*entities.Repository{modified}, "").Return("modified only", nil)
+
+	usecase := NewStatusReportUseCase(nil, nil, nil, mockStatusService, mockLogger, mockPresenter)
+
+	result, err := usecase.GetStatus(ctx, &StatusReportInput{Status: string(entities.StatusModified)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Repositories) != 1 || result.Repositories[0] != modified {
+		t.Errorf("Expected only the modified repository, got %v", result.Repositories)
+	}
+	if result.Summary.TotalRepositories != 1 || result.Summary.CleanRepositories != 0 {
+		t.Errorf("Expected a summary of the filtered repositories, got %+v", result.Summary)
+	}
+}
+
 func TestStatusReportUseCase_GetStatus_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -125,3 +187,288 @@ func TestStatusReportUseCase_GetStatus_Error(t *testing.T) {
 		t.Error("Expected error, got nil")
 	}
 }
+
+func TestStatusReportUseCase_GetRemoteURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		remotes     []string
+		wantRemote  string
+		expectedURL string
+	}{
+		{
+			name:        "prefers origin",
+			remotes:     []string{"upstream", "origin"},
+			wantRemote:  "origin",
+			expectedURL: "git@example.com:org/repo.git",
+		},
+		{
+			name:        "falls back to first remote",
+			remotes:     []string{"upstream"},
+			wantRemote:  "upstream",
+			expectedURL: "git@example.com:upstream/repo.git",
+		},
+		{
+			name:        "no remotes",
+			remotes:     []string{},
+			expectedURL: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGitRepo := repositories.NewMockGitRepository(ctrl)
+			usecase := &StatusReportUseCase{gitRepo: mockGitRepo}
+
+			ctx := context.Background()
+			repo := &entities.Repository{Name: "repo", Path: "/path/repo"}
+
+			mockGitRepo.EXPECT().GetRemotes(ctx, repo).Return(tt.remotes, nil)
+			if tt.wantRemote != "" {
+				mockGitRepo.EXPECT().GetRemoteURL(ctx, repo, tt.wantRemote).Return(tt.expectedURL, nil)
+			}
+
+			url, err := usecase.GetRemoteURL(ctx, repo)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if url != tt.expectedURL {
+				t.Errorf("GetRemoteURL() = %q, want %q", url, tt.expectedURL)
+			}
+		})
+	}
+}
+
+func TestSortRepositories(t *testing.T) {
+	newRepos := func() []*entities.Repository {
+		return []*entities.Repository{
+			{Name: "web", Branch: "main", ModifiedFiles: 1, Ahead: 2},
+			{Name: "api", Branch: "main", CreatedFiles: 2, DeletedFiles: 1},
+			{Name: "docs", Branch: "develop", Ahead: 5},
+			{Name: "cli", Branch: "main", ModifiedFiles: 3},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		sortBy   string
+		expected []string
+	}{
+		{"default is name", "", []string{"api", "cli", "docs", "web"}},
+		{"name", SortByName, []string{"api", "cli", "docs", "web"}},
+		{"dirty first, ties by name", SortByDirty, []string{"api", "cli", "web", "docs"}},
+		{"branch, ties by name", SortByBranch, []string{"docs", "api", "cli", "web"}},
+		{"ahead first, ties by name", SortByAhead, []string{"docs", "web", "api", "cli"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := newRepos()
+			sortRepositories(repos, tt.sortBy)
+
+			names := make([]string, len(repos))
+			for i, repo := range repos {
+				names[i] = repo.Name
+			}
+			if strings.Join(names, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("sortRepositories(%q) = %v, want %v", tt.sortBy, names, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStatusReportUseCase_GetLargeUntrackedFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, broken, api}, nil)
+	mockGitRepo.EXPECT().GetUntrackedFiles(ctx, api).Return([]repositories.UntrackedFile{
+		{Path: "notes.txt", Size: 10},
+		{Path: "dist/app.js", Size: 2000},
+		{Path: "dist/app.bin", Size: 9000},
+	}, nil)
+	mockGitRepo.EXPECT().GetUntrackedFiles(ctx, broken).Return(nil, errors.New("not a git repository"))
+	mockGitRepo.EXPECT().GetUntrackedFiles(ctx, web).Return(nil, nil)
+	mockLogger.EXPECT().Warn(ctx, "Failed to get untracked files", gomock.Any()).Times(1)
+
+	reports, err := usecase.GetLargeUntrackedFiles(ctx, []string{"all"}, 1000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reports) != 3 || reports[0].Repository != "api" || reports[1].Repository != "broken" || reports[2].Repository != "web" {
+		t.Fatalf("GetLargeUntrackedFiles() should return the repositories sorted by name, got %+v", reports)
+	}
+
+	files := reports[0].Files
+	if len(files) != 2 || files[0].Path != "dist/app.bin" || files[1].Path != "dist/app.js" {
+		t.Errorf("GetLargeUntrackedFiles() should keep the files over the threshold, largest first, got %+v", files)
+	}
+	if reports[1].Error == "" {
+		t.Error("unreadable repository should report an error")
+	}
+	if len(reports[2].Files) != 0 {
+		t.Errorf("repository without untracked files should be clean, got %+v", reports[2].Files)
+	}
+}
+
+func TestStatusReportUseCase_GetDiffStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, broken, api}, nil)
+	mockGitRepo.EXPECT().GetDiffStat(ctx, api, false).Return(&repositories.DiffStat{FilesChanged: 1, Insertions: 4}, nil)
+	mockGitRepo.EXPECT().GetDiffStat(ctx, api, true).Return(&repositories.DiffStat{FilesChanged: 2, Deletions: 3}, nil)
+	mockGitRepo.EXPECT().GetDiffStat(ctx, broken, false).Return(nil, errors.New("not a git repository"))
+	mockGitRepo.EXPECT().GetDiffStat(ctx, web, false).Return(&repositories.DiffStat{}, nil)
+	mockGitRepo.EXPECT().GetDiffStat(ctx, web, true).Return(&repositories.DiffStat{}, nil)
+	mockLogger.EXPECT().Warn(ctx, "Failed to get diff stat", gomock.Any()).Times(1)
+
+	stats, err := usecase.GetDiffStats(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(stats) != 3 || stats[0].Repository != "api" || stats[1].Repository != "broken" || stats[2].Repository != "web" {
+		t.Fatalf("GetDiffStats() should return the repositories sorted by name, got %+v", stats)
+	}
+
+	expectedTotal := repositories.DiffStat{FilesChanged: 3, Insertions: 4, Deletions: 3}
+	if stats[0].Total() != expectedTotal {
+		t.Errorf("Total() = %+v, want %+v", stats[0].Total(), expectedTotal)
+	}
+	if stats[1].Error == "" {
+		t.Error("unreadable repository should report an error")
+	}
+	if stats[2].Total() != (repositories.DiffStat{}) {
+		t.Errorf("clean repository should have zero counts, got %+v", stats[2].Total())
+	}
+}
+
+func TestStatusReportUseCase_GetStatus_WithCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockStatusService := services.NewMockStatusService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	mockPresenter := output.NewMockPresenterPort(ctrl)
+
+	ctx := context.Background()
+	repos := []*entities.Repository{
+		{Name: "api", Path: "/src/api", Status: entities.StatusClean, IsValid: true},
+		{Name: "empty", Path: "/src/empty", Status: entities.StatusClean, IsValid: true},
+		{Name: "missing", Path: "/src/missing", Status: entities.StatusError},
+	}
+	commit := &repositories.CommitInfo{Hash: "abc123", Author: "Jane", Message: "Init"}
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockStatusService.EXPECT().GetAllStatus(ctx).Return(repos, nil)
+	mockPresenter.EXPECT().PresentStatus(ctx, gomock.Any(), "").Return("formatted output", nil)
+	mockGitRepo.EXPECT().GetLastCommit(ctx, repos[0]).Return(commit, nil)
+	mockGitRepo.EXPECT().GetLastCommit(ctx, repos[1]).Return(nil, errors.New("does not have any commits yet"))
+
+	usecase := NewStatusReportUseCase(nil, mockGitRepo, nil, mockStatusService, mockLogger, mockPresenter)
+
+	result, err := usecase.GetStatus(ctx, &StatusReportInput{WithCommit: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.LastCommits) != 3 {
+		t.Fatalf("Expected an entry for each repository, got %v", result.LastCommits)
+	}
+	if result.LastCommits["api"] != commit {
+		t.Errorf("Expected the last commit of api, got %v", result.LastCommits["api"])
+	}
+	if result.LastCommits["empty"] != nil || result.LastCommits["missing"] != nil {
+		t.Errorf("Expected nil commits for repositories without commits, got %v", result.LastCommits)
+	}
+}
+
+func TestStatusReportUseCase_GetStatus_NotGitRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatusService := services.NewMockStatusService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	mockPresenter := output.NewMockPresenterPort(ctrl)
+
+	ctx := context.Background()
+	clean := &entities.Repository{Name: "clean", Status: entities.StatusClean, IsValid: true}
+	broken := &entities.Repository{Name: "broken", Status: entities.StatusError, ErrorMessage: "invalid directory"}
+	notGit := &entities.Repository{Name: "notes", Status: entities.StatusError, ErrorMessage: entities.NotAGitRepositoryMessage}
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockStatusService.EXPECT().GetAllStatus(ctx).Return([]*entities.Repository{clean, broken, notGit}, nil)
+	mockPresenter.EXPECT().PresentStatus(ctx, gomock.Any(), "").Return("status", nil)
+
+	usecase := NewStatusReportUseCase(nil, nil, nil, mockStatusService, mockLogger, mockPresenter)
+
+	result, err := usecase.GetStatus(ctx, &StatusReportInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Repositories) != 3 {
+		t.Errorf("Expected the other repositories to be reported too, got %v", result.Repositories)
+	}
+	if result.Summary.NotGitRepositories != 1 || result.Summary.ErrorRepositories != 1 || result.Summary.CleanRepositories != 1 {
+		t.Errorf("Expected the path that is not a git repository to be counted on its own, got %+v", result.Summary)
+	}
+}
+
+func TestStatusReportUseCase_GetStatus_LastOp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatusService := services.NewMockStatusService(ctrl)
+	mockStateRepo := repositories.NewMockStateRepository(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	mockPresenter := output.NewMockPresenterPort(ctrl)
+
+	ctx := context.Background()
+	repos := []*entities.Repository{
+		{Name: "api", Status: entities.StatusClean, IsValid: true},
+		{Name: "web", Status: entities.StatusClean, IsValid: true},
+	}
+	operation := &entities.LastOperation{Command: "git pull", Time: time.Now(), Status: entities.ExecutionStatusSuccess}
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockStatusService.EXPECT().GetAllStatus(ctx).Return(repos, nil)
+	mockStateRepo.EXPECT().LastOperations(ctx).Return(map[string]*entities.LastOperation{"api": operation}, nil)
+	mockPresenter.EXPECT().PresentStatus(ctx, gomock.Any(), "").Return("status", nil)
+
+	usecase := NewStatusReportUseCase(nil, nil, nil, mockStatusService, mockLogger, mockPresenter)
+	usecase.SetStateRepository(mockStateRepo)
+
+	if _, err := usecase.GetStatus(ctx, &StatusReportInput{LastOp: true}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if repos[0].LastOperation != operation || repos[1].LastOperation != nil {
+		t.Errorf("Expected only api to have a last operation, got %v and %v", repos[0].LastOperation, repos[1].LastOperation)
+	}
+}
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// RepositorySubmodules holds the submodules of a repository
+type RepositorySubmodules struct {
+	Repository string                   `json:"repository"`
+	Submodules []repositories.Submodule `json:"submodules"`
+	Error      string                   `json:"error,omitempty"`
+}
+
+// Count returns the number of submodules in the given state
+func (s *RepositorySubmodules) Count(state repositories.SubmoduleState) int {
+	count := 0
+	for _, submodule := range s.Submodules {
+		if submodule.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+// GetSubmoduleStatuses returns the submodules of the repositories in the given groups,
+// sorted by name. Repositories whose submodules cannot be read are reported with an error.
+func (uc *StatusReportUseCase) GetSubmoduleStatuses(ctx context.Context, groups []string) ([]*RepositorySubmodules, error) {
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	statuses := make([]*RepositorySubmodules, 0, len(repos))
+	for _, repo := range repos {
+		status := &RepositorySubmodules{Repository: repo.Name}
+		statuses = append(statuses, status)
+
+		submodules, err := uc.gitRepo.GetSubmodules(ctx, repo)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to get submodules", "repository", repo.Name, "error", err)
+			status.Error = err.Error()
+			continue
+		}
+		status.Submodules = submodules
+	}
+
+	return statuses, nil
+}
+
+// UpdateSubmodules initializes and recursively updates the submodules of each repository
+// of the groups. Repositories without submodules are skipped, while those whose
+// submodules cannot be read are kept so that git reports the problem.
+func (uc *ExecuteCommandUseCase) UpdateSubmodules(ctx context.Context, groups []string) (*ExecuteCommandOutput, error) {
+	uc.logger.Info(ctx, "Starting submodule update", "groups", groups)
+
+	if len(groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	// Nested submodules are initialized and updated too
+	command := entities.NewGitCommand([]string{"submodule", "update", "--init", "--recursive"})
+
+	withSubmodules := make([]*entities.Repository, 0, len(repos))
+	var without []*entities.Repository
+	for _, repo := range repos {
+		submodules, err := uc.gitRepo.GetSubmodules(ctx, repo)
+		if err != nil {
+			uc.logger.Debug(ctx, "Failed to get submodules", "repository", repo.Name, "error", err)
+		}
+		if err == nil && len(submodules) == 0 {
+			without = append(without, repo)
+		} else {
+			withSubmodules = append(withSubmodules, repo)
+		}
+	}
+
+	summary := entities.NewSummary()
+	if len(withSubmodules) > 0 {
+		summary, err = uc.executorRepo.ExecuteInParallel(ctx, withSubmodules, command)
+		if err != nil {
+			uc.logger.Error(ctx, "Failed to update submodules", err, "repositories", len(withSubmodules))
+			return nil, errors.WrapFailedToExecuteCommand(err)
+		}
+	} else {
+		summary.Finalize()
+	}
+
+	addSkippedResults(summary, without, command, NoSubmodulesReason)
+
+	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to format output", err)
+		formattedOutput = "Error formatting output"
+	}
+
+	return &ExecuteCommandOutput{
+		Summary:         summary,
+		FormattedOutput: formattedOutput,
+		Success:         !summary.HasFailures(),
+	}, nil
+}
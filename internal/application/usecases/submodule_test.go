@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+)
+
+func TestStatusReportUseCase_GetSubmoduleStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitRepo := repositories.NewMockGitRepository(ctrl)
+	mockConfigService := services.NewMockConfigService(ctrl)
+	mockLogger := services.NewMockLoggingService(ctrl)
+	usecase := &StatusReportUseCase{gitRepo: mockGitRepo, configService: mockConfigService, logger: mockLogger}
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/path/web"}
+	api := &entities.Repository{Name: "api", Path: "/path/api"}
+	broken := &entities.Repository{Name: "broken", Path: "/path/broken"}
+
+	mockConfigService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, broken, api}, nil)
+	mockGitRepo.EXPECT().GetSubmodules(ctx, api).Return([]repositories.Submodule{
+		{Path: "vendor/proto", Commit: "1a2b3c", State: repositories.SubmoduleStateCurrent},
+		{Path: "vendor/ui", Commit: "4d5e6f", State: repositories.SubmoduleStateOutOfDate},
+		{Path: "docs", Commit: "7a8b9c", State: repositories.SubmoduleStateOutOfDate},
+	}, nil)
+	mockGitRepo.EXPECT().GetSubmodules(ctx, web).Return(nil, nil)
+	mockGitRepo.EXPECT().GetSubmodules(ctx, broken).Return(nil, errors.New("not a git repository"))
+	mockLogger.EXPECT().Warn(ctx, "Failed to get submodules", gomock.Any()).Times(1)
+
+	statuses, err := usecase.GetSubmoduleStatuses(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(statuses) != 3 || statuses[0].Repository != "api" || statuses[1].Repository != "broken" || statuses[2].Repository != "web" {
+		t.Fatalf("GetSubmoduleStatuses() should return the repositories sorted by name, got %+v", statuses)
+	}
+	if got := statuses[0].Count(repositories.SubmoduleStateOutOfDate); got != 2 {
+		t.Errorf("Count(out of date) = %d, want 2", got)
+	}
+	if statuses[1].Error == "" {
+		t.Error("unreadable repository should report an error")
+	}
+	if len(statuses[2].Submodules) != 0 {
+		t.Errorf("repository without submodules should have none, got %+v", statuses[2].Submodules)
+	}
+}
+
+func TestUpdateSubmodules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := repositories.NewMockGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, nil, nil, logger, presenter)
+
+	ctx := context.Background()
+	api := &entities.Repository{Name: "api", Path: "/path/to/api"}
+	web := &entities.Repository{Name: "web", Path: "/path/to/web"}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{api, web}, nil)
+	gitRepo.EXPECT().GetSubmodules(ctx, api).Return([]repositories.Submodule{{Path: "vendor/proto", State: repositories.SubmoduleStateUninitialized}}, nil)
+	gitRepo.EXPECT().GetSubmodules(ctx, web).Return(nil, nil)
+	presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+	executorRepo.EXPECT().ExecuteInParallel(ctx, []*entities.Repository{api}, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+			if cmd.GetFullCommand() != "submodule update --init --recursive" {
+				t.Errorf("command = %q, want a recursive submodule update", cmd.GetFullCommand())
+			}
+			summary := entities.NewSummary()
+			result := entities.NewExecutionResult("api", cmd.GetFullCommand())
+			result.MarkAsSuccess("", 0)
+			summary.AddResult(*result)
+			return summary, nil
+		})
+
+	output, err := useCase.UpdateSubmodules(ctx, []string{"all"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !output.Success {
+		t.Error("Expected success")
+	}
+	if output.Summary.SkippedCount() != 1 || output.Summary.Results[1].ErrorMessage != NoSubmodulesReason {
+		t.Errorf("Expected web to be skipped as having no submodules, got %+v", output.Summary.Results)
+	}
+}
@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// SwitchRemoteInput represents input for rewriting the URL of a remote across groups.
+// {repo} in the URL is replaced by the repository name found in the remote's old URL.
+type SwitchRemoteInput struct {
+	Groups []string `json:"groups"`
+	Remote string   `json:"remote"`
+	URL    string   `json:"url"`
+	DryRun bool     `json:"dry_run,omitempty"`
+}
+
+// RepositoryRemoteSwitch holds the URL change of the remote of a repository, or the one
+// that would be made in a dry run
+type RepositoryRemoteSwitch struct {
+	Repository string `json:"repository"`
+	OldURL     string `json:"old_url,omitempty"`
+	NewURL     string `json:"new_url,omitempty"`
+	DryRun     bool   `json:"dry_run,omitempty"`
+	Skipped    string `json:"skipped,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// IsUnchanged returns true if the remote already has the new URL
+func (s *RepositoryRemoteSwitch) IsUnchanged() bool {
+	return s.OldURL == s.NewURL
+}
+
+// SwitchRemotes sets the URL of the remote in each repository of the groups, sorted by
+// name. Repositories without the remote are skipped and, with DryRun, the URLs are only
+// computed. Repositories whose remote cannot be switched are reported with an error.
+func (uc *ExecuteCommandUseCase) SwitchRemotes(ctx context.Context, input *SwitchRemoteInput) ([]*RepositoryRemoteSwitch, error) {
+	uc.logger.Info(ctx, "Starting remote switch", "groups", input.Groups, "remote", input.Remote, "dry_run", input.DryRun)
+
+	if len(input.Groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+	if input.Remote == "" || input.URL == "" || strings.HasPrefix(input.Remote, "-") || strings.HasPrefix(input.URL, "-") {
+		return nil, errors.ErrUsageSwitchRemote
+	}
+
+	repos, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sortRepositories(repos, SortByName)
+
+	switches := make([]*RepositoryRemoteSwitch, 0, len(repos))
+	for _, repo := range repos {
+		remoteSwitch := &RepositoryRemoteSwitch{Repository: repo.Name, DryRun: input.DryRun}
+		switches = append(switches, remoteSwitch)
+
+		if err := uc.switchRepositoryRemote(ctx, repo, input, remoteSwitch); err != nil {
+			uc.logger.Warn(ctx, "Failed to switch remote", "repository", repo.Name, "error", err)
+			remoteSwitch.Error = err.Error()
+		}
+	}
+
+	return switches, nil
+}
+
+// switchRepositoryRemote computes the new URL of the remote of a repository and sets it
+// unless it is unchanged or the run is a dry run
+func (uc *ExecuteCommandUseCase) switchRepositoryRemote(ctx context.Context, repo *entities.Repository, input *SwitchRemoteInput, remoteSwitch *RepositoryRemoteSwitch) error {
+	remotes, err := uc.gitRepo.GetRemotes(ctx, repo)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(remotes, input.Remote) {
+		remoteSwitch.Skipped = NoSuchRemoteReason + " " + input.Remote
+		return nil
+	}
+
+	oldURL, err := uc.gitRepo.GetRemoteURL(ctx, repo, input.Remote)
+	if err != nil {
+		return err
+	}
+	remoteSwitch.OldURL = oldURL
+	remoteSwitch.NewURL = strings.ReplaceAll(input.URL, "{repo}", repositoryNameFromURL(oldURL))
+
+	if input.DryRun || remoteSwitch.IsUnchanged() {
+		return nil
+	}
+	return uc.gitRepo.SetRemoteURL(ctx, repo, input.Remote, remoteSwitch.NewURL)
+}
+
+// repositoryNameFromURL returns the last path element of a remote URL without its .git
+// suffix, for both URLs and scp-like addresses such as git@host:org/name.git
+func repositoryNameFromURL(url string) string {
+	url = strings.TrimRight(url, "/")
+	if i := strings.LastIndexAny(url, "/:"); i >= 0 {
+		url = url[i+1:]
+	}
+	return strings.TrimSuffix(url, ".git")
+}
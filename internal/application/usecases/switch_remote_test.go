@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestSwitchRemotes(t *testing.T) {
+	for _, dryRun := range []bool{false, true} {
+		ctrl := gomock.NewController(t)
+
+		gitRepo := repositories.NewMockGitRepository(ctrl)
+		configService := services.NewMockConfigService(ctrl)
+		logger := services.NewMockLoggingService(ctrl)
+
+		useCase := NewExecuteCommandUseCase(nil, gitRepo, nil, configService, nil, nil, logger, nil)
+
+		ctx := context.Background()
+		web := &entities.Repository{Name: "web"}
+		api := &entities.Repository{Name: "api"}
+		docs := &entities.Repository{Name: "docs"}
+		broken := &entities.Repository{Name: "broken"}
+		template := "git@new.example.com:team/{repo}.git"
+
+		logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, api, docs, broken}, nil)
+		gitRepo.EXPECT().GetRemotes(ctx, api).Return([]string{"origin"}, nil)
+		gitRepo.EXPECT().GetRemoteURL(ctx, api, "origin").Return("https://old.example.com/team/web-api.git", nil)
+		gitRepo.EXPECT().GetRemotes(ctx, docs).Return([]string{"upstream"}, nil)
+		gitRepo.EXPECT().GetRemotes(ctx, web).Return([]string{"origin"}, nil)
+		gitRepo.EXPECT().GetRemoteURL(ctx, web, "origin").Return("git@new.example.com:team/web.git", nil)
+		gitRepo.EXPECT().GetRemotes(ctx, broken).Return(nil, errors.New("not a git repository"))
+		if !dryRun {
+			gitRepo.EXPECT().SetRemoteURL(ctx, api, "origin", "git@new.example.com:team/web-api.git").Return(nil)
+		}
+
+		switches, err := useCase.SwitchRemotes(ctx, &SwitchRemoteInput{Groups: []string{"all"}, Remote: "origin", URL: template, DryRun: dryRun})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(switches) != 4 || switches[0].Repository != "api" {
+			t.Fatalf("Expected the repositories sorted by name, got %+v", switches)
+		}
+		if apiSwitch := switches[0]; apiSwitch.NewURL != "git@new.example.com:team/web-api.git" || apiSwitch.DryRun != dryRun {
+			t.Errorf("Expected {repo} to come from the old URL, got %+v", apiSwitch)
+		}
+		if switches[1].Error == "" {
+			t.Errorf("Expected broken to report its error, got %+v", switches[1])
+		}
+		if switches[2].Skipped != NoSuchRemoteReason+" origin" {
+			t.Errorf("Expected docs to be skipped without origin, got %+v", switches[2])
+		}
+		if !switches[3].IsUnchanged() {
+			t.Errorf("Expected web to be unchanged, got %+v", switches[3])
+		}
+
+		ctrl.Finish()
+	}
+}
+
+func TestSwitchRemotes_Usage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := services.NewMockLoggingService(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, nil, nil, logger, nil)
+
+	_, err := useCase.SwitchRemotes(context.Background(), &SwitchRemoteInput{Groups: []string{"all"}, Remote: "origin"})
+	if !gferrors.IsError(err, gferrors.ErrUsageSwitchRemote) {
+		t.Errorf("Expected ErrUsageSwitchRemote without URL, got %v", err)
+	}
+}
+
+func TestRepositoryNameFromURL(t *testing.T) {
+	tests := map[string]string{
+		"git@github.com:org/api.git":      "api",
+		"https://github.com/org/api.git":  "api",
+		"https://github.com/org/api/":     "api",
+		"ssh://git@host:2222/org/web-app": "web-app",
+		"/srv/git/tools.git":              "tools",
+	}
+	for url, want := range tests {
+		if got := repositoryNameFromURL(url); got != want {
+			t.Errorf("repositoryNameFromURL(%q) = %q, want %q", url, got, want)
+		}
+	}
+}
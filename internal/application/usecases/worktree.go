@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// DefaultWorktreePath is the path template of the worktrees when the configuration sets
+// none. {repo} and {branch} are replaced by the repository name and the branch, and
+// relative paths are resolved from the repository.
+const DefaultWorktreePath = "../{repo}-{branch}"
+
+// WorktreeAddInput represents input for adding a worktree of a branch across groups
+type WorktreeAddInput struct {
+	Groups []string `json:"groups"`
+	Branch string   `json:"branch"`
+}
+
+// AddWorktrees runs git worktree add for the branch in each repository of the groups,
+// at the path given by the configured template. Repositories whose worktree path
+// already exists are skipped.
+func (uc *ExecuteCommandUseCase) AddWorktrees(ctx context.Context, input *WorktreeAddInput) (*ExecuteCommandOutput, error) {
+	uc.logger.Info(ctx, "Starting worktree creation", "groups", input.Groups, "branch", input.Branch)
+
+	if len(input.Groups) == 0 {
+		return nil, errors.WrapInvalidInput(errors.ErrAtLeastOneGroupRequired)
+	}
+	if input.Branch == "" || strings.HasPrefix(input.Branch, "-") || strings.ContainsAny(input.Branch, " \t") {
+		return nil, errors.ErrUsageWorktreeAdd
+	}
+
+	repositories, err := uc.configService.GetRepositoriesForGroups(ctx, input.Groups)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to get repositories for groups", err, "groups", input.Groups)
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	template := uc.configService.GetWorktreePath(ctx)
+	if template == "" {
+		template = DefaultWorktreePath
+	}
+
+	// The path differs for each repository, so each one runs its own command
+	sortRepositories(repositories, SortByName)
+	summary := entities.NewSummary()
+	for _, repo := range repositories {
+		path := worktreePath(template, repo, input.Branch)
+		command := entities.NewGitCommand([]string{"worktree", "add", path, input.Branch})
+
+		if uc.gitRepo.IsValidDirectory(ctx, path) {
+			result := entities.NewExecutionResult(repo.Name, command.GetFullCommand())
+			result.MarkAsSkipped(WorktreeExistsReason)
+			summary.AddResult(*result)
+			continue
+		}
+
+		result, err := uc.executorRepo.ExecuteSingle(ctx, repo, command)
+		if err != nil {
+			uc.logger.Warn(ctx, "Failed to add worktree", "repository", repo.Name, "error", err)
+			if result == nil {
+				result = entities.NewExecutionResult(repo.Name, command.GetFullCommand())
+				result.MarkAsFailed("", -1, err.Error())
+			}
+		}
+		summary.AddResult(*result)
+	}
+	summary.Finalize()
+
+	formattedOutput, err := uc.presenter.PresentSummary(ctx, summary)
+	if err != nil {
+		uc.logger.Error(ctx, "Failed to format output", err)
+		formattedOutput = "Error formatting output"
+	}
+
+	return &ExecuteCommandOutput{
+		Summary:         summary,
+		FormattedOutput: formattedOutput,
+		Success:         !summary.HasFailures(),
+	}, nil
+}
+
+// worktreePath expands the template for the repository and branch. Slashes in the branch
+// are replaced so that feature/login gives a single directory.
+func worktreePath(template string, repo *entities.Repository, branch string) string {
+	path := strings.NewReplacer(
+		"{repo}", repo.Name,
+		"{branch}", strings.ReplaceAll(branch, "/", "-"),
+	).Replace(template)
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repo.Path, path)
+	}
+	return path
+}
@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/domain/services"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestAddWorktrees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitRepo := repositories.NewMockGitRepository(ctrl)
+	executorRepo := repositories.NewMockExecutorRepository(ctrl)
+	configService := services.NewMockConfigService(ctrl)
+	logger := services.NewMockLoggingService(ctrl)
+	presenter := output.NewMockPresenterPort(ctrl)
+
+	useCase := NewExecuteCommandUseCase(nil, gitRepo, executorRepo, configService, nil, nil, logger, presenter)
+
+	ctx := context.Background()
+	web := &entities.Repository{Name: "web", Path: "/src/web"}
+	api := &entities.Repository{Name: "api", Path: "/src/api"}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	configService.EXPECT().GetRepositoriesForGroups(ctx, []string{"all"}).Return([]*entities.Repository{web, api}, nil)
+	configService.EXPECT().GetWorktreePath(ctx).Return("")
+	gitRepo.EXPECT().IsValidDirectory(ctx, "/src/api-feature-login").Return(false)
+	gitRepo.EXPECT().IsValidDirectory(ctx, "/src/web-feature-login").Return(true)
+	presenter.EXPECT().PresentSummary(ctx, gomock.Any()).Return("formatted output", nil)
+
+	executorRepo.EXPECT().ExecuteSingle(ctx, api, gomock.Any()).DoAndReturn(
+		func(_ context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+			want := "worktree add /src/api-feature-login feature/login"
+			if cmd.GetFullCommand() != want {
+				t.Errorf("command = %q, want %q", cmd.GetFullCommand(), want)
+			}
+			result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+			result.MarkAsSuccess("", 0)
+			return result, nil
+		})
+
+	output, err := useCase.AddWorktrees(ctx, &WorktreeAddInput{Groups: []string{"all"}, Branch: "feature/login"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !output.Success {
+		t.Error("Expected success")
+	}
+	if output.Summary.SkippedCount() != 1 || output.Summary.Results[1].ErrorMessage != WorktreeExistsReason {
+		t.Errorf("Expected web to be skipped as its worktree exists, got %+v", output.Summary.Results)
+	}
+}
+
+func TestAddWorktrees_InvalidBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := services.NewMockLoggingService(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	useCase := NewExecuteCommandUseCase(nil, nil, nil, nil, nil, nil, logger, nil)
+
+	for _, branch := range []string{"", "--force", "two words"} {
+		_, err := useCase.AddWorktrees(context.Background(), &WorktreeAddInput{Groups: []string{"all"}, Branch: branch})
+		if !gferrors.IsError(err, gferrors.ErrUsageWorktreeAdd) {
+			t.Errorf("AddWorktrees(%q) error = %v, want ErrUsageWorktreeAdd", branch, err)
+		}
+	}
+}
+
+func TestWorktreePath(t *testing.T) {
+	repo := &entities.Repository{Name: "api", Path: "/src/api"}
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{DefaultWorktreePath, "/src/api-feature-login"},
+		{"/worktrees/{branch}/{repo}", "/worktrees/feature-login/api"},
+		{".worktrees/{branch}", "/src/api/.worktrees/feature-login"},
+	}
+
+	for _, tt := range tests {
+		if got := worktreePath(tt.template, repo, "feature/login"); got != tt.want {
+			t.Errorf("worktreePath(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,15 +20,43 @@ const (
 
 // Command represents a command that can be executed on repositories
 type Command struct {
-	Name         string        `json:"name"`
-	Type         CommandType   `json:"type"`
-	Args         []string      `json:"args"`
-	Description  string        `json:"description,omitempty"`
-	WorkingDir   string        `json:"working_dir,omitempty"`
-	Timeout      time.Duration `json:"timeout,omitempty"`
-	AllowFailure bool          `json:"allow_failure"`
+	Name         string            `json:"name"`
+	Type         CommandType       `json:"type"`
+	Args         []string          `json:"args"`
+	Description  string            `json:"description,omitempty"`
+	WorkingDir   string            `json:"working_dir,omitempty"`
+	Timeout      time.Duration     `json:"timeout,omitempty"`
+	AllowFailure bool              `json:"allow_failure"`
+	FailFast     bool              `json:"fail_fast,omitempty"`
+	Retries      int               `json:"retries,omitempty"`
+	RetryOn      []string          `json:"retry_on,omitempty"`
+	Env          map[string]string `json:"env,omitempty"`
+	Autostash    bool              `json:"autostash,omitempty"`
 }
 
+// DangerousPattern matches a git subcommand run with any of the given flags
+type DangerousPattern struct {
+	Subcommand string
+	Flags      []string
+}
+
+// DangerousPatterns lists the commands that can destroy work across a fleet
+var DangerousPatterns = []DangerousPattern{
+	{Subcommand: "reset", Flags: []string{"--hard"}},
+	{Subcommand: "clean", Flags: []string{"-f", "--force"}},
+	{Subcommand: "push", Flags: []string{"-f", "--force", "--force-with-lease", "--delete", "--mirror"}},
+	{Subcommand: "branch", Flags: []string{"-D"}},
+	{Subcommand: "checkout", Flags: []string{"-f", "--force"}},
+	{Subcommand: "stash", Flags: []string{"clear"}},
+}
+
+// JobsSubcommands lists the git subcommands accepting --jobs to run their own work,
+// such as fetching submodules, in parallel
+var JobsSubcommands = []string{"fetch", "pull", "clone"}
+
+// shellSeparators end the first command of a shell command line
+var shellSeparators = map[string]bool{"&&": true, "||": true, "|": true, ";": true}
+
 // NewGitCommand creates a new Git command
 func NewGitCommand(args []string) *Command {
 	return &Command{
@@ -140,6 +169,139 @@ func (c *Command) GetFullCommand() string {
 	return strings.Join(c.Args, " ")
 }
 
+// leadingSubcommand splits the first command of the command line into its git
+// subcommand and options, dropping an explicit "git" prefix
+func (c *Command) leadingSubcommand() (string, []string) {
+	fields := strings.Fields(c.GetFullCommand())
+	if len(fields) > 0 && fields[0] == "git" {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	subcommand, options := fields[0], fields[1:]
+	for i, option := range options {
+		if shellSeparators[option] {
+			options = options[:i]
+			break
+		}
+	}
+
+	return subcommand, options
+}
+
+// Subcommand returns the leading git subcommand, such as "push" for "git push -f"
+func (c *Command) Subcommand() string {
+	subcommand, _ := c.leadingSubcommand()
+	return subcommand
+}
+
+// HasOption returns true if the leading git subcommand is given the flag
+func (c *Command) HasOption(flag string) bool {
+	_, options := c.leadingSubcommand()
+	for _, option := range options {
+		if matchesFlag(option, flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDangerous returns true if the leading git subcommand and its flags match
+// one of the DangerousPatterns
+func (c *Command) IsDangerous() bool {
+	subcommand, options := c.leadingSubcommand()
+	if subcommand == "" {
+		return false
+	}
+
+	for _, pattern := range DangerousPatterns {
+		if pattern.Subcommand != subcommand {
+			continue
+		}
+		for _, option := range options {
+			for _, flag := range pattern.Flags {
+				if matchesFlag(option, flag) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// AcceptsJobs returns true if the command is a git subcommand accepting --jobs
+func (c *Command) AcceptsJobs() bool {
+	return c.IsGitCommand() && slices.Contains(JobsSubcommands, c.Subcommand())
+}
+
+// SetGitJobs makes git run the subcommand with the given number of jobs by adding
+// --jobs after it, unless the command already sets them. It returns false when the
+// subcommand does not accept --jobs, leaving the command unchanged.
+func (c *Command) SetGitJobs(jobs int) bool {
+	if !c.AcceptsJobs() {
+		return false
+	}
+	if c.HasOption("--jobs") || c.HasOption("-j") {
+		return true
+	}
+
+	i := slices.Index(c.Args, c.Subcommand())
+	if i < 0 {
+		return false
+	}
+	c.Args = slices.Insert(c.Args, i+1, fmt.Sprintf("--jobs=%d", jobs))
+	return true
+}
+
+// SetAutostash stashes the local changes around the command and restores them once it
+// has run. git pull is given its own --autostash, while other commands are marked for
+// the executor to stash the changes itself.
+func (c *Command) SetAutostash() {
+	if c.Subcommand() != "pull" {
+		c.Autostash = true
+		return
+	}
+	if c.HasOption("--autostash") {
+		return
+	}
+
+	i := slices.Index(c.Args, c.Subcommand())
+	c.Args = slices.Insert(c.Args, i+1, "--autostash")
+}
+
+// ShouldRetry reports whether a failed execution is run again after the given number of
+// attempts. Only failures whose error code is in RetryOn are retried, or the transient
+// ones when RetryOn is empty, so that deterministic failures such as conflicts are not.
+func (c *Command) ShouldRetry(result *ExecutionResult, attempts int) bool {
+	if attempts > c.Retries || result == nil {
+		return false
+	}
+	if !result.IsFailed() && result.Status != ExecutionStatusTimeout {
+		return false
+	}
+
+	codes := c.RetryOn
+	if len(codes) == 0 {
+		codes = errors.TransientCodes
+	}
+	return slices.Contains(codes, result.ErrorCode)
+}
+
+// matchesFlag reports whether a command line option is the given flag, allowing
+// --flag=value and grouped short flags such as -fdx for -f
+func matchesFlag(option, flag string) bool {
+	if name, _, _ := strings.Cut(option, "="); name == flag {
+		return true
+	}
+
+	isShortFlag := len(flag) == 2 && flag[0] == '-' && flag[1] != '-'
+	isShortGroup := len(option) > 2 && option[0] == '-' && option[1] != '-'
+	return isShortFlag && isShortGroup && strings.ContainsRune(option[1:], rune(flag[1]))
+}
+
 // Validate checks if the command is valid
 func (c *Command) Validate() error {
 	if c.Name == "" {
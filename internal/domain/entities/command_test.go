@@ -1,8 +1,11 @@
 package entities
 
 import (
+	"slices"
 	"testing"
 	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 func TestCommandType_Constants(t *testing.T) {
@@ -584,3 +587,154 @@ func TestCommand_Fields(t *testing.T) {
 		t.Error("Expected AllowFailure to be true")
 	}
 }
+
+func TestCommand_ShouldRetry(t *testing.T) {
+	failed := func(code string) *ExecutionResult {
+		result := NewExecutionResult("repo", "git fetch")
+		result.MarkAsFailed("boom", 1, "exit status 1")
+		result.ErrorCode = code
+		return result
+	}
+	timedOut := NewExecutionResult("repo", "git fetch")
+	timedOut.MarkAsTimeout()
+	succeeded := NewExecutionResult("repo", "git fetch")
+	succeeded.MarkAsSuccess("", 0)
+
+	tests := []struct {
+		name     string
+		retries  int
+		retryOn  []string
+		result   *ExecutionResult
+		attempts int
+		expected bool
+	}{
+		{"network failure retried by default", 2, nil, failed(errors.CodeNetworkFailure), 1, true},
+		{"timeout retried by default", 2, nil, timedOut, 2, true},
+		{"conflict not retried by default", 2, nil, failed(errors.CodeMergeConflict), 1, false},
+		{"auth not retried by default", 2, nil, failed(errors.CodeAuthRequired), 1, false},
+		{"auth retried when listed", 2, []string{errors.CodeAuthRequired}, failed(errors.CodeAuthRequired), 1, true},
+		{"network not retried when not listed", 2, []string{errors.CodeAuthRequired}, failed(errors.CodeNetworkFailure), 1, false},
+		{"retries exhausted", 2, nil, failed(errors.CodeNetworkFailure), 3, false},
+		{"retries disabled", 0, nil, failed(errors.CodeNetworkFailure), 1, false},
+		{"success not retried", 2, nil, succeeded, 1, false},
+		{"no result", 2, nil, nil, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewGitCommand([]string{"fetch"})
+			cmd.Retries = tt.retries
+			cmd.RetryOn = tt.retryOn
+
+			if got := cmd.ShouldRetry(tt.result, tt.attempts); got != tt.expected {
+				t.Errorf("ShouldRetry() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommand_IsDangerous(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{"reset hard", []string{"reset", "--hard"}, true},
+		{"explicit git reset hard", []string{"git", "reset", "--hard", "origin/main"}, true},
+		{"soft reset", []string{"reset", "--soft", "HEAD~1"}, false},
+		{"grouped clean flags", []string{"clean", "-fdx"}, true},
+		{"clean dry run", []string{"clean", "-n"}, false},
+		{"force push", []string{"push", "--force"}, true},
+		{"force with lease value", []string{"push", "--force-with-lease=origin/main"}, true},
+		{"short force push", []string{"push", "-f", "origin", "main"}, true},
+		{"plain push", []string{"push", "origin", "main"}, false},
+		{"delete branch", []string{"branch", "-D", "feature"}, true},
+		{"safe delete branch", []string{"branch", "-d", "feature"}, false},
+		{"stash clear", []string{"stash", "clear"}, true},
+		{"flag of another subcommand", []string{"log", "--hard"}, false},
+		{"substring in message", []string{"commit", "-m", "reset --hard"}, false},
+		{"shell command line", []string{"git reset --hard && git pull"}, true},
+		{"flag after shell separator", []string{"git fetch && git push --force"}, false},
+		{"empty", []string{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &Command{Args: tt.args}
+			if got := cmd.IsDangerous(); got != tt.expected {
+				t.Errorf("IsDangerous() for %v = %v, want %v", tt.args, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommand_HasOption(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		flag     string
+		expected bool
+	}{
+		{"long flag", []string{"commit", "--allow-empty", "-m", "empty"}, "--allow-empty", true},
+		{"grouped short flags", []string{"commit", "-am", "fix"}, "-a", true},
+		{"missing flag", []string{"commit", "-m", "fix"}, "--allow-empty", false},
+		{"flag after shell separator", []string{"git commit -m fix && git commit --allow-empty"}, "--allow-empty", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &Command{Args: tt.args}
+			if got := cmd.HasOption(tt.flag); got != tt.expected {
+				t.Errorf("HasOption(%q) for %v = %v, want %v", tt.flag, tt.args, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommand_SetAutostash(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   *Command
+		expected  []string
+		autostash bool
+	}{
+		{"pull", NewGitCommand([]string{"pull", "origin", "main"}), []string{"pull", "--autostash", "origin", "main"}, false},
+		{"autostash already given", NewGitCommand([]string{"git", "pull", "--autostash"}), []string{"git", "pull", "--autostash"}, false},
+		{"checkout", NewGitCommand([]string{"checkout", "main"}), []string{"checkout", "main"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.command.SetAutostash()
+			if !slices.Equal(tt.command.Args, tt.expected) || tt.command.Autostash != tt.autostash {
+				t.Errorf("SetAutostash() = %v with Autostash %v, want %v with %v", tt.command.Args, tt.command.Autostash, tt.expected, tt.autostash)
+			}
+		})
+	}
+}
+
+func TestCommand_SetGitJobs(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  *Command
+		accepted bool
+		expected []string
+	}{
+		{"fetch", NewGitCommand([]string{"fetch", "--all"}), true, []string{"fetch", "--jobs=4", "--all"}},
+		{"explicit git prefix", NewGitCommand([]string{"git", "pull", "origin"}), true, []string{"git", "pull", "--jobs=4", "origin"}},
+		{"jobs already given", NewGitCommand([]string{"fetch", "-j2"}), true, []string{"fetch", "-j2"}},
+		{"subcommand without --jobs", NewGitCommand([]string{"status"}), false, []string{"status"}},
+		{"shell command", NewShellCommand([]string{"make", "fetch"}), false, []string{"make", "fetch"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.command.SetGitJobs(4); got != tt.accepted {
+				t.Errorf("SetGitJobs() = %v, want %v", got, tt.accepted)
+			}
+			if !slices.Equal(tt.command.Args, tt.expected) {
+				t.Errorf("SetGitJobs() args = %v, want %v", tt.command.Args, tt.expected)
+			}
+		})
+	}
+}
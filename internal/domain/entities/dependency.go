@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// DependencyFailedReason is recorded on repositories skipped because a dependency did not succeed
+const DependencyFailedReason = "skipped due to failed dependency"
+
+// SortByDependencies orders the repositories so that each one comes after the
+// repositories it depends on. Repositories without ordering constraints keep their
+// relative order, and dependencies outside the given repositories are ignored.
+// It returns an error naming the repositories involved when dependencies form a cycle.
+func SortByDependencies(repos []*Repository) ([]*Repository, error) {
+	selected := make(map[string]bool, len(repos))
+	for _, repo := range repos {
+		selected[repo.Name] = true
+	}
+
+	pending := make(map[string]int, len(repos))
+	dependents := make(map[string][]string, len(repos))
+	for _, repo := range repos {
+		for _, dependency := range repo.DependsOn {
+			if !selected[dependency] {
+				continue
+			}
+			pending[repo.Name]++
+			dependents[dependency] = append(dependents[dependency], repo.Name)
+		}
+	}
+
+	sorted := make([]*Repository, 0, len(repos))
+	placed := make(map[string]bool, len(repos))
+	for len(sorted) < len(repos) {
+		progressed := false
+		for _, repo := range repos {
+			if placed[repo.Name] || pending[repo.Name] > 0 {
+				continue
+			}
+
+			sorted = append(sorted, repo)
+			placed[repo.Name] = true
+			progressed = true
+			for _, dependent := range dependents[repo.Name] {
+				pending[dependent]--
+			}
+		}
+
+		if !progressed {
+			var cycle []string
+			for _, repo := range repos {
+				if !placed[repo.Name] {
+					cycle = append(cycle, repo.Name)
+				}
+			}
+			return nil, errors.WrapDependencyCycle(cycle)
+		}
+	}
+
+	return sorted, nil
+}
+
+// HasFailedDependency returns true if one of the repository's dependencies is in failed
+func (r *Repository) HasFailedDependency(failed map[string]bool) bool {
+	for _, dependency := range r.DependsOn {
+		if failed[dependency] {
+			return true
+		}
+	}
+	return false
+}
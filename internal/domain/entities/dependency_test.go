@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestSortByDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		repos    []*Repository
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name: "no dependencies keeps the order",
+			repos: []*Repository{
+				{Name: "web"},
+				{Name: "api"},
+			},
+			expected: []string{"web", "api"},
+		},
+		{
+			name: "dependencies run first",
+			repos: []*Repository{
+				{Name: "app", DependsOn: []string{"lib"}},
+				{Name: "cli", DependsOn: []string{"app", "lib"}},
+				{Name: "lib"},
+			},
+			expected: []string{"lib", "app", "cli"},
+		},
+		{
+			name: "dependencies outside the selection are ignored",
+			repos: []*Repository{
+				{Name: "app", DependsOn: []string{"lib"}},
+				{Name: "docs"},
+			},
+			expected: []string{"app", "docs"},
+		},
+		{
+			name: "cycle is rejected",
+			repos: []*Repository{
+				{Name: "docs"},
+				{Name: "a", DependsOn: []string{"b"}},
+				{Name: "b", DependsOn: []string{"a"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "self dependency is rejected",
+			repos: []*Repository{
+				{Name: "a", DependsOn: []string{"a"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := SortByDependencies(tt.repos)
+			if tt.wantErr {
+				if !errors.IsError(err, errors.ErrDependencyCycle) {
+					t.Errorf("SortByDependencies() error = %v, want %v", err, errors.ErrDependencyCycle)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SortByDependencies() unexpected error: %v", err)
+			}
+
+			if len(sorted) != len(tt.expected) {
+				t.Fatalf("SortByDependencies() returned %d repositories, want %d", len(sorted), len(tt.expected))
+			}
+			for i, name := range tt.expected {
+				if sorted[i].Name != name {
+					t.Errorf("SortByDependencies()[%d] = %s, want %s", i, sorted[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+func TestRepository_HasFailedDependency(t *testing.T) {
+	repo := &Repository{Name: "app", DependsOn: []string{"lib", "proto"}}
+
+	if repo.HasFailedDependency(map[string]bool{"docs": true}) {
+		t.Error("HasFailedDependency() should be false when no dependency failed")
+	}
+
+	if !repo.HasFailedDependency(map[string]bool{"proto": true}) {
+		t.Error("HasFailedDependency() should be true when a dependency failed")
+	}
+}
@@ -3,6 +3,9 @@ package entities
 import (
 	"fmt"
 	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 // ExecutionStatus represents the status of a command execution
@@ -15,37 +18,72 @@ const (
 	ExecutionStatusFailed    ExecutionStatus = "failed"
 	ExecutionStatusTimeout   ExecutionStatus = "timeout"
 	ExecutionStatusCancelled ExecutionStatus = "cancelled"
+	ExecutionStatusSkipped   ExecutionStatus = "skipped"
+)
+
+// FailureCategory tells what caused a failed execution
+type FailureCategory string
+
+const (
+	FailureCategoryHook     FailureCategory = "hook"
+	FailureCategoryNetwork  FailureCategory = "network"
+	FailureCategoryConflict FailureCategory = "conflict"
+	FailureCategoryOther    FailureCategory = "other"
 )
 
 // ExecutionResult represents the result of executing a command on a repository
 type ExecutionResult struct {
-	Repository   string          `json:"repository"`
-	Command      string          `json:"command"`
-	Status       ExecutionStatus `json:"status"`
-	Output       string          `json:"output"`
-	ErrorOutput  string          `json:"error_output,omitempty"`
-	ExitCode     int             `json:"exit_code"`
-	StartTime    time.Time       `json:"start_time"`
-	EndTime      time.Time       `json:"end_time"`
-	Duration     time.Duration   `json:"duration"`
-	ErrorMessage string          `json:"error_message,omitempty"`
+	Repository      string          `json:"repository"`
+	Command         string          `json:"command"`
+	Status          ExecutionStatus `json:"status"`
+	Output          string          `json:"output"`
+	ErrorOutput     string          `json:"error_output,omitempty"`
+	ExitCode        int             `json:"exit_code"`
+	StartTime       time.Time       `json:"start_time"`
+	EndTime         time.Time       `json:"end_time"`
+	Duration        time.Duration   `json:"duration"`
+	ErrorMessage    string          `json:"error_message,omitempty"`
+	FailureCategory FailureCategory `json:"failure_category,omitempty"`
+	ErrorCode       string          `json:"error_code,omitempty"`
+	HookOutput      string          `json:"hook_output,omitempty"`
+	Warning         string          `json:"warning,omitempty"`
+	Attempts        int             `json:"attempts,omitempty"`
+
+	// clock times the execution, or the system time when nil
+	clock clock.Clock
 }
 
 // NewExecutionResult creates a new execution result
 func NewExecutionResult(repository, command string) *ExecutionResult {
-	return &ExecutionResult{
+	return NewExecutionResultWithClock(repository, command, nil)
+}
+
+// NewExecutionResultWithClock creates a new execution result timed by the clock, or by
+// the system time when it is nil
+func NewExecutionResultWithClock(repository, command string, c clock.Clock) *ExecutionResult {
+	result := &ExecutionResult{
 		Repository: repository,
 		Command:    command,
 		Status:     ExecutionStatusPending,
-		StartTime:  time.Now(),
 		ExitCode:   -1,
+		clock:      c,
+	}
+	result.StartTime = result.now()
+	return result
+}
+
+// now returns the time of the clock of the execution
+func (er *ExecutionResult) now() time.Time {
+	if er.clock == nil {
+		return time.Now()
 	}
+	return er.clock.Now()
 }
 
 // MarkAsRunning marks the execution as running
 func (er *ExecutionResult) MarkAsRunning() {
 	er.Status = ExecutionStatusRunning
-	er.StartTime = time.Now()
+	er.StartTime = er.now()
 }
 
 // MarkAsSuccess marks the execution as successful
@@ -53,7 +91,7 @@ func (er *ExecutionResult) MarkAsSuccess(output string, exitCode int) {
 	er.Status = ExecutionStatusSuccess
 	er.Output = output
 	er.ExitCode = exitCode
-	er.EndTime = time.Now()
+	er.EndTime = er.now()
 	er.Duration = er.EndTime.Sub(er.StartTime)
 }
 
@@ -63,7 +101,7 @@ func (er *ExecutionResult) MarkAsFailed(errorOutput string, exitCode int, errorM
 	er.ErrorOutput = errorOutput
 	er.ExitCode = exitCode
 	er.ErrorMessage = errorMessage
-	er.EndTime = time.Now()
+	er.EndTime = er.now()
 	er.Duration = er.EndTime.Sub(er.StartTime)
 }
 
@@ -71,7 +109,8 @@ func (er *ExecutionResult) MarkAsFailed(errorOutput string, exitCode int, errorM
 func (er *ExecutionResult) MarkAsTimeout() {
 	er.Status = ExecutionStatusTimeout
 	er.ErrorMessage = "command execution timed out"
-	er.EndTime = time.Now()
+	er.ErrorCode = errors.CodeTimeout
+	er.EndTime = er.now()
 	er.Duration = er.EndTime.Sub(er.StartTime)
 }
 
@@ -79,10 +118,27 @@ func (er *ExecutionResult) MarkAsTimeout() {
 func (er *ExecutionResult) MarkAsCancelled() {
 	er.Status = ExecutionStatusCancelled
 	er.ErrorMessage = "command execution was cancelled"
-	er.EndTime = time.Now()
+	er.EndTime = er.now()
 	er.Duration = er.EndTime.Sub(er.StartTime)
 }
 
+// MarkAsWarning turns a finished execution into a success reporting a problem that
+// did not make it fail, keeping its output and exit code
+func (er *ExecutionResult) MarkAsWarning(warning string) {
+	er.Status = ExecutionStatusSuccess
+	er.Warning = warning
+	er.ErrorMessage = ""
+	er.ErrorCode = ""
+	er.FailureCategory = ""
+}
+
+// MarkAsSkipped marks the execution as skipped without running the command
+func (er *ExecutionResult) MarkAsSkipped(reason string) {
+	er.Status = ExecutionStatusSkipped
+	er.ErrorMessage = reason
+	er.EndTime = er.now()
+}
+
 // IsSuccess returns true if the execution was successful
 func (er *ExecutionResult) IsSuccess() bool {
 	return er.Status == ExecutionStatusSuccess
@@ -93,6 +149,25 @@ func (er *ExecutionResult) IsFailed() bool {
 	return er.Status == ExecutionStatusFailed
 }
 
+// IsHookFailure returns true if a git hook of the repository rejected the command
+func (er *ExecutionResult) IsHookFailure() bool {
+	return er.IsFailed() && er.FailureCategory == FailureCategoryHook
+}
+
+// Err returns the typed error of an unsuccessful execution, matching the errors of the
+// errors package for its error code, or nil when the execution succeeded or has no code
+func (er *ExecutionResult) Err() error {
+	if er.IsSuccess() {
+		return nil
+	}
+	return errors.FromCode(er.ErrorCode, er.ErrorMessage)
+}
+
+// HasWarning returns true if the execution reported a problem that did not make it fail
+func (er *ExecutionResult) HasWarning() bool {
+	return er.Warning != ""
+}
+
 // IsCancelled returns true if the execution was cancelled
 func (er *ExecutionResult) IsCancelled() bool {
 	return er.Status == ExecutionStatusCancelled
@@ -103,12 +178,18 @@ func (er *ExecutionResult) IsTimeout() bool {
 	return er.Status == ExecutionStatusTimeout
 }
 
+// IsSkipped returns true if the command was not run on the repository
+func (er *ExecutionResult) IsSkipped() bool {
+	return er.Status == ExecutionStatusSkipped
+}
+
 // IsCompleted returns true if the execution is completed (success or failed)
 func (er *ExecutionResult) IsCompleted() bool {
 	return er.Status == ExecutionStatusSuccess ||
 		er.Status == ExecutionStatusFailed ||
 		er.Status == ExecutionStatusTimeout ||
-		er.Status == ExecutionStatusCancelled
+		er.Status == ExecutionStatusCancelled ||
+		er.Status == ExecutionStatusSkipped
 }
 
 // GetFormattedOutput returns formatted output for display
@@ -134,14 +215,33 @@ type Summary struct {
 	Results              []ExecutionResult `json:"results"`
 	StartTime            time.Time         `json:"start_time"`
 	EndTime              time.Time         `json:"end_time"`
+
+	// clock times the summary, or the system time when nil
+	clock clock.Clock
 }
 
 // NewSummary creates a new execution summary
 func NewSummary() *Summary {
-	return &Summary{
-		Results:   make([]ExecutionResult, 0),
-		StartTime: time.Now(),
+	return NewSummaryWithClock(nil)
+}
+
+// NewSummaryWithClock creates a new execution summary timed by the clock, or by the
+// system time when it is nil
+func NewSummaryWithClock(c clock.Clock) *Summary {
+	summary := &Summary{
+		Results: make([]ExecutionResult, 0),
+		clock:   c,
 	}
+	summary.StartTime = summary.now()
+	return summary
+}
+
+// now returns the time of the clock of the summary
+func (s *Summary) now() time.Time {
+	if s.clock == nil {
+		return time.Now()
+	}
+	return s.clock.Now()
 }
 
 // AddResult adds an execution result to the summary
@@ -160,7 +260,7 @@ func (s *Summary) AddResult(result ExecutionResult) {
 
 // Finalize finalizes the summary
 func (s *Summary) Finalize() {
-	s.EndTime = time.Now()
+	s.EndTime = s.now()
 }
 
 // GetSuccessRate returns the success rate as a percentage
@@ -202,6 +302,62 @@ func (s *Summary) CancelledCount() int {
 	return cancelled
 }
 
+// SkippedCount returns the number of skipped executions
+func (s *Summary) SkippedCount() int {
+	skipped := 0
+	for _, result := range s.Results {
+		if result.IsSkipped() {
+			skipped++
+		}
+	}
+	return skipped
+}
+
+// NotGitRepositoryCount returns the number of executions skipped because the path is
+// not a git repository
+func (s *Summary) NotGitRepositoryCount() int {
+	notGit := 0
+	for _, result := range s.Results {
+		if result.IsSkipped() && result.ErrorMessage == NotAGitRepositoryMessage {
+			notGit++
+		}
+	}
+	return notGit
+}
+
+// HookFailureCount returns the number of executions rejected by a git hook
+func (s *Summary) HookFailureCount() int {
+	hookFailures := 0
+	for _, result := range s.Results {
+		if result.IsHookFailure() {
+			hookFailures++
+		}
+	}
+	return hookFailures
+}
+
+// WarningCount returns the number of executions that reported a warning
+func (s *Summary) WarningCount() int {
+	warnings := 0
+	for _, result := range s.Results {
+		if result.HasWarning() {
+			warnings++
+		}
+	}
+	return warnings
+}
+
+// Slowest returns the execution that took the longest, or nil without results
+func (s *Summary) Slowest() *ExecutionResult {
+	var slowest *ExecutionResult
+	for i := range s.Results {
+		if slowest == nil || s.Results[i].Duration > slowest.Duration {
+			slowest = &s.Results[i]
+		}
+	}
+	return slowest
+}
+
 // TotalDuration returns the total duration of all executions
 func (s *Summary) GetTotalDuration() time.Duration {
 	return s.TotalDuration
@@ -3,6 +3,9 @@ package entities
 import (
 	"testing"
 	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 func TestExecutionStatus_Constants(t *testing.T) {
@@ -174,6 +177,82 @@ func TestExecutionResult_MarkAsTimeout(t *testing.T) {
 	}
 }
 
+func TestExecutionResult_MarkAsSkipped(t *testing.T) {
+	result := NewExecutionResult("test-repo", "git push")
+	result.MarkAsSkipped("command blocked by config")
+
+	if !result.IsSkipped() {
+		t.Errorf("Expected status %s, got %s", ExecutionStatusSkipped, result.Status)
+	}
+
+	if result.ErrorMessage != "command blocked by config" {
+		t.Errorf("Expected error message 'command blocked by config', got '%s'", result.ErrorMessage)
+	}
+
+	summary := NewSummary()
+	summary.AddResult(*result)
+
+	if summary.SkippedCount() != 1 {
+		t.Errorf("Expected 1 skipped result, got %d", summary.SkippedCount())
+	}
+
+	if summary.HasFailures() {
+		t.Error("Skipped results should not count as failures")
+	}
+}
+
+func TestExecutionResult_IsHookFailure(t *testing.T) {
+	hookResult := NewExecutionResult("repo1", "git commit")
+	hookResult.MarkAsFailed("lint failed", 1, "exit status 1")
+	hookResult.FailureCategory = FailureCategoryHook
+
+	networkResult := NewExecutionResult("repo2", "git push")
+	networkResult.MarkAsFailed("could not resolve host", 128, "exit status 128")
+	networkResult.FailureCategory = FailureCategoryNetwork
+
+	if !hookResult.IsHookFailure() {
+		t.Error("Expected IsHookFailure() to be true for a failure caused by a hook")
+	}
+	if networkResult.IsHookFailure() {
+		t.Error("Expected IsHookFailure() to be false for a network failure")
+	}
+
+	summary := NewSummary()
+	summary.AddResult(*hookResult)
+	summary.AddResult(*networkResult)
+
+	if summary.HookFailureCount() != 1 {
+		t.Errorf("Expected 1 hook failure, got %d", summary.HookFailureCount())
+	}
+}
+
+func TestExecutionResult_Err(t *testing.T) {
+	success := NewExecutionResult("repo1", "git pull")
+	success.MarkAsSuccess("", 0)
+	if err := success.Err(); err != nil {
+		t.Errorf("Expected no error for a success, got %v", err)
+	}
+
+	conflict := NewExecutionResult("repo2", "git pull")
+	conflict.MarkAsFailed("CONFLICT (content)", 1, "exit status 1")
+	conflict.ErrorCode = errors.CodeMergeConflict
+	if err := conflict.Err(); !errors.IsError(err, errors.ErrMergeConflict) {
+		t.Errorf("Expected ErrMergeConflict, got %v", err)
+	}
+
+	timeout := NewExecutionResult("repo3", "git fetch")
+	timeout.MarkAsTimeout()
+	if err := timeout.Err(); !errors.IsError(err, errors.ErrTimeout) {
+		t.Errorf("Expected ErrTimeout, got %v", err)
+	}
+
+	unclassified := NewExecutionResult("repo4", "make")
+	unclassified.MarkAsFailed("", 2, "exit status 2")
+	if err := unclassified.Err(); err != nil {
+		t.Errorf("Expected no typed error without a code, got %v", err)
+	}
+}
+
 func TestExecutionResult_MarkAsCancelled(t *testing.T) {
 	result := NewExecutionResult("test-repo", "git status")
 	result.MarkAsRunning()
@@ -323,6 +402,45 @@ func findIndex(s, substr string) int {
 	return -1
 }
 
+func TestSummary_WarningCount(t *testing.T) {
+	summary := NewSummary()
+
+	warned := NewExecutionResult("repo1", "git pull --autostash")
+	warned.MarkAsSuccess("", 0)
+	warned.Warning = "local changes conflicted"
+	clean := NewExecutionResult("repo2", "git pull --autostash")
+	clean.MarkAsSuccess("", 0)
+
+	summary.AddResult(*warned)
+	summary.AddResult(*clean)
+
+	if !warned.HasWarning() || clean.HasWarning() {
+		t.Error("Expected HasWarning() to be true only for the result with a warning")
+	}
+	if summary.WarningCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", summary.WarningCount())
+	}
+}
+
+func TestSummary_NotGitRepositoryCount(t *testing.T) {
+	summary := NewSummary()
+
+	notGit := NewExecutionResult("notes", "git pull")
+	notGit.MarkAsSkipped(NotAGitRepositoryMessage)
+	locked := NewExecutionResult("api", "git pull")
+	locked.MarkAsSkipped("locked by another process")
+
+	summary.AddResult(*notGit)
+	summary.AddResult(*locked)
+
+	if summary.NotGitRepositoryCount() != 1 {
+		t.Errorf("Expected 1 path that is not a git repository, got %d", summary.NotGitRepositoryCount())
+	}
+	if summary.SkippedCount() != 2 {
+		t.Errorf("Expected 2 skipped executions, got %d", summary.SkippedCount())
+	}
+}
+
 func TestNewSummary(t *testing.T) {
 	startTime := time.Now()
 	summary := NewSummary()
@@ -427,6 +545,34 @@ func TestSummary_Finalize(t *testing.T) {
 	}
 }
 
+func TestExecutionResult_WithClock(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake := clock.NewFake(start, time.Second)
+
+	result := NewExecutionResultWithClock("repo", "git status", fake)
+	if !result.StartTime.Equal(start) {
+		t.Errorf("StartTime = %v, want %v", result.StartTime, start)
+	}
+
+	result.MarkAsRunning()
+	fake.Advance(3 * time.Second)
+	result.MarkAsSuccess("ok", 0)
+
+	if !result.EndTime.Equal(start.Add(5 * time.Second)) {
+		t.Errorf("EndTime = %v, want %v", result.EndTime, start.Add(5*time.Second))
+	}
+	if result.Duration != 4*time.Second {
+		t.Errorf("Duration = %v, want 4s", result.Duration)
+	}
+
+	summary := NewSummaryWithClock(fake)
+	summary.AddResult(*result)
+	summary.Finalize()
+	if summary.EndTime.Sub(summary.StartTime) != time.Second || summary.TotalDuration != 4*time.Second {
+		t.Errorf("summary = %v to %v, total %v", summary.StartTime, summary.EndTime, summary.TotalDuration)
+	}
+}
+
 func TestSummary_GetSuccessRate(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -604,3 +750,20 @@ func TestExecutionResult_Fields(t *testing.T) {
 		t.Errorf("Expected Duration %v, got %v", duration, result.Duration)
 	}
 }
+
+func TestSummary_Slowest(t *testing.T) {
+	summary := NewSummary()
+	if summary.Slowest() != nil {
+		t.Error("Slowest() without results should be nil")
+	}
+
+	for name, duration := range map[string]time.Duration{"api": time.Second, "web": 3 * time.Second, "docs": 2 * time.Second} {
+		result := NewExecutionResult(name, "git pull")
+		result.Duration = duration
+		summary.AddResult(*result)
+	}
+
+	if slowest := summary.Slowest(); slowest == nil || slowest.Repository != "web" {
+		t.Errorf("Slowest() = %v, want web", slowest)
+	}
+}
@@ -3,15 +3,94 @@ package entities
 import (
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 // Group represents a logical grouping of repositories
 type Group struct {
-	Name         string   `json:"name"`
-	Repositories []string `json:"repositories"`
-	Description  string   `json:"description,omitempty"`
+	Name           string            `json:"name"`
+	Repositories   []string          `json:"repositories"`
+	Description    string            `json:"description,omitempty"`
+	DefaultCommand string            `json:"default_command,omitempty"`
+	Composition    *GroupComposition `json:"composition,omitempty"`
+}
+
+// Set operations of group compositions
+const (
+	CompositionUnion     = "union"
+	CompositionIntersect = "intersect"
+	CompositionSubtract  = "subtract"
+)
+
+// CompositionOperations lists the supported set operations of group compositions
+var CompositionOperations = []string{CompositionUnion, CompositionIntersect, CompositionSubtract}
+
+// GroupComposition describes a group made of a set operation over other groups: the
+// repositories of any of them, of all of them, or of the first one but none of the others
+type GroupComposition struct {
+	Operation string   `json:"operation"`
+	Groups    []string `json:"groups"`
+}
+
+// Validate checks the operation and that it applies to at least two groups
+func (c *GroupComposition) Validate() error {
+	if !slices.Contains(CompositionOperations, c.Operation) {
+		return errors.WrapInvalidCompositionOperation(c.Operation, CompositionOperations)
+	}
+	if len(c.Groups) < 2 {
+		return errors.ErrCompositionNeedsTwoGroups
+	}
+	return nil
+}
+
+// String returns the operation followed by the composed groups, e.g. intersect(frontend, critical)
+func (c *GroupComposition) String() string {
+	return fmt.Sprintf("%s(%s)", c.Operation, strings.Join(c.Groups, ", "))
+}
+
+// Apply combines the repository names of the composed groups, given in the order of
+// Groups, keeping the order in which repositories first appear
+func (c *GroupComposition) Apply(members [][]string) []string {
+	if len(members) == 0 {
+		return nil
+	}
+
+	var result []string
+	switch c.Operation {
+	case CompositionUnion:
+		for _, names := range members {
+			for _, name := range names {
+				if !slices.Contains(result, name) {
+					result = append(result, name)
+				}
+			}
+		}
+	case CompositionIntersect:
+		for _, name := range members[0] {
+			inAll := true
+			for _, names := range members[1:] {
+				inAll = inAll && slices.Contains(names, name)
+			}
+			if inAll && !slices.Contains(result, name) {
+				result = append(result, name)
+			}
+		}
+	case CompositionSubtract:
+		for _, name := range members[0] {
+			inOther := false
+			for _, names := range members[1:] {
+				inOther = inOther || slices.Contains(names, name)
+			}
+			if !inOther && !slices.Contains(result, name) {
+				result = append(result, name)
+			}
+		}
+	}
+
+	return result
 }
 
 // NewGroup creates a new group with the given name and repositories
@@ -49,6 +128,16 @@ func (g *Group) IsEmpty() bool {
 	return len(g.Repositories) == 0
 }
 
+// IsComposed returns true if the group is made of other groups
+func (g *Group) IsComposed() bool {
+	return g.Composition != nil
+}
+
+// HasDefaultCommand returns true if the group runs a command when none is given
+func (g *Group) HasDefaultCommand() bool {
+	return g.DefaultCommand != ""
+}
+
 // Count returns the number of repositories in the group
 func (g *Group) Count() int {
 	return len(g.Repositories)
@@ -59,6 +148,9 @@ func (g *Group) Validate() error {
 	if g.Name == "" {
 		return errors.ErrGroupNameEmpty
 	}
+	if g.IsComposed() {
+		return g.Composition.Validate()
+	}
 	if g.IsEmpty() {
 		return errors.ErrGroupMustHaveRepositories
 	}
@@ -69,3 +161,66 @@ func (g *Group) Validate() error {
 func (g *Group) String() string {
 	return fmt.Sprintf("Group{Name: %s, Repositories: %v}", g.Name, g.Repositories)
 }
+
+// GroupSelection is a group name given on the command line, optionally followed by a
+// [start:end] range selecting part of its repositories, e.g. all[0:10]
+type GroupSelection struct {
+	Group    string
+	Start    int
+	End      int // -1 when the range runs to the last repository
+	HasRange bool
+}
+
+// ParseGroupSelection parses a group token with an optional [start:end] range, where
+// either bound may be omitted. Bounds are zero-based and the end is excluded.
+func ParseGroupSelection(token string) (GroupSelection, error) {
+	open := strings.Index(token, "[")
+	if open < 0 {
+		return GroupSelection{Group: token, End: -1}, nil
+	}
+
+	if open == 0 || !strings.HasSuffix(token, "]") {
+		return GroupSelection{}, errors.WrapInvalidGroupRange(token)
+	}
+
+	startText, endText, found := strings.Cut(token[open+1:len(token)-1], ":")
+	if !found {
+		return GroupSelection{}, errors.WrapInvalidGroupRange(token)
+	}
+
+	selection := GroupSelection{Group: token[:open], End: -1, HasRange: true}
+	var err error
+	if startText != "" {
+		if selection.Start, err = strconv.Atoi(startText); err != nil || selection.Start < 0 {
+			return GroupSelection{}, errors.WrapInvalidGroupRange(token)
+		}
+	}
+	if endText != "" {
+		if selection.End, err = strconv.Atoi(endText); err != nil || selection.End < selection.Start {
+			return GroupSelection{}, errors.WrapInvalidGroupRange(token)
+		}
+	}
+
+	return selection, nil
+}
+
+// Apply returns the repositories in the range of the selection. Bounds past the last
+// repository are clamped, which is reported so that callers can warn about it.
+func (s GroupSelection) Apply(repositories []*Repository) (selected []*Repository, clamped bool) {
+	if !s.HasRange {
+		return repositories, false
+	}
+
+	start, end := s.Start, s.End
+	if end < 0 {
+		end = len(repositories)
+	}
+	if end > len(repositories) {
+		end, clamped = len(repositories), true
+	}
+	if start > end {
+		start, clamped = end, true
+	}
+
+	return repositories[start:end], clamped
+}
@@ -1,7 +1,10 @@
 package entities
 
 import (
+	"slices"
 	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 func TestNewGroup(t *testing.T) {
@@ -458,3 +461,112 @@ func TestGroup_ComplexOperations(t *testing.T) {
 		t.Error("Expected validation to fail for empty group")
 	}
 }
+
+func TestParseGroupSelection(t *testing.T) {
+	tests := []struct {
+		token   string
+		want    GroupSelection
+		wantErr bool
+	}{
+		{token: "all", want: GroupSelection{Group: "all", End: -1}},
+		{token: "all[0:10]", want: GroupSelection{Group: "all", Start: 0, End: 10, HasRange: true}},
+		{token: "all[10:]", want: GroupSelection{Group: "all", Start: 10, End: -1, HasRange: true}},
+		{token: "*[:5]", want: GroupSelection{Group: "*", Start: 0, End: 5, HasRange: true}},
+		{token: "all[5]", wantErr: true},
+		{token: "all[5:2]", wantErr: true},
+		{token: "all[-1:2]", wantErr: true},
+		{token: "all[a:b]", wantErr: true},
+		{token: "all[0:10", wantErr: true},
+		{token: "[0:10]", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseGroupSelection(tt.token)
+		if tt.wantErr {
+			if !errors.IsError(err, errors.ErrInvalidGroupRange) {
+				t.Errorf("ParseGroupSelection(%q) error = %v, want ErrInvalidGroupRange", tt.token, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseGroupSelection(%q) = %+v, %v, want %+v", tt.token, got, err, tt.want)
+		}
+	}
+}
+
+func TestGroupSelection_Apply(t *testing.T) {
+	repos := []*Repository{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}
+
+	tests := []struct {
+		token       string
+		wantNames   string
+		wantClamped bool
+	}{
+		{token: "all", wantNames: "abcd"},
+		{token: "all[0:2]", wantNames: "ab"},
+		{token: "all[2:]", wantNames: "cd"},
+		{token: "all[2:10]", wantNames: "cd", wantClamped: true},
+		{token: "all[6:10]", wantNames: "", wantClamped: true},
+	}
+
+	for _, tt := range tests {
+		selection, err := ParseGroupSelection(tt.token)
+		if err != nil {
+			t.Fatalf("ParseGroupSelection(%q) error = %v", tt.token, err)
+		}
+		selected, clamped := selection.Apply(repos)
+		names := ""
+		for _, repo := range selected {
+			names += repo.Name
+		}
+		if names != tt.wantNames || clamped != tt.wantClamped {
+			t.Errorf("Apply(%q) = %q, clamped %v, want %q, clamped %v", tt.token, names, clamped, tt.wantNames, tt.wantClamped)
+		}
+	}
+}
+
+func TestGroupComposition_Apply(t *testing.T) {
+	frontend := []string{"web", "mobile", "docs"}
+	critical := []string{"api", "web", "mobile"}
+	mobile := []string{"mobile"}
+
+	tests := []struct {
+		operation string
+		members   [][]string
+		want      []string
+	}{
+		{CompositionUnion, [][]string{frontend, critical}, []string{"web", "mobile", "docs", "api"}},
+		{CompositionIntersect, [][]string{frontend, critical}, []string{"web", "mobile"}},
+		{CompositionIntersect, [][]string{frontend, critical, mobile}, []string{"mobile"}},
+		{CompositionSubtract, [][]string{frontend, critical}, []string{"docs"}},
+		{CompositionSubtract, [][]string{critical, frontend, mobile}, []string{"api"}},
+	}
+
+	for _, tt := range tests {
+		composition := &GroupComposition{Operation: tt.operation}
+		if got := composition.Apply(tt.members); !slices.Equal(got, tt.want) {
+			t.Errorf("Apply() with %s = %v, want %v", tt.operation, got, tt.want)
+		}
+	}
+}
+
+func TestGroupComposition_Validate(t *testing.T) {
+	valid := NewGroup("critical-frontend", nil)
+	valid.Composition = &GroupComposition{Operation: CompositionIntersect, Groups: []string{"critical", "frontend"}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() of a composed group without repositories error = %v, want nil", err)
+	}
+	if got := valid.Composition.String(); got != "intersect(critical, frontend)" {
+		t.Errorf("String() = %q, want intersect(critical, frontend)", got)
+	}
+
+	unknown := &GroupComposition{Operation: "xor", Groups: []string{"a", "b"}}
+	if err := unknown.Validate(); !errors.IsError(err, errors.ErrInvalidCompositionOperation) {
+		t.Errorf("Validate() of an unknown operation error = %v, want ErrInvalidCompositionOperation", err)
+	}
+
+	single := &GroupComposition{Operation: CompositionUnion, Groups: []string{"a"}}
+	if err := single.Validate(); !errors.IsError(err, errors.ErrCompositionNeedsTwoGroups) {
+		t.Errorf("Validate() of a single group error = %v, want ErrCompositionNeedsTwoGroups", err)
+	}
+}
@@ -1,41 +1,155 @@
 package entities
 
 import (
+	"slices"
+	"sort"
+	"strings"
 	"time"
 )
 
-// RepositoryStatus represents the current state of a repository
+// RepositoryStatus represents the current state of a repository. Its values are stable
+// identifiers, used for filtering and in JSON output, that are only turned into display
+// labels when rendered.
 type RepositoryStatus string
 
 const (
-	StatusClean    RepositoryStatus = "Clean"
-	StatusModified RepositoryStatus = "Modified"
-	StatusError    RepositoryStatus = "Error"
-	StatusWarning  RepositoryStatus = "Warning"
-	StatusCreated  RepositoryStatus = "Created"
-	StatusDeleted  RepositoryStatus = "Deleted"
-	StatusUnknown  RepositoryStatus = "Unknown"
+	StatusClean    RepositoryStatus = "clean"
+	StatusModified RepositoryStatus = "modified"
+	StatusError    RepositoryStatus = "error"
+	StatusWarning  RepositoryStatus = "warning"
+	StatusCreated  RepositoryStatus = "created"
+	StatusDeleted  RepositoryStatus = "deleted"
+	StatusUnknown  RepositoryStatus = "unknown"
+)
+
+// RepositoryStatuses lists the repository statuses
+var RepositoryStatuses = []RepositoryStatus{
+	StatusClean, StatusModified, StatusError, StatusWarning, StatusCreated, StatusDeleted, StatusUnknown,
+}
+
+// ParseRepositoryStatus returns the repository status with the given name, ignoring case
+func ParseRepositoryStatus(name string) (RepositoryStatus, bool) {
+	status := RepositoryStatus(strings.ToLower(strings.TrimSpace(name)))
+	if !slices.Contains(RepositoryStatuses, status) {
+		return "", false
+	}
+	return status, true
+}
+
+// Operations that can be left in progress in a repository
+const (
+	OperationMerge  = "merge"
+	OperationRebase = "rebase"
 )
 
 // Repository represents a Git repository with its metadata
 type Repository struct {
-	Name          string           `json:"name"`
-	Path          string           `json:"path"`
-	Status        RepositoryStatus `json:"status"`
-	Branch        string           `json:"branch"`
-	CreatedFiles  int              `json:"created_files"`
-	ModifiedFiles int              `json:"modified_files"`
-	DeletedFiles  int              `json:"deleted_files"`
-	LastChecked   time.Time        `json:"last_checked"`
-	IsValid       bool             `json:"is_valid"`
-	ErrorMessage  string           `json:"error_message,omitempty"`
+	Name            string            `json:"name"`
+	Path            string            `json:"path"`
+	Description     string            `json:"description,omitempty"`
+	Status          RepositoryStatus  `json:"status"`
+	Branch          string            `json:"branch"`
+	CreatedFiles    int               `json:"created_files"`
+	ModifiedFiles   int               `json:"modified_files"`
+	DeletedFiles    int               `json:"deleted_files"`
+	Ahead           int               `json:"ahead"`
+	Behind          int               `json:"behind"`
+	LastChecked     time.Time         `json:"last_checked"`
+	IsValid         bool              `json:"is_valid"`
+	ErrorMessage    string            `json:"error_message,omitempty"`
+	InProgress      string            `json:"in_progress,omitempty"`
+	BlockedCommands []string          `json:"blocked_commands,omitempty"`
+	DependsOn       []string          `json:"depends_on,omitempty"`
+	Env             map[string]string `json:"env,omitempty"`
+	Remote          string            `json:"remote,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Timeout         time.Duration     `json:"timeout,omitempty"`
+	LastOperation   *LastOperation    `json:"last_operation,omitempty"`
 }
 
+// LastOperation is the last command gf ran in a repository and how it ended
+type LastOperation struct {
+	Command string          `json:"command"`
+	Time    time.Time       `json:"time"`
+	Status  ExecutionStatus `json:"status"`
+}
+
+// DefaultRemote is the remote used for repositories that do not configure one
+const DefaultRemote = "origin"
+
+// NotAGitRepositoryMessage is the error message of configured paths that are not git repositories
+const NotAGitRepositoryMessage = "not a git repository"
+
+// UntaggedSection names the section of repositories without tags when grouping by tag
+const UntaggedSection = "untagged"
+
 // HasChanges returns true if the repository has any pending changes
 func (r *Repository) HasChanges() bool {
 	return r.CreatedFiles > 0 || r.ModifiedFiles > 0 || r.DeletedFiles > 0
 }
 
+// ChangeCount returns the number of created, modified and deleted files
+func (r *Repository) ChangeCount() int {
+	return r.CreatedFiles + r.ModifiedFiles + r.DeletedFiles
+}
+
+// IsCommandBlocked returns true if the command's git subcommand is blocked for this repository
+func (r *Repository) IsCommandBlocked(cmd *Command) bool {
+	subcommand := cmd.Subcommand()
+	for _, blocked := range r.BlockedCommands {
+		if blocked == subcommand {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoteName returns the configured remote of the repository, or DefaultRemote
+func (r *Repository) RemoteName() string {
+	if r.Remote == "" {
+		return DefaultRemote
+	}
+	return r.Remote
+}
+
+// GroupByTag sorts repositories into sections by tag, keeping their order within each
+// section. A repository with several tags appears in each of their sections, and
+// repositories without tags go to UntaggedSection. The returned tags are sorted, with
+// UntaggedSection last.
+func GroupByTag(repos []*Repository) ([]string, map[string][]*Repository) {
+	sections := make(map[string][]*Repository)
+	var tags []string
+	for _, repo := range repos {
+		repoTags := repo.Tags
+		if len(repoTags) == 0 {
+			repoTags = []string{UntaggedSection}
+		}
+		for _, tag := range repoTags {
+			if _, exists := sections[tag]; !exists && tag != UntaggedSection {
+				tags = append(tags, tag)
+			}
+			sections[tag] = append(sections[tag], repo)
+		}
+	}
+
+	sort.Strings(tags)
+	if _, exists := sections[UntaggedSection]; exists {
+		tags = append(tags, UntaggedSection)
+	}
+
+	return tags, sections
+}
+
+// HasOperationInProgress returns true if a merge or rebase was left unfinished
+func (r *Repository) HasOperationInProgress() bool {
+	return r.InProgress != ""
+}
+
+// IsNotGitRepository returns true if the path of the repository was found not to be a git repository
+func (r *Repository) IsNotGitRepository() bool {
+	return !r.IsValid && r.ErrorMessage == NotAGitRepositoryMessage
+}
+
 // IsHealthy returns true if the repository is in a good state
 func (r *Repository) IsHealthy() bool {
 	return r.IsValid && r.Status != StatusError
@@ -56,6 +170,11 @@ func (r *Repository) UpdateStatus() {
 		return
 	}
 
+	if r.HasOperationInProgress() {
+		r.Status = StatusWarning
+		return
+	}
+
 	if r.HasChanges() {
 		r.Status = StatusModified
 		return
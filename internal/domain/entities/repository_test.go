@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -11,13 +12,13 @@ func TestRepositoryStatus_Constants(t *testing.T) {
 		status   RepositoryStatus
 		expected string
 	}{
-		{"Clean status", StatusClean, "Clean"},
-		{"Modified status", StatusModified, "Modified"},
-		{"Error status", StatusError, "Error"},
-		{"Warning status", StatusWarning, "Warning"},
-		{"Created status", StatusCreated, "Created"},
-		{"Deleted status", StatusDeleted, "Deleted"},
-		{"Unknown status", StatusUnknown, "Unknown"},
+		{"Clean status", StatusClean, "clean"},
+		{"Modified status", StatusModified, "modified"},
+		{"Error status", StatusError, "error"},
+		{"Warning status", StatusWarning, "warning"},
+		{"Created status", StatusCreated, "created"},
+		{"Deleted status", StatusDeleted, "deleted"},
+		{"Unknown status", StatusUnknown, "unknown"},
 	}
 
 	for _, tt := range tests {
@@ -30,6 +31,27 @@ func TestRepositoryStatus_Constants(t *testing.T) {
 	}
 }
 
+func TestParseRepositoryStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected RepositoryStatus
+		ok       bool
+	}{
+		{"clean", StatusClean, true},
+		{"Modified", StatusModified, true},
+		{" ERROR ", StatusError, true},
+		{"dirty", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		status, ok := ParseRepositoryStatus(tt.name)
+		if status != tt.expected || ok != tt.ok {
+			t.Errorf("ParseRepositoryStatus(%q) = %q, %v, want %q, %v", tt.name, status, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
 func TestRepository_HasChanges(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -218,6 +240,23 @@ func TestRepository_UpdateStatus(t *testing.T) {
 			},
 			expectedStatus: StatusClean,
 		},
+		{
+			name: "valid repository with rebase in progress should be warning",
+			repo: Repository{
+				IsValid:       true,
+				ModifiedFiles: 1,
+				InProgress:    OperationRebase,
+			},
+			expectedStatus: StatusWarning,
+		},
+		{
+			name: "invalid repository with merge in progress should be error",
+			repo: Repository{
+				IsValid:    false,
+				InProgress: OperationMerge,
+			},
+			expectedStatus: StatusError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -323,3 +362,66 @@ func TestRepository_RepositoryCreation(t *testing.T) {
 		t.Errorf("Expected empty ErrorMessage by default, got %s", repo.ErrorMessage)
 	}
 }
+
+func TestRepository_ChangeCount(t *testing.T) {
+	repo := Repository{CreatedFiles: 1, ModifiedFiles: 2, DeletedFiles: 3}
+	if got := repo.ChangeCount(); got != 6 {
+		t.Errorf("ChangeCount() = %d, want 6", got)
+	}
+}
+
+func TestRepository_IsCommandBlocked(t *testing.T) {
+	repo := Repository{BlockedCommands: []string{"push", "reset"}}
+
+	tests := []struct {
+		args     []string
+		expected bool
+	}{
+		{[]string{"push", "origin", "main"}, true},
+		{[]string{"git", "reset", "--hard"}, true},
+		{[]string{"pull"}, false},
+		{[]string{"log", "--grep", "push"}, false},
+	}
+
+	for _, tt := range tests {
+		cmd := &Command{Args: tt.args}
+		if got := repo.IsCommandBlocked(cmd); got != tt.expected {
+			t.Errorf("IsCommandBlocked(%v) = %v, want %v", tt.args, got, tt.expected)
+		}
+	}
+}
+
+func TestRepository_RemoteName(t *testing.T) {
+	if got := (&Repository{}).RemoteName(); got != DefaultRemote {
+		t.Errorf("RemoteName() = %s, want %s", got, DefaultRemote)
+	}
+	if got := (&Repository{Remote: "upstream"}).RemoteName(); got != "upstream" {
+		t.Errorf("RemoteName() = %s, want upstream", got)
+	}
+}
+
+func TestGroupByTag(t *testing.T) {
+	api := &Repository{Name: "api", Tags: []string{"go", "backend"}}
+	web := &Repository{Name: "web", Tags: []string{"frontend"}}
+	docs := &Repository{Name: "docs"}
+	worker := &Repository{Name: "worker", Tags: []string{"backend"}}
+
+	tags, sections := GroupByTag([]*Repository{api, web, docs, worker})
+
+	if strings.Join(tags, ",") != "backend,frontend,go,untagged" {
+		t.Errorf("GroupByTag() tags = %v, want [backend frontend go untagged]", tags)
+	}
+	if len(sections["backend"]) != 2 || sections["backend"][0] != api || sections["backend"][1] != worker {
+		t.Errorf("GroupByTag() backend section = %v, want [api worker]", sections["backend"])
+	}
+	if len(sections["go"]) != 1 || sections["go"][0] != api {
+		t.Errorf("GroupByTag() go section = %v, want [api]", sections["go"])
+	}
+	if len(sections[UntaggedSection]) != 1 || sections[UntaggedSection][0] != docs {
+		t.Errorf("GroupByTag() untagged section = %v, want [docs]", sections[UntaggedSection])
+	}
+
+	if tags, _ := GroupByTag([]*Repository{api}); strings.Join(tags, ",") != "backend,go" {
+		t.Errorf("GroupByTag() without untagged repositories = %v, want [backend go]", tags)
+	}
+}
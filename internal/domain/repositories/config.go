@@ -3,8 +3,12 @@ package repositories
 
 import (
 	"context"
+	"slices"
+	"sort"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 // ConfigRepository defines the interface for configuration persistence
@@ -26,19 +30,117 @@ type ConfigRepository interface {
 
 	// Validate validates the configuration
 	Validate(ctx context.Context, config *Config) error
+
+	// Backup copies the current configuration file to the backups directory and returns the backup path
+	Backup(ctx context.Context) (string, error)
+
+	// ListBackups returns the names of the available backups, newest first
+	ListBackups(ctx context.Context) ([]string, error)
+
+	// RestoreBackup replaces the configuration file with the named backup
+	RestoreBackup(ctx context.Context, name string) error
+
+	// ChangedOnDisk reports whether the configuration file changed since it was last loaded or saved
+	ChangedOnDisk(ctx context.Context) (bool, error)
 }
 
+// CurrentConfigVersion is the configuration schema version written by this release
+const CurrentConfigVersion = 1
+
 // Config represents the application configuration
 type Config struct {
-	Repositories map[string]*RepositoryConfig `json:"repositories"`
-	Groups       map[string]*entities.Group   `json:"groups"`
-	Theme        string                       `json:"theme,omitempty"`
-	Version      string                       `json:"version,omitempty"`
+	Repositories   map[string]*RepositoryConfig `json:"repositories"`
+	Order          []string                     `json:"order,omitempty"`
+	Groups         map[string]*entities.Group   `json:"groups"`
+	Theme          string                       `json:"theme,omitempty"`
+	ThemeSchedule  *ThemeSchedule               `json:"theme_schedule,omitempty"`
+	BorderStyle    string                       `json:"border_style,omitempty"`
+	SummaryMetrics []string                     `json:"summary_metrics,omitempty"`
+	WorktreePath   string                       `json:"worktree_path,omitempty"`
+	AutoDiscover   *AutoDiscover                `json:"auto_discover,omitempty"`
+	Pager          bool                         `json:"pager,omitempty"`
+	Version        int                          `json:"version"`
+}
+
+// ThemeSchedule holds the hours of the time theme, which is light from DayStart until
+// DayEnd and NightTheme the rest of the day. DayEnd may be before DayStart for days
+// running past midnight.
+type ThemeSchedule struct {
+	DayStart   int    `json:"day_start"`
+	DayEnd     int    `json:"day_end"`
+	NightTheme string `json:"night_theme,omitempty"`
+}
+
+// AutoDiscoverOnStartup runs the discovery of new repositories each time gf starts
+const AutoDiscoverOnStartup = "on-startup"
+
+// Defaults of the automatic discovery
+const (
+	DefaultDiscoverCooldown = time.Hour
+	DefaultDiscoverMaxDepth = 3
+)
+
+// AutoDiscover holds the automatic discovery settings: when When is on-startup, the
+// Roots are scanned for repositories missing from the configuration at most once per
+// Cooldown, MaxDepth directories deep.
+type AutoDiscover struct {
+	When     string   `json:"when"`
+	Roots    []string `json:"roots"`
+	Cooldown string   `json:"cooldown,omitempty"`
+	MaxDepth int      `json:"max_depth,omitempty"`
+}
+
+// OnStartup returns true if the discovery runs when gf starts
+func (ad *AutoDiscover) OnStartup() bool {
+	return ad != nil && ad.When == AutoDiscoverOnStartup && len(ad.Roots) > 0
+}
+
+// CooldownDuration returns the time to wait between two discoveries, the default one
+// when the cooldown is unset or not a valid duration
+func (ad *AutoDiscover) CooldownDuration() time.Duration {
+	if ad.Cooldown == "" {
+		return DefaultDiscoverCooldown
+	}
+
+	cooldown, err := time.ParseDuration(ad.Cooldown)
+	if err != nil || cooldown < 0 {
+		return DefaultDiscoverCooldown
+	}
+	return cooldown
+}
+
+// Depth returns how many directories deep the roots are scanned
+func (ad *AutoDiscover) Depth() int {
+	if ad.MaxDepth <= 0 {
+		return DefaultDiscoverMaxDepth
+	}
+	return ad.MaxDepth
 }
 
 // RepositoryConfig represents a repository configuration
 type RepositoryConfig struct {
-	Path string `json:"path"`
+	Path            string            `json:"path"`
+	Description     string            `json:"description,omitempty"`
+	BlockedCommands []string          `json:"blocked_commands,omitempty"`
+	DependsOn       []string          `json:"depends_on,omitempty"`
+	Env             map[string]string `json:"env,omitempty"`
+	Remote          string            `json:"remote,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Timeout         string            `json:"timeout,omitempty"`
+}
+
+// ExecutionTimeout returns the parsed timeout of the repository, or zero when it is
+// unset or not a valid duration, in which case the global timeout applies
+func (rc *RepositoryConfig) ExecutionTimeout() time.Duration {
+	if rc.Timeout == "" {
+		return 0
+	}
+
+	timeout, err := time.ParseDuration(rc.Timeout)
+	if err != nil || timeout < 0 {
+		return 0
+	}
+	return timeout
 }
 
 // GetRepository returns a repository by name
@@ -49,22 +151,42 @@ func (c *Config) GetRepository(name string) (*entities.Repository, bool) {
 	}
 
 	repo := &entities.Repository{
-		Name: name,
-		Path: configRepo.Path,
+		Name:            name,
+		Path:            configRepo.Path,
+		Description:     configRepo.Description,
+		BlockedCommands: configRepo.BlockedCommands,
+		DependsOn:       configRepo.DependsOn,
+		Env:             configRepo.Env,
+		Remote:          configRepo.Remote,
+		Tags:            configRepo.Tags,
+		Timeout:         configRepo.ExecutionTimeout(),
 	}
 
 	return repo, true
 }
 
-// GetRepositoriesForGroup returns all repositories in a group
+// AllRepositoriesSelection is the group name selecting every configured repository,
+// whether or not an all group exists
+const AllRepositoriesSelection = "*"
+
+// GetRepositoriesForGroup returns all repositories in a group. AllRepositoriesSelection
+// returns every configured repository, sorted by name.
 func (c *Config) GetRepositoriesForGroup(groupName string) ([]*entities.Repository, error) {
-	group, exists := c.Groups[groupName]
-	if !exists {
-		return nil, ErrGroupNotFound{GroupName: groupName}
+	if groupName == AllRepositoriesSelection {
+		repositories := c.GetAllRepositories()
+		sort.Slice(repositories, func(i, j int) bool {
+			return repositories[i].Name < repositories[j].Name
+		})
+		return repositories, nil
+	}
+
+	repoNames, err := c.GroupRepositoryNames(groupName)
+	if err != nil {
+		return nil, err
 	}
 
 	var repositories []*entities.Repository
-	for _, repoName := range group.Repositories {
+	for _, repoName := range repoNames {
 		repo, exists := c.GetRepository(repoName)
 		if !exists {
 			// Log warning but continue
@@ -76,48 +198,137 @@ func (c *Config) GetRepositoriesForGroup(groupName string) ([]*entities.Reposito
 	return repositories, nil
 }
 
-// GetAllRepositories returns all configured repositories
+// GroupRepositoryNames returns the repository names of a group, resolving the groups
+// composed of other groups recursively
+func (c *Config) GroupRepositoryNames(groupName string) ([]string, error) {
+	return c.groupRepositoryNames(groupName, make(map[string]bool))
+}
+
+// groupRepositoryNames resolves the repository names of a group, where resolving holds
+// the composed groups being resolved so that cycles are reported instead of looping
+func (c *Config) groupRepositoryNames(groupName string, resolving map[string]bool) ([]string, error) {
+	group, exists := c.Groups[groupName]
+	if !exists {
+		return nil, ErrGroupNotFound{GroupName: groupName}
+	}
+	if !group.IsComposed() {
+		return group.Repositories, nil
+	}
+
+	if resolving[groupName] {
+		return nil, errors.WrapGroupCompositionCycle(groupName)
+	}
+	resolving[groupName] = true
+	defer delete(resolving, groupName)
+
+	members := make([][]string, 0, len(group.Composition.Groups))
+	for _, name := range group.Composition.Groups {
+		repoNames, err := c.groupRepositoryNames(name, resolving)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, repoNames)
+	}
+
+	return group.Composition.Apply(members), nil
+}
+
+// RepositoryNames returns the names of the configured repositories in their configured
+// order: those listed in Order first, then the others by name
+func (c *Config) RepositoryNames() []string {
+	names := make([]string, 0, len(c.Repositories))
+	for _, name := range c.Order {
+		if _, exists := c.Repositories[name]; exists && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	unordered := make([]string, 0, len(c.Repositories)-len(names))
+	for name := range c.Repositories {
+		if !slices.Contains(names, name) {
+			unordered = append(unordered, name)
+		}
+	}
+	sort.Strings(unordered)
+
+	return append(names, unordered...)
+}
+
+// GetAllRepositories returns all configured repositories in their configured order
 func (c *Config) GetAllRepositories() []*entities.Repository {
 	var repositories []*entities.Repository
-	for name, configRepo := range c.Repositories {
+	for _, name := range c.RepositoryNames() {
+		configRepo := c.Repositories[name]
 		repo := &entities.Repository{
-			Name: name,
-			Path: configRepo.Path,
+			Name:            name,
+			Path:            configRepo.Path,
+			Description:     configRepo.Description,
+			BlockedCommands: configRepo.BlockedCommands,
+			DependsOn:       configRepo.DependsOn,
+			Env:             configRepo.Env,
+			Remote:          configRepo.Remote,
+			Tags:            configRepo.Tags,
+			Timeout:         configRepo.ExecutionTimeout(),
 		}
 		repositories = append(repositories, repo)
 	}
 	return repositories
 }
 
-// GetAllGroups returns all configured groups
+// GetAllGroups returns all configured groups, sorted by name
 func (c *Config) GetAllGroups() []*entities.Group {
 	var groups []*entities.Group
-	for _, group := range c.Groups {
-		groups = append(groups, group)
+	for _, name := range c.GetGroupNames() {
+		groups = append(groups, c.Groups[name])
 	}
 	return groups
 }
 
-// GetGroupNames returns all group names
+// GetGroupNames returns all group names, sorted
 func (c *Config) GetGroupNames() []string {
 	names := make([]string, 0, len(c.Groups))
 	for name := range c.Groups {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
-// AddRepository adds a repository to the configuration
+// ConfigListing is a configuration with the names of its repositories and groups in the
+// order they are shown
+type ConfigListing struct {
+	Config       *Config
+	Repositories []string
+	Groups       []string
+}
+
+// NewConfigListing returns the listing of the configuration in its default order: the
+// repositories in their configured order and the groups by name
+func NewConfigListing(config *Config) *ConfigListing {
+	return &ConfigListing{
+		Config:       config,
+		Repositories: config.RepositoryNames(),
+		Groups:       config.GetGroupNames(),
+	}
+}
+
+// AddRepository adds a repository to the configuration. When an order is configured,
+// the repository is added at its end.
 func (c *Config) AddRepository(name, path string) {
 	if c.Repositories == nil {
 		c.Repositories = make(map[string]*RepositoryConfig)
 	}
 	c.Repositories[name] = &RepositoryConfig{Path: path}
+
+	if len(c.Order) > 0 && !slices.Contains(c.Order, name) {
+		c.Order = append(c.Order, name)
+	}
 }
 
 // RemoveRepository removes a repository from the configuration
 func (c *Config) RemoveRepository(name string) {
 	delete(c.Repositories, name)
+	c.Order = slices.DeleteFunc(c.Order, func(ordered string) bool { return ordered == name })
 
 	// Remove from all groups
 	for _, group := range c.Groups {
@@ -40,6 +40,36 @@ func (m *MockConfigRepository) EXPECT() *MockConfigRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Backup mocks base method.
+func (m *MockConfigRepository) Backup(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockConfigRepositoryMockRecorder) Backup(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockConfigRepository)(nil).Backup), ctx)
+}
+
+// ChangedOnDisk mocks base method.
+func (m *MockConfigRepository) ChangedOnDisk(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangedOnDisk", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangedOnDisk indicates an expected call of ChangedOnDisk.
+func (mr *MockConfigRepositoryMockRecorder) ChangedOnDisk(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangedOnDisk", reflect.TypeOf((*MockConfigRepository)(nil).ChangedOnDisk), ctx)
+}
+
 // CreateDefault mocks base method.
 func (m *MockConfigRepository) CreateDefault(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -82,6 +112,21 @@ func (mr *MockConfigRepositoryMockRecorder) GetPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPath", reflect.TypeOf((*MockConfigRepository)(nil).GetPath))
 }
 
+// ListBackups mocks base method.
+func (m *MockConfigRepository) ListBackups(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackups", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackups indicates an expected call of ListBackups.
+func (mr *MockConfigRepositoryMockRecorder) ListBackups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackups", reflect.TypeOf((*MockConfigRepository)(nil).ListBackups), ctx)
+}
+
 // Load mocks base method.
 func (m *MockConfigRepository) Load(ctx context.Context) (*Config, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +142,20 @@ func (mr *MockConfigRepositoryMockRecorder) Load(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockConfigRepository)(nil).Load), ctx)
 }
 
+// RestoreBackup mocks base method.
+func (m *MockConfigRepository) RestoreBackup(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBackup", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreBackup indicates an expected call of RestoreBackup.
+func (mr *MockConfigRepositoryMockRecorder) RestoreBackup(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackup", reflect.TypeOf((*MockConfigRepository)(nil).RestoreBackup), ctx, name)
+}
+
 // Save mocks base method.
 func (m *MockConfigRepository) Save(ctx context.Context, config *Config) error {
 	m.ctrl.T.Helper()
@@ -1,15 +1,19 @@
 package repositories
 
 import (
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 func TestConfig_GetRepository(t *testing.T) {
 	config := &Config{
 		Repositories: map[string]*RepositoryConfig{
-			"repo1": {Path: "/path/to/repo1"},
+			"repo1": {Path: "/path/to/repo1", Description: "legacy, read-only"},
 			"repo2": {Path: "/path/to/repo2"},
 		},
 	}
@@ -25,6 +29,9 @@ func TestConfig_GetRepository(t *testing.T) {
 		if repo.Path != "/path/to/repo1" {
 			t.Errorf("Path = %s, want %s", repo.Path, "/path/to/repo1")
 		}
+		if repo.Description != "legacy, read-only" {
+			t.Errorf("Description = %s, want %s", repo.Description, "legacy, read-only")
+		}
 	})
 
 	t.Run("non-existing repository", func(t *testing.T) {
@@ -43,6 +50,57 @@ func TestConfig_GetRepository(t *testing.T) {
 	})
 }
 
+func TestAutoDiscover(t *testing.T) {
+	var unset *AutoDiscover
+	if unset.OnStartup() {
+		t.Error("OnStartup() = true without settings")
+	}
+	if (&AutoDiscover{When: AutoDiscoverOnStartup}).OnStartup() {
+		t.Error("OnStartup() = true without roots")
+	}
+
+	settings := &AutoDiscover{When: AutoDiscoverOnStartup, Roots: []string{"~/src"}, Cooldown: "15m"}
+	if !settings.OnStartup() || settings.CooldownDuration() != 15*time.Minute || settings.Depth() != DefaultDiscoverMaxDepth {
+		t.Errorf("AutoDiscover %+v: OnStartup() = %v, CooldownDuration() = %v, Depth() = %d",
+			settings, settings.OnStartup(), settings.CooldownDuration(), settings.Depth())
+	}
+
+	invalid := &AutoDiscover{Cooldown: "hourly", MaxDepth: 5}
+	if invalid.CooldownDuration() != DefaultDiscoverCooldown || invalid.Depth() != 5 {
+		t.Errorf("CooldownDuration() = %v, Depth() = %d, want the default cooldown and 5", invalid.CooldownDuration(), invalid.Depth())
+	}
+}
+
+func TestRepositoryConfig_ExecutionTimeout(t *testing.T) {
+	tests := []struct {
+		timeout string
+		want    time.Duration
+	}{
+		{"", 0},
+		{"10m", 10 * time.Minute},
+		{"90s", 90 * time.Second},
+		{"ten minutes", 0},
+		{"-5m", 0},
+	}
+
+	for _, tt := range tests {
+		rc := &RepositoryConfig{Path: "/path/to/repo", Timeout: tt.timeout}
+		if got := rc.ExecutionTimeout(); got != tt.want {
+			t.Errorf("ExecutionTimeout() with %q = %v, want %v", tt.timeout, got, tt.want)
+		}
+	}
+
+	config := &Config{
+		Repositories: map[string]*RepositoryConfig{
+			"monorepo": {Path: "/path/to/monorepo", Timeout: "10m"},
+		},
+	}
+	repo, _ := config.GetRepository("monorepo")
+	if repo.Timeout != 10*time.Minute {
+		t.Errorf("GetRepository() Timeout = %v, want %v", repo.Timeout, 10*time.Minute)
+	}
+}
+
 func TestConfig_GetRepositoriesForGroup(t *testing.T) {
 	group1 := entities.NewGroup("group1", []string{"repo1", "repo2"})
 	group2 := entities.NewGroup("group2", []string{"repo3", "nonexistent"})
@@ -105,6 +163,53 @@ func TestConfig_GetRepositoriesForGroup(t *testing.T) {
 			t.Errorf("Expected group name 'nonexistent', got %s", groupNotFoundErr.GroupName)
 		}
 	})
+
+	t.Run("composed groups", func(t *testing.T) {
+		composed := &Config{
+			Repositories: config.Repositories,
+			Groups: map[string]*entities.Group{
+				"group1": group1,
+				"group3": entities.NewGroup("group3", []string{"repo2", "repo3"}),
+				"both": {Name: "both", Composition: &entities.GroupComposition{
+					Operation: entities.CompositionIntersect, Groups: []string{"group1", "group3"},
+				}},
+				"either": {Name: "either", Composition: &entities.GroupComposition{
+					Operation: entities.CompositionUnion, Groups: []string{"both", "group3"},
+				}},
+				"loop": {Name: "loop", Composition: &entities.GroupComposition{
+					Operation: entities.CompositionUnion, Groups: []string{"group1", "loop"},
+				}},
+			},
+		}
+
+		repos, err := composed.GetRepositoriesForGroup("both")
+		if err != nil || len(repos) != 1 || repos[0].Name != "repo2" {
+			t.Errorf("Expected the intersection to be repo2, got %v, %v", repos, err)
+		}
+
+		names, err := composed.GroupRepositoryNames("either")
+		if err != nil || strings.Join(names, ",") != "repo2,repo3" {
+			t.Errorf("Expected nested compositions to resolve to repo2,repo3, got %v, %v", names, err)
+		}
+
+		if _, err := composed.GetRepositoriesForGroup("loop"); !errors.IsError(err, errors.ErrGroupCompositionCycle) {
+			t.Errorf("Expected ErrGroupCompositionCycle, got %v", err)
+		}
+	})
+
+	t.Run("all repositories selection without all group", func(t *testing.T) {
+		repos, err := config.GetRepositoriesForGroup(AllRepositoriesSelection)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var names []string
+		for _, repo := range repos {
+			names = append(names, repo.Name)
+		}
+		if strings.Join(names, ",") != "repo1,repo2,repo3" {
+			t.Errorf("Expected every repository sorted by name, got %v", names)
+		}
+	})
 }
 
 func TestConfig_GetAllRepositories(t *testing.T) {
@@ -149,6 +254,59 @@ func TestConfig_GetAllRepositories(t *testing.T) {
 	})
 }
 
+func TestConfig_RepositoryNames(t *testing.T) {
+	config := &Config{
+		Repositories: map[string]*RepositoryConfig{
+			"web":    {Path: "/path/to/web"},
+			"api":    {Path: "/path/to/api"},
+			"worker": {Path: "/path/to/worker"},
+			"docs":   {Path: "/path/to/docs"},
+			"cli":    {Path: "/path/to/cli"},
+		},
+	}
+
+	// Map iteration order varies, the listing must not
+	for range 20 {
+		if names := config.RepositoryNames(); !slices.Equal(names, []string{"api", "cli", "docs", "web", "worker"}) {
+			t.Fatalf("RepositoryNames() = %v, want the repositories sorted by name", names)
+		}
+	}
+
+	config.Order = []string{"worker", "removed", "api", "worker"}
+	expected := []string{"worker", "api", "cli", "docs", "web"}
+	if names := config.RepositoryNames(); !slices.Equal(names, expected) {
+		t.Errorf("RepositoryNames() = %v, want %v", names, expected)
+	}
+
+	var repoNames []string
+	for _, repo := range config.GetAllRepositories() {
+		repoNames = append(repoNames, repo.Name)
+	}
+	if !slices.Equal(repoNames, expected) {
+		t.Errorf("GetAllRepositories() listed %v, want %v", repoNames, expected)
+	}
+}
+
+func TestConfig_OrderMaintenance(t *testing.T) {
+	config := &Config{}
+	config.AddRepository("api", "/path/to/api")
+	if len(config.Order) != 0 {
+		t.Errorf("AddRepository() should not start an order, got %v", config.Order)
+	}
+
+	config.Order = []string{"api"}
+	config.AddRepository("web", "/path/to/web")
+	config.AddRepository("cli", "/path/to/cli")
+	if !slices.Equal(config.Order, []string{"api", "web", "cli"}) {
+		t.Errorf("AddRepository() should append to the order, got %v", config.Order)
+	}
+
+	config.RemoveRepository("web")
+	if !slices.Equal(config.Order, []string{"api", "cli"}) {
+		t.Errorf("RemoveRepository() should remove from the order, got %v", config.Order)
+	}
+}
+
 func TestConfig_GetAllGroups(t *testing.T) {
 	group1 := entities.NewGroup("group1", []string{"repo1"})
 	group2 := entities.NewGroup("group2", []string{"repo2"})
@@ -503,7 +661,7 @@ func TestConfig_Fields(t *testing.T) {
 			"group1": group,
 		},
 		Theme:   "dark",
-		Version: "1.0.0",
+		Version: 1,
 	}
 
 	if len(config.Repositories) != 1 {
@@ -521,7 +679,7 @@ func TestConfig_Fields(t *testing.T) {
 	if config.Theme != "dark" {
 		t.Errorf("Theme = %s, want %s", config.Theme, "dark")
 	}
-	if config.Version != "1.0.0" {
-		t.Errorf("Version = %s, want %s", config.Version, "1.0.0")
+	if config.Version != 1 {
+		t.Errorf("Version = %d, want %d", config.Version, 1)
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
 )
 
 // GitRepository defines the interface for Git operations
@@ -33,6 +34,9 @@ type GitRepository interface {
 	// GetRemotes returns the list of remotes for a repository
 	GetRemotes(ctx context.Context, repo *entities.Repository) ([]string, error)
 
+	// GetRemoteURL returns the URL of the given remote
+	GetRemoteURL(ctx context.Context, repo *entities.Repository, remote string) (string, error)
+
 	// GetLastCommit returns information about the last commit
 	GetLastCommit(ctx context.Context, repo *entities.Repository) (*CommitInfo, error)
 
@@ -41,6 +45,66 @@ type GitRepository interface {
 
 	// GetAheadBehind returns how many commits the repository is ahead/behind of origin
 	GetAheadBehind(ctx context.Context, repo *entities.Repository) (ahead, behind int, err error)
+
+	// GetAheadBehindBranch returns how many commits the current branch of the repository is
+	// ahead/behind of another branch, or ErrBranchNotFound when the repository lacks it
+	GetAheadBehindBranch(ctx context.Context, repo *entities.Repository, branch string) (ahead, behind int, err error)
+
+	// GetDiffStat returns the unstaged changes of a repository, or the staged ones when cached is true
+	GetDiffStat(ctx context.Context, repo *entities.Repository, cached bool) (*DiffStat, error)
+
+	// GetUntrackedFiles returns the untracked files of a repository with their size
+	GetUntrackedFiles(ctx context.Context, repo *entities.Repository) ([]UntrackedFile, error)
+
+	// GetInProgressOperation returns the merge or rebase left in progress, or an empty string
+	GetInProgressOperation(ctx context.Context, repo *entities.Repository) (string, error)
+
+	// GetLockFile returns the index.lock or HEAD.lock file held in the repository by
+	// another git process, or an empty string when it is not locked
+	GetLockFile(ctx context.Context, repo *entities.Repository) (string, error)
+
+	// GetDiskUsage returns the size of the working tree and of the git directory of a
+	// repository, or only the git directory when gitOnly is true
+	GetDiskUsage(ctx context.Context, repo *entities.Repository, gitOnly bool) (*DiskUsage, error)
+
+	// CountTrackedFiles returns the number of files tracked by git in a repository
+	CountTrackedFiles(ctx context.Context, repo *entities.Repository) (int, error)
+
+	// GetSubmodules returns the submodules of a repository, recursively, or none when it
+	// has no .gitmodules file
+	GetSubmodules(ctx context.Context, repo *entities.Repository) ([]Submodule, error)
+
+	// GetAuthorCommitCounts returns the number of commits of each author on the current
+	// branch of a repository, only counting commits after since when it is set
+	GetAuthorCommitCounts(ctx context.Context, repo *entities.Repository, since string) (map[string]int, error)
+
+	// HasTag checks if the repository has a tag with the given name
+	HasTag(ctx context.Context, repo *entities.Repository, tag string) (bool, error)
+
+	// PruneRemote deletes the remote-tracking branches of the remote whose branch no longer
+	// exists and returns the pruned refs
+	PruneRemote(ctx context.Context, repo *entities.Repository, remote string) ([]string, error)
+
+	// FetchPruneTags fetches the remote, pruning the branches and tags deleted on it, and
+	// returns the local tags that were deleted
+	FetchPruneTags(ctx context.Context, repo *entities.Repository, remote string) ([]string, error)
+
+	// GetDefaultBranch returns the default branch of the repository's remote, falling back
+	// to a local main or master branch
+	GetDefaultBranch(ctx context.Context, repo *entities.Repository) (string, error)
+
+	// GetMergedBranches returns the local branches fully merged into the base branch
+	GetMergedBranches(ctx context.Context, repo *entities.Repository, base string) ([]string, error)
+
+	// DeleteBranch deletes a local branch
+	DeleteBranch(ctx context.Context, repo *entities.Repository, branch string) error
+
+	// CleanUntracked removes the untracked files and directories of a repository and
+	// returns their paths, or only lists the ones it would remove with dryRun
+	CleanUntracked(ctx context.Context, repo *entities.Repository, dryRun bool) ([]string, error)
+
+	// SetRemoteURL changes the URL of the given remote
+	SetRemoteURL(ctx context.Context, repo *entities.Repository, remote, url string) error
 }
 
 // CommitInfo represents information about a Git commit
@@ -51,6 +115,55 @@ type CommitInfo struct {
 	Timestamp string `json:"timestamp"`
 }
 
+// DiffStat represents the counts reported by git diff --shortstat
+type DiffStat struct {
+	FilesChanged int `json:"files_changed"`
+	Insertions   int `json:"insertions"`
+	Deletions    int `json:"deletions"`
+}
+
+// Add adds the counts of another diff stat
+func (d *DiffStat) Add(other DiffStat) {
+	d.FilesChanged += other.FilesChanged
+	d.Insertions += other.Insertions
+	d.Deletions += other.Deletions
+}
+
+// UntrackedFile represents a file that git does not track yet
+type UntrackedFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// DiskUsage holds the size in bytes of a repository on disk. WorkTree excludes the
+// git directory.
+type DiskUsage struct {
+	WorkTree int64 `json:"work_tree"`
+	GitDir   int64 `json:"git_dir"`
+}
+
+// Total returns the size of the working tree and the git directory together
+func (d DiskUsage) Total() int64 {
+	return d.WorkTree + d.GitDir
+}
+
+// SubmoduleState is the state of a submodule checkout as reported by git submodule status
+type SubmoduleState string
+
+const (
+	SubmoduleStateCurrent       SubmoduleState = "current"
+	SubmoduleStateOutOfDate     SubmoduleState = "out_of_date"
+	SubmoduleStateUninitialized SubmoduleState = "uninitialized"
+	SubmoduleStateConflicted    SubmoduleState = "conflicted"
+)
+
+// Submodule represents a submodule of a repository, Path being relative to its working tree
+type Submodule struct {
+	Path   string         `json:"path"`
+	Commit string         `json:"commit"`
+	State  SubmoduleState `json:"state"`
+}
+
 // ExecutorRepository defines the interface for command execution
 type ExecutorRepository interface {
 	// ExecuteInParallel executes a command on multiple repositories in parallel
@@ -59,6 +172,10 @@ type ExecutorRepository interface {
 	// ExecuteSequential executes a command on multiple repositories sequentially
 	ExecuteSequential(ctx context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error)
 
+	// ExecuteInOrder executes a command on repositories already sorted by dependency,
+	// skipping the repositories whose dependencies did not succeed
+	ExecuteInOrder(ctx context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error)
+
 	// ExecuteSingle executes a command on a single repository
 	ExecuteSingle(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error)
 
@@ -67,4 +184,7 @@ type ExecutorRepository interface {
 
 	// GetRunningExecutions returns currently running executions
 	GetRunningExecutions(ctx context.Context) ([]*entities.ExecutionResult, error)
+
+	// Clock returns the clock timing the executions and their summaries
+	Clock() clock.Clock
 }
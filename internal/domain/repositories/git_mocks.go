@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entities "github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	clock "github.com/qskkk/git-fleet/v2/internal/pkg/clock"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +42,50 @@ func (m *MockGitRepository) EXPECT() *MockGitRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CleanUntracked mocks base method.
+func (m *MockGitRepository) CleanUntracked(ctx context.Context, repo *entities.Repository, dryRun bool) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanUntracked", ctx, repo, dryRun)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanUntracked indicates an expected call of CleanUntracked.
+func (mr *MockGitRepositoryMockRecorder) CleanUntracked(ctx, repo, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanUntracked", reflect.TypeOf((*MockGitRepository)(nil).CleanUntracked), ctx, repo, dryRun)
+}
+
+// CountTrackedFiles mocks base method.
+func (m *MockGitRepository) CountTrackedFiles(ctx context.Context, repo *entities.Repository) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTrackedFiles", ctx, repo)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTrackedFiles indicates an expected call of CountTrackedFiles.
+func (mr *MockGitRepositoryMockRecorder) CountTrackedFiles(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTrackedFiles", reflect.TypeOf((*MockGitRepository)(nil).CountTrackedFiles), ctx, repo)
+}
+
+// DeleteBranch mocks base method.
+func (m *MockGitRepository) DeleteBranch(ctx context.Context, repo *entities.Repository, branch string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBranch", ctx, repo, branch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBranch indicates an expected call of DeleteBranch.
+func (mr *MockGitRepositoryMockRecorder) DeleteBranch(ctx, repo, branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBranch", reflect.TypeOf((*MockGitRepository)(nil).DeleteBranch), ctx, repo, branch)
+}
+
 // ExecuteCommand mocks base method.
 func (m *MockGitRepository) ExecuteCommand(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +116,21 @@ func (mr *MockGitRepositoryMockRecorder) ExecuteShellCommand(ctx, repo, cmd any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteShellCommand", reflect.TypeOf((*MockGitRepository)(nil).ExecuteShellCommand), ctx, repo, cmd)
 }
 
+// FetchPruneTags mocks base method.
+func (m *MockGitRepository) FetchPruneTags(ctx context.Context, repo *entities.Repository, remote string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPruneTags", ctx, repo, remote)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPruneTags indicates an expected call of FetchPruneTags.
+func (mr *MockGitRepositoryMockRecorder) FetchPruneTags(ctx, repo, remote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPruneTags", reflect.TypeOf((*MockGitRepository)(nil).FetchPruneTags), ctx, repo, remote)
+}
+
 // GetAheadBehind mocks base method.
 func (m *MockGitRepository) GetAheadBehind(ctx context.Context, repo *entities.Repository) (int, int, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +147,37 @@ func (mr *MockGitRepositoryMockRecorder) GetAheadBehind(ctx, repo any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAheadBehind", reflect.TypeOf((*MockGitRepository)(nil).GetAheadBehind), ctx, repo)
 }
 
+// GetAheadBehindBranch mocks base method.
+func (m *MockGitRepository) GetAheadBehindBranch(ctx context.Context, repo *entities.Repository, branch string) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAheadBehindBranch", ctx, repo, branch)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAheadBehindBranch indicates an expected call of GetAheadBehindBranch.
+func (mr *MockGitRepositoryMockRecorder) GetAheadBehindBranch(ctx, repo, branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAheadBehindBranch", reflect.TypeOf((*MockGitRepository)(nil).GetAheadBehindBranch), ctx, repo, branch)
+}
+
+// GetAuthorCommitCounts mocks base method.
+func (m *MockGitRepository) GetAuthorCommitCounts(ctx context.Context, repo *entities.Repository, since string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorCommitCounts", ctx, repo, since)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorCommitCounts indicates an expected call of GetAuthorCommitCounts.
+func (mr *MockGitRepositoryMockRecorder) GetAuthorCommitCounts(ctx, repo, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorCommitCounts", reflect.TypeOf((*MockGitRepository)(nil).GetAuthorCommitCounts), ctx, repo, since)
+}
+
 // GetBranch mocks base method.
 func (m *MockGitRepository) GetBranch(ctx context.Context, repo *entities.Repository) (string, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +193,51 @@ func (mr *MockGitRepositoryMockRecorder) GetBranch(ctx, repo any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranch", reflect.TypeOf((*MockGitRepository)(nil).GetBranch), ctx, repo)
 }
 
+// GetDefaultBranch mocks base method.
+func (m *MockGitRepository) GetDefaultBranch(ctx context.Context, repo *entities.Repository) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultBranch", ctx, repo)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultBranch indicates an expected call of GetDefaultBranch.
+func (mr *MockGitRepositoryMockRecorder) GetDefaultBranch(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultBranch", reflect.TypeOf((*MockGitRepository)(nil).GetDefaultBranch), ctx, repo)
+}
+
+// GetDiffStat mocks base method.
+func (m *MockGitRepository) GetDiffStat(ctx context.Context, repo *entities.Repository, cached bool) (*DiffStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiffStat", ctx, repo, cached)
+	ret0, _ := ret[0].(*DiffStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiffStat indicates an expected call of GetDiffStat.
+func (mr *MockGitRepositoryMockRecorder) GetDiffStat(ctx, repo, cached any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiffStat", reflect.TypeOf((*MockGitRepository)(nil).GetDiffStat), ctx, repo, cached)
+}
+
+// GetDiskUsage mocks base method.
+func (m *MockGitRepository) GetDiskUsage(ctx context.Context, repo *entities.Repository, gitOnly bool) (*DiskUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiskUsage", ctx, repo, gitOnly)
+	ret0, _ := ret[0].(*DiskUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiskUsage indicates an expected call of GetDiskUsage.
+func (mr *MockGitRepositoryMockRecorder) GetDiskUsage(ctx, repo, gitOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskUsage", reflect.TypeOf((*MockGitRepository)(nil).GetDiskUsage), ctx, repo, gitOnly)
+}
+
 // GetFileChanges mocks base method.
 func (m *MockGitRepository) GetFileChanges(ctx context.Context, repo *entities.Repository) (int, int, int, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +255,21 @@ func (mr *MockGitRepositoryMockRecorder) GetFileChanges(ctx, repo any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileChanges", reflect.TypeOf((*MockGitRepository)(nil).GetFileChanges), ctx, repo)
 }
 
+// GetInProgressOperation mocks base method.
+func (m *MockGitRepository) GetInProgressOperation(ctx context.Context, repo *entities.Repository) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInProgressOperation", ctx, repo)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInProgressOperation indicates an expected call of GetInProgressOperation.
+func (mr *MockGitRepositoryMockRecorder) GetInProgressOperation(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInProgressOperation", reflect.TypeOf((*MockGitRepository)(nil).GetInProgressOperation), ctx, repo)
+}
+
 // GetLastCommit mocks base method.
 func (m *MockGitRepository) GetLastCommit(ctx context.Context, repo *entities.Repository) (*CommitInfo, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +285,51 @@ func (mr *MockGitRepositoryMockRecorder) GetLastCommit(ctx, repo any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastCommit", reflect.TypeOf((*MockGitRepository)(nil).GetLastCommit), ctx, repo)
 }
 
+// GetLockFile mocks base method.
+func (m *MockGitRepository) GetLockFile(ctx context.Context, repo *entities.Repository) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLockFile", ctx, repo)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLockFile indicates an expected call of GetLockFile.
+func (mr *MockGitRepositoryMockRecorder) GetLockFile(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLockFile", reflect.TypeOf((*MockGitRepository)(nil).GetLockFile), ctx, repo)
+}
+
+// GetMergedBranches mocks base method.
+func (m *MockGitRepository) GetMergedBranches(ctx context.Context, repo *entities.Repository, base string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMergedBranches", ctx, repo, base)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMergedBranches indicates an expected call of GetMergedBranches.
+func (mr *MockGitRepositoryMockRecorder) GetMergedBranches(ctx, repo, base any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedBranches", reflect.TypeOf((*MockGitRepository)(nil).GetMergedBranches), ctx, repo, base)
+}
+
+// GetRemoteURL mocks base method.
+func (m *MockGitRepository) GetRemoteURL(ctx context.Context, repo *entities.Repository, remote string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteURL", ctx, repo, remote)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteURL indicates an expected call of GetRemoteURL.
+func (mr *MockGitRepositoryMockRecorder) GetRemoteURL(ctx, repo, remote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteURL", reflect.TypeOf((*MockGitRepository)(nil).GetRemoteURL), ctx, repo, remote)
+}
+
 // GetRemotes mocks base method.
 func (m *MockGitRepository) GetRemotes(ctx context.Context, repo *entities.Repository) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +360,51 @@ func (mr *MockGitRepositoryMockRecorder) GetStatus(ctx, repo any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockGitRepository)(nil).GetStatus), ctx, repo)
 }
 
+// GetSubmodules mocks base method.
+func (m *MockGitRepository) GetSubmodules(ctx context.Context, repo *entities.Repository) ([]Submodule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmodules", ctx, repo)
+	ret0, _ := ret[0].([]Submodule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubmodules indicates an expected call of GetSubmodules.
+func (mr *MockGitRepositoryMockRecorder) GetSubmodules(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmodules", reflect.TypeOf((*MockGitRepository)(nil).GetSubmodules), ctx, repo)
+}
+
+// GetUntrackedFiles mocks base method.
+func (m *MockGitRepository) GetUntrackedFiles(ctx context.Context, repo *entities.Repository) ([]UntrackedFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUntrackedFiles", ctx, repo)
+	ret0, _ := ret[0].([]UntrackedFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUntrackedFiles indicates an expected call of GetUntrackedFiles.
+func (mr *MockGitRepositoryMockRecorder) GetUntrackedFiles(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUntrackedFiles", reflect.TypeOf((*MockGitRepository)(nil).GetUntrackedFiles), ctx, repo)
+}
+
+// HasTag mocks base method.
+func (m *MockGitRepository) HasTag(ctx context.Context, repo *entities.Repository, tag string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasTag", ctx, repo, tag)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasTag indicates an expected call of HasTag.
+func (mr *MockGitRepositoryMockRecorder) HasTag(ctx, repo, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasTag", reflect.TypeOf((*MockGitRepository)(nil).HasTag), ctx, repo, tag)
+}
+
 // HasUncommittedChanges mocks base method.
 func (m *MockGitRepository) HasUncommittedChanges(ctx context.Context, repo *entities.Repository) (bool, error) {
 	m.ctrl.T.Helper()
@@ -207,6 +448,35 @@ func (mr *MockGitRepositoryMockRecorder) IsValidRepository(ctx, path any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidRepository", reflect.TypeOf((*MockGitRepository)(nil).IsValidRepository), ctx, path)
 }
 
+// PruneRemote mocks base method.
+func (m *MockGitRepository) PruneRemote(ctx context.Context, repo *entities.Repository, remote string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneRemote", ctx, repo, remote)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneRemote indicates an expected call of PruneRemote.
+func (mr *MockGitRepositoryMockRecorder) PruneRemote(ctx, repo, remote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneRemote", reflect.TypeOf((*MockGitRepository)(nil).PruneRemote), ctx, repo, remote)
+}
+
+// SetRemoteURL mocks base method.
+func (m *MockGitRepository) SetRemoteURL(ctx context.Context, repo *entities.Repository, remote, url string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRemoteURL", ctx, repo, remote, url)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRemoteURL indicates an expected call of SetRemoteURL.
+func (mr *MockGitRepositoryMockRecorder) SetRemoteURL(ctx, repo, remote, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRemoteURL", reflect.TypeOf((*MockGitRepository)(nil).SetRemoteURL), ctx, repo, remote, url)
+}
+
 // MockExecutorRepository is a mock of ExecutorRepository interface.
 type MockExecutorRepository struct {
 	ctrl     *gomock.Controller
@@ -245,6 +515,35 @@ func (mr *MockExecutorRepositoryMockRecorder) Cancel(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockExecutorRepository)(nil).Cancel), ctx)
 }
 
+// Clock mocks base method.
+func (m *MockExecutorRepository) Clock() clock.Clock {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clock")
+	ret0, _ := ret[0].(clock.Clock)
+	return ret0
+}
+
+// Clock indicates an expected call of Clock.
+func (mr *MockExecutorRepositoryMockRecorder) Clock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clock", reflect.TypeOf((*MockExecutorRepository)(nil).Clock))
+}
+
+// ExecuteInOrder mocks base method.
+func (m *MockExecutorRepository) ExecuteInOrder(ctx context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteInOrder", ctx, repos, cmd)
+	ret0, _ := ret[0].(*entities.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteInOrder indicates an expected call of ExecuteInOrder.
+func (mr *MockExecutorRepositoryMockRecorder) ExecuteInOrder(ctx, repos, cmd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteInOrder", reflect.TypeOf((*MockExecutorRepository)(nil).ExecuteInOrder), ctx, repos, cmd)
+}
+
 // ExecuteInParallel mocks base method.
 func (m *MockExecutorRepository) ExecuteInParallel(ctx context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
 	m.ctrl.T.Helper()
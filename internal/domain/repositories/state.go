@@ -0,0 +1,18 @@
+//go:generate go run go.uber.org/mock/mockgen -package=repositories -destination=state_mocks.go github.com/qskkk/git-fleet/v2/internal/domain/repositories StateRepository
+package repositories
+
+import (
+	"context"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+// StateRepository defines the interface for the state gf keeps between runs
+type StateRepository interface {
+	// LastOperations returns the last operation run in each repository, by repository name
+	LastOperations(ctx context.Context) (map[string]*entities.LastOperation, error)
+
+	// RecordOperations stores the last operation of the given repositories, keeping those
+	// of the other repositories
+	RecordOperations(ctx context.Context, operations map[string]*entities.LastOperation) error
+}
@@ -3,8 +3,10 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/pkg/logger"
 )
 
@@ -94,6 +96,19 @@ type ConfigService interface {
 	// DiscoverRepositories discovers repositories in the configured paths
 	DiscoverRepositories(ctx context.Context) ([]*entities.Repository, error)
 
+	// DiscoverNewRepositories adds the repositories found under the roots, at most maxDepth directories deep,
+	// that are not configured yet
+	DiscoverNewRepositories(ctx context.Context, roots []string, maxDepth int) ([]*entities.Repository, error)
+
+	// GetAutoDiscover gets the automatic discovery settings, nil when they are not configured
+	GetAutoDiscover(ctx context.Context) *repositories.AutoDiscover
+
+	// LastDiscoveryTime returns when the automatic discovery last ran, the zero time if it never did
+	LastDiscoveryTime(ctx context.Context) time.Time
+
+	// RecordDiscovery records that the automatic discovery ran at the given time
+	RecordDiscovery(ctx context.Context, at time.Time) error
+
 	// GetConfigPath returns the path to the configuration file
 	GetConfigPath() string
 
@@ -102,6 +117,24 @@ type ConfigService interface {
 
 	// GetTheme gets the current UI theme
 	GetTheme(ctx context.Context) string
+
+	// GetThemeSchedule gets the hours of the time theme, nil when they are not configured
+	GetThemeSchedule(ctx context.Context) *repositories.ThemeSchedule
+
+	// GetBorderStyle gets the table border style
+	GetBorderStyle(ctx context.Context) string
+
+	// GetSummaryMetrics gets the metrics shown in execution summaries, empty for the default ones
+	GetSummaryMetrics(ctx context.Context) []string
+
+	// GetWorktreePath gets the path template of the worktrees created by worktree-add, empty for the default one
+	GetWorktreePath(ctx context.Context) string
+
+	// GetPager reports whether the output of CLI commands goes through the pager by default
+	GetPager(ctx context.Context) bool
+
+	// ConfigChangedOnDisk reports whether the configuration file changed since it was loaded
+	ConfigChangedOnDisk(ctx context.Context) (bool, error)
 }
 
 // ValidationService defines the interface for validation operations
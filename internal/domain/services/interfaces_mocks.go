@@ -12,8 +12,10 @@ package services
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	repositories "github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	logger "github.com/qskkk/git-fleet/v2/internal/pkg/logger"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -294,6 +296,21 @@ func (mr *MockConfigServiceMockRecorder) AddRepository(ctx, name, path any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRepository", reflect.TypeOf((*MockConfigService)(nil).AddRepository), ctx, name, path)
 }
 
+// ConfigChangedOnDisk mocks base method.
+func (m *MockConfigService) ConfigChangedOnDisk(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigChangedOnDisk", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigChangedOnDisk indicates an expected call of ConfigChangedOnDisk.
+func (mr *MockConfigServiceMockRecorder) ConfigChangedOnDisk(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigChangedOnDisk", reflect.TypeOf((*MockConfigService)(nil).ConfigChangedOnDisk), ctx)
+}
+
 // CreateDefaultConfig mocks base method.
 func (m *MockConfigService) CreateDefaultConfig(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -308,6 +325,21 @@ func (mr *MockConfigServiceMockRecorder) CreateDefaultConfig(ctx any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDefaultConfig", reflect.TypeOf((*MockConfigService)(nil).CreateDefaultConfig), ctx)
 }
 
+// DiscoverNewRepositories mocks base method.
+func (m *MockConfigService) DiscoverNewRepositories(ctx context.Context, roots []string, maxDepth int) ([]*entities.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverNewRepositories", ctx, roots, maxDepth)
+	ret0, _ := ret[0].([]*entities.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverNewRepositories indicates an expected call of DiscoverNewRepositories.
+func (mr *MockConfigServiceMockRecorder) DiscoverNewRepositories(ctx, roots, maxDepth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverNewRepositories", reflect.TypeOf((*MockConfigService)(nil).DiscoverNewRepositories), ctx, roots, maxDepth)
+}
+
 // DiscoverRepositories mocks base method.
 func (m *MockConfigService) DiscoverRepositories(ctx context.Context) ([]*entities.Repository, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +385,34 @@ func (mr *MockConfigServiceMockRecorder) GetAllRepositories(ctx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRepositories", reflect.TypeOf((*MockConfigService)(nil).GetAllRepositories), ctx)
 }
 
+// GetAutoDiscover mocks base method.
+func (m *MockConfigService) GetAutoDiscover(ctx context.Context) *repositories.AutoDiscover {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoDiscover", ctx)
+	ret0, _ := ret[0].(*repositories.AutoDiscover)
+	return ret0
+}
+
+// GetAutoDiscover indicates an expected call of GetAutoDiscover.
+func (mr *MockConfigServiceMockRecorder) GetAutoDiscover(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoDiscover", reflect.TypeOf((*MockConfigService)(nil).GetAutoDiscover), ctx)
+}
+
+// GetBorderStyle mocks base method.
+func (m *MockConfigService) GetBorderStyle(ctx context.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBorderStyle", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetBorderStyle indicates an expected call of GetBorderStyle.
+func (mr *MockConfigServiceMockRecorder) GetBorderStyle(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBorderStyle", reflect.TypeOf((*MockConfigService)(nil).GetBorderStyle), ctx)
+}
+
 // GetConfigPath mocks base method.
 func (m *MockConfigService) GetConfigPath() string {
 	m.ctrl.T.Helper()
@@ -382,6 +442,20 @@ func (mr *MockConfigServiceMockRecorder) GetGroup(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockConfigService)(nil).GetGroup), ctx, name)
 }
 
+// GetPager mocks base method.
+func (m *MockConfigService) GetPager(ctx context.Context) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPager", ctx)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetPager indicates an expected call of GetPager.
+func (mr *MockConfigServiceMockRecorder) GetPager(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPager", reflect.TypeOf((*MockConfigService)(nil).GetPager), ctx)
+}
+
 // GetRepositoriesForGroups mocks base method.
 func (m *MockConfigService) GetRepositoriesForGroups(ctx context.Context, groupNames []string) ([]*entities.Repository, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +486,20 @@ func (mr *MockConfigServiceMockRecorder) GetRepository(ctx, name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockConfigService)(nil).GetRepository), ctx, name)
 }
 
+// GetSummaryMetrics mocks base method.
+func (m *MockConfigService) GetSummaryMetrics(ctx context.Context) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummaryMetrics", ctx)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetSummaryMetrics indicates an expected call of GetSummaryMetrics.
+func (mr *MockConfigServiceMockRecorder) GetSummaryMetrics(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummaryMetrics", reflect.TypeOf((*MockConfigService)(nil).GetSummaryMetrics), ctx)
+}
+
 // GetTheme mocks base method.
 func (m *MockConfigService) GetTheme(ctx context.Context) string {
 	m.ctrl.T.Helper()
@@ -426,6 +514,48 @@ func (mr *MockConfigServiceMockRecorder) GetTheme(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTheme", reflect.TypeOf((*MockConfigService)(nil).GetTheme), ctx)
 }
 
+// GetThemeSchedule mocks base method.
+func (m *MockConfigService) GetThemeSchedule(ctx context.Context) *repositories.ThemeSchedule {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThemeSchedule", ctx)
+	ret0, _ := ret[0].(*repositories.ThemeSchedule)
+	return ret0
+}
+
+// GetThemeSchedule indicates an expected call of GetThemeSchedule.
+func (mr *MockConfigServiceMockRecorder) GetThemeSchedule(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThemeSchedule", reflect.TypeOf((*MockConfigService)(nil).GetThemeSchedule), ctx)
+}
+
+// GetWorktreePath mocks base method.
+func (m *MockConfigService) GetWorktreePath(ctx context.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorktreePath", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetWorktreePath indicates an expected call of GetWorktreePath.
+func (mr *MockConfigServiceMockRecorder) GetWorktreePath(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorktreePath", reflect.TypeOf((*MockConfigService)(nil).GetWorktreePath), ctx)
+}
+
+// LastDiscoveryTime mocks base method.
+func (m *MockConfigService) LastDiscoveryTime(ctx context.Context) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastDiscoveryTime", ctx)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// LastDiscoveryTime indicates an expected call of LastDiscoveryTime.
+func (mr *MockConfigServiceMockRecorder) LastDiscoveryTime(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastDiscoveryTime", reflect.TypeOf((*MockConfigService)(nil).LastDiscoveryTime), ctx)
+}
+
 // LoadConfig mocks base method.
 func (m *MockConfigService) LoadConfig(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -440,6 +570,20 @@ func (mr *MockConfigServiceMockRecorder) LoadConfig(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadConfig", reflect.TypeOf((*MockConfigService)(nil).LoadConfig), ctx)
 }
 
+// RecordDiscovery mocks base method.
+func (m *MockConfigService) RecordDiscovery(ctx context.Context, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDiscovery", ctx, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordDiscovery indicates an expected call of RecordDiscovery.
+func (mr *MockConfigServiceMockRecorder) RecordDiscovery(ctx, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDiscovery", reflect.TypeOf((*MockConfigService)(nil).RecordDiscovery), ctx, at)
+}
+
 // RemoveGroup mocks base method.
 func (m *MockConfigService) RemoveGroup(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
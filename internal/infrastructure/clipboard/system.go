@@ -0,0 +1,65 @@
+package clipboard
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// SystemClipboard copies text with the clipboard tool of the platform
+type SystemClipboard struct {
+	goos     string
+	lookPath func(file string) (string, error)
+	run      func(ctx context.Context, stdin io.Reader, name string, args ...string) error
+}
+
+// NewSystemClipboard creates a clipboard for the current platform
+func NewSystemClipboard() output.ClipboardPort {
+	return &SystemClipboard{
+		goos:     runtime.GOOS,
+		lookPath: exec.LookPath,
+		run: func(ctx context.Context, stdin io.Reader, name string, args ...string) error {
+			cmd := exec.CommandContext(ctx, name, args...)
+			cmd.Stdin = stdin
+			return cmd.Run()
+		},
+	}
+}
+
+// Copy pipes the text to the first clipboard tool installed, returning
+// ErrClipboardUnavailable when there is none
+func (c *SystemClipboard) Copy(ctx context.Context, text string) error {
+	for _, command := range clipboardCommands(c.goos) {
+		if _, err := c.lookPath(command[0]); err != nil {
+			continue
+		}
+		return c.run(ctx, strings.NewReader(text), command[0], command[1:]...)
+	}
+
+	return errors.ErrClipboardUnavailable
+}
+
+// clipboardCommands returns the commands reading the clipboard content from their input
+// on the given platform, in order of preference: pbcopy on macOS, clip on Windows and
+// wl-copy, xclip or xsel elsewhere
+func clipboardCommands(goos string) [][]string {
+	switch goos {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		return [][]string{{"clip"}}
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return [][]string{
+			{"wl-copy"},
+			{"xclip", "-selection", "clipboard"},
+			{"xsel", "--clipboard", "--input"},
+		}
+	default:
+		return nil
+	}
+}
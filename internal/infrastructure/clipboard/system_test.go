@@ -0,0 +1,75 @@
+package clipboard
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestClipboardCommands(t *testing.T) {
+	tests := []struct {
+		goos     string
+		expected string
+	}{
+		{goos: "darwin", expected: "pbcopy"},
+		{goos: "linux", expected: "wl-copy"},
+		{goos: "windows", expected: "clip"},
+		{goos: "plan9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goos, func(t *testing.T) {
+			commands := clipboardCommands(tt.goos)
+			first := ""
+			if len(commands) > 0 {
+				first = commands[0][0]
+			}
+			if first != tt.expected {
+				t.Errorf("clipboardCommands(%q) starts with %q, want %q", tt.goos, first, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSystemClipboard_Copy(t *testing.T) {
+	var ran, copied string
+	clipboard := &SystemClipboard{
+		goos: "linux",
+		lookPath: func(file string) (string, error) {
+			if file == "xclip" {
+				return "/usr/bin/xclip", nil
+			}
+			return "", exec.ErrNotFound
+		},
+		run: func(ctx context.Context, stdin io.Reader, name string, args ...string) error {
+			data, err := io.ReadAll(stdin)
+			ran, copied = name, string(data)
+			return err
+		},
+	}
+
+	if err := clipboard.Copy(context.Background(), "status table"); err != nil {
+		t.Fatalf("Copy() unexpected error: %v", err)
+	}
+	if ran != "xclip" || copied != "status table" {
+		t.Errorf("Copy() piped %q to %q, want the text piped to the first installed tool, xclip", copied, ran)
+	}
+}
+
+func TestSystemClipboard_CopyUnavailable(t *testing.T) {
+	clipboard := &SystemClipboard{
+		goos:     "linux",
+		lookPath: func(file string) (string, error) { return "", exec.ErrNotFound },
+		run: func(ctx context.Context, stdin io.Reader, name string, args ...string) error {
+			t.Error("Copy() should not run a missing clipboard tool")
+			return nil
+		},
+	}
+
+	if err := clipboard.Copy(context.Background(), "text"); !errors.IsError(err, errors.ErrClipboardUnavailable) {
+		t.Errorf("Copy() error = %v, want %v", err, errors.ErrClipboardUnavailable)
+	}
+}
@@ -0,0 +1,164 @@
+package config
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	gitfleetErrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// lastDiscoveryFile is the file next to the configuration holding the time of the last
+// automatic discovery, kept out of the configuration so that it is not rewritten and
+// backed up on every start
+const lastDiscoveryFile = ".gf-last-discovery"
+
+// GetAutoDiscover gets the automatic discovery settings, nil when they are not configured
+func (s *Service) GetAutoDiscover(ctx context.Context) *repositories.AutoDiscover {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.config == nil {
+		return nil
+	}
+	return s.config.AutoDiscover
+}
+
+// DiscoverNewRepositories adds the repositories found under the roots, at most maxDepth
+// directories deep, whose name and path are not configured yet. Groups are left untouched.
+func (s *Service) DiscoverNewRepositories(ctx context.Context, roots []string, maxDepth int) ([]*entities.Repository, error) {
+	if !s.hasConfig() {
+		s.logger.Warn(ctx, "No configuration loaded, cannot discover repositories")
+		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
+	}
+
+	var discovered []*entities.Repository
+	seen := make(map[string]bool)
+	for _, root := range roots {
+		for _, repo := range s.scanRootForRepositories(ctx, expandHome(root), maxDepth) {
+			if seen[repo.Name] || s.isConfigured(repo.Name) || s.isPathConfigured(repo.Path) {
+				s.logger.Debug(ctx, "Repository already known, skipping", "name", repo.Name, "path", repo.Path)
+				continue
+			}
+			seen[repo.Name] = true
+			discovered = append(discovered, repo)
+		}
+	}
+
+	if len(discovered) == 0 {
+		return discovered, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.config == nil {
+		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
+	}
+	for _, repo := range discovered {
+		s.config.AddRepository(repo.Name, repo.Path)
+	}
+
+	return discovered, nil
+}
+
+// scanRootForRepositories returns the Git repositories under the root, without descending
+// into repositories, hidden directories or directories deeper than maxDepth
+func (s *Service) scanRootForRepositories(ctx context.Context, root string, maxDepth int) []*entities.Repository {
+	var found []*entities.Repository
+	root = filepath.Clean(root)
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			s.logger.Warn(ctx, "Error accessing path during discovery", "path", path, "error", err)
+			if entry != nil && entry.IsDir() && path != root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+
+		name := entry.Name()
+		if path != root && (strings.HasPrefix(name, ".") || name == "node_modules" || name == "vendor" || name == "target") {
+			return filepath.SkipDir
+		}
+
+		if _, err := os.Stat(filepath.Join(path, ".git")); err == nil {
+			found = append(found, &entities.Repository{Name: filepath.Base(path), Path: path})
+			return filepath.SkipDir
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err == nil && relPath != "." && len(strings.Split(relPath, string(filepath.Separator))) >= maxDepth {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to scan discovery root", "path", root, "error", err)
+	}
+
+	return found
+}
+
+// isPathConfigured reports whether a repository with this path is already in the loaded configuration
+func (s *Service) isPathConfigured(path string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.config == nil {
+		return false
+	}
+	for _, repo := range s.config.Repositories {
+		if repo != nil && filepath.Clean(expandHome(repo.Path)) == filepath.Clean(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// LastDiscoveryTime returns when the automatic discovery last ran, the zero time if it never did
+func (s *Service) LastDiscoveryTime(ctx context.Context) time.Time {
+	info, err := os.Stat(s.lastDiscoveryPath())
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// RecordDiscovery records that the automatic discovery ran at the given time
+func (s *Service) RecordDiscovery(ctx context.Context, at time.Time) error {
+	path := s.lastDiscoveryPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return gitfleetErrors.WrapRepositoryOperationError(gitfleetErrors.ErrFailedToCreateConfigDir, err)
+	}
+	if err := os.WriteFile(path, []byte(at.Format(time.RFC3339)+"\n"), 0644); err != nil {
+		return gitfleetErrors.WrapRepositoryOperationError(gitfleetErrors.ErrFailedToWriteConfig, err)
+	}
+	return os.Chtimes(path, at, at)
+}
+
+// lastDiscoveryPath returns the path of the file holding the time of the last discovery
+func (s *Service) lastDiscoveryPath() string {
+	return filepath.Join(filepath.Dir(s.repo.GetPath()), lastDiscoveryFile)
+}
+
+// expandHome replaces a leading ~ with the home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
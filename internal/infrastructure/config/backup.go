@@ -0,0 +1,171 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+const (
+	// backupDirName is the directory, next to the configuration file, holding the backups
+	backupDirName = "backups"
+
+	// backupExtension is the file extension of configuration backups
+	backupExtension = ".bak"
+
+	// backupTimeFormat sorts lexically in chronological order
+	backupTimeFormat = "20060102-150405.000000"
+
+	// maxConfigBackups is the number of backups kept when a new one is written
+	maxConfigBackups = 10
+)
+
+// Backup copies the current configuration file to the backups directory and returns the backup path
+func (r *Repository) Backup(ctx context.Context) (string, error) {
+	if !r.Exists(ctx) {
+		return "", errors.WrapConfigFileNotExists(r.configPath)
+	}
+
+	data, err := os.ReadFile(r.configPath)
+	if err != nil {
+		return "", errors.WrapRepositoryOperationError(errors.ErrFailedToBackupConfig, err)
+	}
+
+	path, err := r.writeBackup(data)
+	if err != nil {
+		return "", errors.WrapRepositoryOperationError(errors.ErrFailedToBackupConfig, err)
+	}
+
+	return path, nil
+}
+
+// ListBackups returns the names of the available backups, newest first
+func (r *Repository) ListBackups(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(r.backupDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToListBackups, err)
+	}
+
+	prefix := filepath.Base(r.configPath) + "."
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, backupExtension) {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	return names, nil
+}
+
+// RestoreBackup replaces the configuration file with the named backup.
+// The current file is backed up first so that a restore can itself be undone.
+func (r *Repository) RestoreBackup(ctx context.Context, name string) error {
+	backups, err := r.ListBackups(ctx)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, backup := range backups {
+		if backup == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.WrapBackupNotFound(name)
+	}
+
+	// Read the backup before rotating, which may remove it
+	data, err := os.ReadFile(filepath.Join(r.backupDir(), name))
+	if err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToRestoreConfig, err)
+	}
+
+	if err := r.backupBeforeWrite(data); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(r.configPath, data, 0644); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToRestoreConfig, err)
+	}
+
+	return nil
+}
+
+// backupBeforeWrite backs up the current configuration file when it exists and differs from data
+func (r *Repository) backupBeforeWrite(data []byte) error {
+	current, err := os.ReadFile(r.configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToBackupConfig, err)
+	}
+
+	if bytes.Equal(current, data) {
+		return nil
+	}
+
+	if _, err := r.writeBackup(current); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToBackupConfig, err)
+	}
+
+	return nil
+}
+
+// writeBackup writes data as a new timestamped backup and removes the oldest backups beyond the limit
+func (r *Repository) writeBackup(data []byte) (string, error) {
+	if err := os.MkdirAll(r.backupDir(), 0755); err != nil {
+		return "", err
+	}
+
+	name := filepath.Base(r.configPath) + "." + time.Now().Format(backupTimeFormat) + backupExtension
+	path := filepath.Join(r.backupDir(), name)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+
+	if err := r.pruneBackups(); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// pruneBackups removes the oldest backups so that at most maxConfigBackups remain
+func (r *Repository) pruneBackups() error {
+	backups, err := r.ListBackups(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if len(backups) <= maxConfigBackups {
+		return nil
+	}
+
+	for _, name := range backups[maxConfigBackups:] {
+		if err := os.Remove(filepath.Join(r.backupDir(), name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backupDir returns the directory holding the configuration backups
+func (r *Repository) backupDir() string {
+	return filepath.Join(filepath.Dir(r.configPath), backupDirName)
+}
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestRepository_SaveBacksUpPreviousFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo := &Repository{configPath: filepath.Join(tmpDir, ".gfconfig.json")}
+	ctx := context.Background()
+
+	config := &repositories.Config{
+		Repositories: map[string]*repositories.RepositoryConfig{},
+		Groups:       map[string]*entities.Group{},
+		Theme:        "dark",
+	}
+
+	if err := repo.Save(ctx, config); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	backups, err := repo.ListBackups(ctx)
+	if err != nil {
+		t.Fatalf("ListBackups() failed: %v", err)
+	}
+	if len(backups) != 0 {
+		t.Errorf("Expected no backup of a new file, got %v", backups)
+	}
+
+	previous, err := os.ReadFile(repo.configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+
+	// Saving the same content does not create a backup
+	if err := repo.Save(ctx, config); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if backups, _ = repo.ListBackups(ctx); len(backups) != 0 {
+		t.Errorf("Expected no backup of an unchanged file, got %v", backups)
+	}
+
+	config.Theme = "light"
+	if err := repo.Save(ctx, config); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	backups, err = repo.ListBackups(ctx)
+	if err != nil {
+		t.Fatalf("ListBackups() failed: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("Expected 1 backup, got %v", backups)
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, backupDirName, backups[0]))
+	if err != nil {
+		t.Fatalf("Failed to read backup: %v", err)
+	}
+	if string(data) != string(previous) {
+		t.Errorf("Backup should hold the previous file, got %s", data)
+	}
+}
+
+func TestRepository_BackupRotation(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo := &Repository{configPath: filepath.Join(tmpDir, ".gfconfig.json")}
+	ctx := context.Background()
+
+	// Seed older backups than the ones written below
+	backupDir := filepath.Join(tmpDir, backupDirName)
+	if err := os.MkdirAll(backupDir, 0755); err != nil {
+		t.Fatalf("Failed to create backup dir: %v", err)
+	}
+	for i := 0; i < maxConfigBackups; i++ {
+		name := fmt.Sprintf(".gfconfig.json.20000101-0000%02d.000000.bak", i)
+		if err := os.WriteFile(filepath.Join(backupDir, name), []byte("{}"), 0644); err != nil {
+			t.Fatalf("Failed to seed backup: %v", err)
+		}
+	}
+	// Files that are not backups of this configuration are left alone
+	if err := os.WriteFile(filepath.Join(backupDir, "notes.txt"), []byte("keep"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	if err := os.WriteFile(repo.configPath, []byte(`{"version": 1}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	path, err := repo.Backup(ctx)
+	if err != nil {
+		t.Fatalf("Backup() failed: %v", err)
+	}
+	if filepath.Dir(path) != backupDir {
+		t.Errorf("Expected backup in %s, got %s", backupDir, path)
+	}
+
+	backups, err := repo.ListBackups(ctx)
+	if err != nil {
+		t.Fatalf("ListBackups() failed: %v", err)
+	}
+	if len(backups) != maxConfigBackups {
+		t.Fatalf("Expected %d backups, got %d", maxConfigBackups, len(backups))
+	}
+	if backups[0] != filepath.Base(path) {
+		t.Errorf("Expected newest backup %s first, got %s", filepath.Base(path), backups[0])
+	}
+	for _, name := range backups {
+		if strings.Contains(name, "20000101-000000") {
+			t.Errorf("Oldest backup should have been removed, found %s", name)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(backupDir, "notes.txt")); err != nil {
+		t.Errorf("Unrelated file should be kept: %v", err)
+	}
+}
+
+func TestRepository_RestoreBackup(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo := &Repository{configPath: filepath.Join(tmpDir, ".gfconfig.json")}
+	ctx := context.Background()
+
+	if err := os.WriteFile(repo.configPath, []byte(`{"theme": "dark"}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	path, err := repo.Backup(ctx)
+	if err != nil {
+		t.Fatalf("Backup() failed: %v", err)
+	}
+	if err := os.WriteFile(repo.configPath, []byte(`{"theme": "light"}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	if err := repo.RestoreBackup(ctx, filepath.Base(path)); err != nil {
+		t.Fatalf("RestoreBackup() failed: %v", err)
+	}
+
+	data, err := os.ReadFile(repo.configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	if string(data) != `{"theme": "dark"}` {
+		t.Errorf("Expected restored content, got %s", data)
+	}
+
+	// The overwritten file is kept so that the restore can be undone
+	backups, err := repo.ListBackups(ctx)
+	if err != nil {
+		t.Fatalf("ListBackups() failed: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("Expected 2 backups, got %v", backups)
+	}
+	undo, err := os.ReadFile(filepath.Join(tmpDir, backupDirName, backups[0]))
+	if err != nil {
+		t.Fatalf("Failed to read backup: %v", err)
+	}
+	if string(undo) != `{"theme": "light"}` {
+		t.Errorf("Expected the replaced file to be backed up, got %s", undo)
+	}
+}
+
+func TestRepository_BackupErrors(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo := &Repository{configPath: filepath.Join(tmpDir, ".gfconfig.json")}
+	ctx := context.Background()
+
+	if _, err := repo.Backup(ctx); !errors.IsError(err, errors.ErrConfigFileNotExists) {
+		t.Errorf("Expected ErrConfigFileNotExists, got %v", err)
+	}
+
+	backups, err := repo.ListBackups(ctx)
+	if err != nil {
+		t.Fatalf("ListBackups() failed: %v", err)
+	}
+	if len(backups) != 0 {
+		t.Errorf("Expected no backups, got %v", backups)
+	}
+
+	if err := repo.RestoreBackup(ctx, "../.gfconfig.json"); !errors.IsError(err, errors.ErrBackupNotFound) {
+		t.Errorf("Expected ErrBackupNotFound, got %v", err)
+	}
+}
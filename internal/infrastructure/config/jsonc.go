@@ -0,0 +1,90 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// unmarshalJSONC parses a hand-edited configuration file, accepting // and /* */
+// comments and trailing commas. Parse errors report the line and column in data.
+func unmarshalJSONC(data []byte, v any) error {
+	err := json.Unmarshal(stripJSONC(data), v)
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *json.SyntaxError:
+		line, column := lineAndColumn(data, e.Offset)
+		return errors.WrapConfigParseError(line, column, err)
+	case *json.UnmarshalTypeError:
+		line, column := lineAndColumn(data, e.Offset)
+		return errors.WrapConfigParseError(line, column, err)
+	}
+	return errors.WrapRepositoryOperationError(errors.ErrFailedToParseConfig, err)
+}
+
+// stripJSONC turns JSONC into plain JSON by blanking out comments and trailing commas.
+// They are replaced with spaces, newlines kept, so that offsets still match data.
+func stripJSONC(data []byte) []byte {
+	out := bytes.Clone(data)
+	lastComma := -1
+
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case c == '"':
+			// Skip the string, escapes included
+			for i++; i < len(out) && out[i] != '"'; i++ {
+				if out[i] == '\\' {
+					i++
+				}
+			}
+			lastComma = -1
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			for ; i < len(out) && out[i] != '\n'; i++ {
+				out[i] = ' '
+			}
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := bytes.Index(out[i+2:], []byte("*/"))
+			if end < 0 {
+				// Leave an unterminated comment for the parser to report
+				return out
+			}
+			blank(out[i : i+2+end+2])
+			i += 2 + end + 1
+		case c == ',':
+			lastComma = i
+		case c == '}' || c == ']':
+			if lastComma >= 0 {
+				out[lastComma] = ' '
+			}
+			lastComma = -1
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		default:
+			lastComma = -1
+		}
+	}
+
+	return out
+}
+
+// blank replaces every byte but newlines with a space
+func blank(data []byte) {
+	for i, c := range data {
+		if c != '\n' {
+			data[i] = ' '
+		}
+	}
+}
+
+// lineAndColumn returns the 1-based line and column of the byte before offset, where
+// encoding/json reports errors
+func lineAndColumn(data []byte, offset int64) (int, int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	before := data[:max(offset-1, 0)]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := len(before) - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestStripJSONC(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"plain json", `{"a": [1, 2]}`, `{"a": [1, 2]}`},
+		{"line comment", "{\"a\": 1 // one\n}", "{\"a\": 1       \n}"},
+		{"block comment", "{/* a\nb */\"a\": 1}", "{    \n    \"a\": 1}"},
+		{"trailing commas", `{"a": [1, 2,], "b": 3,}`, `{"a": [1, 2 ], "b": 3 }`},
+		{"trailing comma before a comment", "[1, // last\n]", "[1         \n]"},
+		{"comment markers in strings", `{"url": "https://x/*y*/", "s": "a\"//,"}`, `{"url": "https://x/*y*/", "s": "a\"//,"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(stripJSONC([]byte(tt.input))); got != tt.expected {
+				t.Errorf("stripJSONC() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRepository_LoadJSONC(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	repo := &Repository{configPath: configPath}
+	ctx := context.Background()
+
+	content := fmt.Sprintf(`{
+  // Repositories checked out under ~/src
+  "repositories": {
+    "api": {"path": "/src/api"},
+    "web": {"path": "/src/web"}, /* frontend */
+  },
+  "groups": {
+    "all": {"repositories": ["api", "web",]},
+  },
+  "version": %d,
+}
+`, repositories.CurrentConfigVersion)
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := repo.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(config.Repositories) != 2 || len(config.Groups["all"].Repositories) != 2 {
+		t.Errorf("Load() = %+v, want both repositories in the all group", config)
+	}
+
+	if err := repo.Save(ctx, config); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	saved, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(saved), "//") || strings.Contains(string(saved), ",\n}") {
+		t.Errorf("Save() should write plain JSON, got:\n%s", saved)
+	}
+}
+
+func TestRepository_LoadParseErrorPosition(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	repo := &Repository{configPath: configPath}
+
+	content := "{\n  \"repositories\": {\n    \"api\": {\"path\": \"/src/api\"}\n    \"web\": {}\n  }\n}\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := repo.Load(context.Background())
+	if !errors.IsError(err, errors.ErrFailedToParseConfig) {
+		t.Fatalf("Load() error = %v, want %v", err, errors.ErrFailedToParseConfig)
+	}
+	if !strings.Contains(err.Error(), "line 4, column 5") {
+		t.Errorf("Load() error = %v, want the position of the missing comma", err)
+	}
+}
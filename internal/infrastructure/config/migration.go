@@ -0,0 +1,59 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// migrations upgrade a stored configuration by one schema version:
+// migrations[v] turns a version v configuration into a version v+1 one
+var migrations = []func(*storedConfig){
+	migrateV0ToV1,
+}
+
+// parseConfigVersion reads the stored schema version. Configurations without a
+// version, or with the free-form version label used before versioning, are version 0.
+func parseConfigVersion(raw json.RawMessage) (int, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) || raw[0] == '"' {
+		return 0, nil
+	}
+
+	var version int
+	if err := json.Unmarshal(raw, &version); err != nil || version < 0 {
+		return 0, errors.WrapInvalidConfigVersion(string(raw))
+	}
+
+	return version, nil
+}
+
+// migrateConfig upgrades the stored configuration to the current schema version and
+// reports whether it changed. Versions newer than this release are left untouched.
+func migrateConfig(stored *storedConfig) bool {
+	migrated := false
+	for stored.Version < repositories.CurrentConfigVersion && stored.Version < len(migrations) {
+		migrations[stored.Version](stored)
+		stored.Version++
+		migrated = true
+	}
+
+	return migrated
+}
+
+// migrateV0ToV1 backfills the defaults that unversioned configurations left implicit
+func migrateV0ToV1(stored *storedConfig) {
+	if stored.Repositories == nil {
+		stored.Repositories = make(map[string]*repositories.RepositoryConfig)
+	}
+
+	if stored.Groups == nil {
+		stored.Groups = make(map[string]rawGroup)
+	}
+
+	if stored.Theme == "" {
+		stored.Theme = defaultTheme
+	}
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseConfigVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected int
+		wantErr  bool
+	}{
+		{name: "missing version", raw: "", expected: 0},
+		{name: "null version", raw: "null", expected: 0},
+		{name: "legacy version label", raw: `"1.0.0"`, expected: 0},
+		{name: "current version", raw: "1", expected: 1},
+		{name: "future version", raw: "7", expected: 7},
+		{name: "negative version", raw: "-1", wantErr: true},
+		{name: "fractional version", raw: "1.5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := parseConfigVersion(json.RawMessage(tt.raw))
+			if tt.wantErr {
+				if !errors.IsError(err, errors.ErrInvalidConfigVersion) {
+					t.Errorf("parseConfigVersion() error = %v, want %v", err, errors.ErrInvalidConfigVersion)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseConfigVersion() unexpected error: %v", err)
+			}
+			if version != tt.expected {
+				t.Errorf("parseConfigVersion() = %d, want %d", version, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRepository_LoadMigratesUnversionedConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	content := `{"repositories": {"api": {"path": "/src/api"}}, "groups": {"backend": ["api"]}, "version": "1.0.0"}`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	repo := &Repository{configPath: configPath}
+	config, err := repo.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if config.Version != repositories.CurrentConfigVersion {
+		t.Errorf("Load() version = %d, want %d", config.Version, repositories.CurrentConfigVersion)
+	}
+	if config.Theme != "fleet" {
+		t.Errorf("Load() theme = %q, want %q", config.Theme, "fleet")
+	}
+	if group, ok := config.Groups["backend"]; !ok || len(group.Repositories) != 1 {
+		t.Errorf("Load() should keep the groups, got %v", config.Groups)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	if !strings.Contains(string(data), `"version": 1`) {
+		t.Errorf("Load() should rewrite the migrated config, got %s", data)
+	}
+}
+
+func TestRepository_LoadKeepsFutureVersion(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	content := `{"repositories": {}, "groups": {}, "version": 99, "future_setting": true}`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	repo := &Repository{configPath: configPath}
+	config, err := repo.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if config.Version != 99 {
+		t.Errorf("Load() version = %d, want 99", config.Version)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	if string(data) != content {
+		t.Errorf("Load() should not rewrite a config from a newer release, got %s", data)
+	}
+}
@@ -2,18 +2,29 @@ package config
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/themes"
 )
 
-// Repository implements the ConfigRepository interface
+// defaultTheme is the theme of configurations that do not set one
+const defaultTheme = themes.Fleet
+
+// Repository implements the ConfigRepository interface.
+// checksum is the checksum of the file as last loaded or saved, to detect edits made
+// to it in the meantime.
 type Repository struct {
 	configPath string
+	checksum   string
 }
 
 // NewRepository creates a new configuration repository
@@ -24,6 +35,69 @@ func NewRepository() repositories.ConfigRepository {
 	}
 }
 
+// NewRepositoryWithPath creates a configuration repository reading and writing the file
+// at the given path instead of the default location. Relative paths are resolved against
+// the working directory.
+func NewRepositoryWithPath(path string) repositories.ConfigRepository {
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+	return &Repository{
+		configPath: path,
+	}
+}
+
+// storedConfig is the stored form of the configuration
+type storedConfig struct {
+	Repositories   map[string]*repositories.RepositoryConfig `json:"repositories"`
+	Order          []string                                  `json:"order,omitempty"`
+	Groups         map[string]rawGroup                       `json:"groups"`
+	Theme          string                                    `json:"theme,omitempty"`
+	ThemeSchedule  *repositories.ThemeSchedule               `json:"theme_schedule,omitempty"`
+	BorderStyle    string                                    `json:"border_style,omitempty"`
+	SummaryMetrics []string                                  `json:"summary_metrics,omitempty"`
+	WorktreePath   string                                    `json:"worktree_path,omitempty"`
+	AutoDiscover   *repositories.AutoDiscover                `json:"auto_discover,omitempty"`
+	Pager          bool                                      `json:"pager,omitempty"`
+	Version        int                                       `json:"version"`
+}
+
+// rawGroup is the stored form of a group: a plain list of repositories, or an object
+// when the group has extra settings such as a default command or a composition
+type rawGroup struct {
+	Repositories   []string                   `json:"repositories,omitempty"`
+	DefaultCommand string                     `json:"default_command,omitempty"`
+	Composition    *entities.GroupComposition `json:"composition,omitempty"`
+}
+
+// UnmarshalJSON accepts both the list and the object form of a group
+func (g *rawGroup) UnmarshalJSON(data []byte) error {
+	var repoNames []string
+	if err := json.Unmarshal(data, &repoNames); err == nil {
+		g.Repositories = repoNames
+		return nil
+	}
+
+	type plainGroup rawGroup
+	var group plainGroup
+	if err := json.Unmarshal(data, &group); err != nil {
+		return err
+	}
+
+	*g = rawGroup(group)
+	return nil
+}
+
+// MarshalJSON keeps the list form for groups without extra settings
+func (g rawGroup) MarshalJSON() ([]byte, error) {
+	if g.DefaultCommand == "" && g.Composition == nil {
+		return json.Marshal(g.Repositories)
+	}
+
+	type plainGroup rawGroup
+	return json.Marshal(plainGroup(g))
+}
+
 // Load loads the configuration from storage
 func (r *Repository) Load(ctx context.Context) (*repositories.Config, error) {
 	if !r.Exists(ctx) {
@@ -34,34 +108,59 @@ func (r *Repository) Load(ctx context.Context) (*repositories.Config, error) {
 	if err != nil {
 		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToReadConfig, err)
 	}
+	r.checksum = checksum(data)
 
+	// The version is read on its own first: v0 files may carry a free-form version label
 	var rawConfig struct {
-		Repositories map[string]*repositories.RepositoryConfig `json:"repositories"`
-		Groups       map[string][]string                       `json:"groups"`
-		Theme        string                                    `json:"theme,omitempty"`
-		Version      string                                    `json:"version,omitempty"`
+		storedConfig
+		Version json.RawMessage `json:"version"`
 	}
 
-	if err := json.Unmarshal(data, &rawConfig); err != nil {
-		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToParseConfig, err)
+	// Hand-edited files may carry comments and trailing commas
+	if err := unmarshalJSONC(data, &rawConfig); err != nil {
+		return nil, err
 	}
 
-	if rawConfig.Theme == "" {
-		rawConfig.Theme = "fleet" // TODO use theme package constants
+	stored := rawConfig.storedConfig
+	stored.Version, err = parseConfigVersion(rawConfig.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	migrated := migrateConfig(&stored)
+
+	if stored.Theme == "" {
+		stored.Theme = defaultTheme
 	}
 
 	// Convert to domain entities
 	config := &repositories.Config{
-		Repositories: rawConfig.Repositories,
-		Groups:       make(map[string]*entities.Group),
-		Theme:        rawConfig.Theme,
-		Version:      rawConfig.Version,
+		Repositories:   stored.Repositories,
+		Order:          stored.Order,
+		Groups:         make(map[string]*entities.Group),
+		Theme:          stored.Theme,
+		ThemeSchedule:  stored.ThemeSchedule,
+		BorderStyle:    stored.BorderStyle,
+		SummaryMetrics: stored.SummaryMetrics,
+		WorktreePath:   stored.WorktreePath,
+		AutoDiscover:   stored.AutoDiscover,
+		Pager:          stored.Pager,
+		Version:        stored.Version,
 	}
 
 	// Convert groups
-	for name, repoNames := range rawConfig.Groups {
-		group := entities.NewGroup(name, repoNames)
-		config.Groups[name] = group
+	for name, group := range stored.Groups {
+		entity := entities.NewGroup(name, group.Repositories)
+		entity.DefaultCommand = group.DefaultCommand
+		entity.Composition = group.Composition
+		config.Groups[name] = entity
+	}
+
+	// Rewrite the file so that it is only migrated once
+	if migrated {
+		if err := r.Save(ctx, config); err != nil {
+			return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToMigrateConfig, err)
+		}
 	}
 
 	return config, nil
@@ -76,21 +175,32 @@ func (r *Repository) Save(ctx context.Context, config *repositories.Config) erro
 	}
 
 	// Convert to JSON structure
-	rawConfig := struct {
-		Repositories map[string]*repositories.RepositoryConfig `json:"repositories"`
-		Groups       map[string][]string                       `json:"groups"`
-		Theme        string                                    `json:"theme,omitempty"`
-		Version      string                                    `json:"version,omitempty"`
-	}{
-		Repositories: config.Repositories,
-		Groups:       make(map[string][]string),
-		Theme:        config.Theme,
-		Version:      config.Version,
+	rawConfig := storedConfig{
+		Repositories:   config.Repositories,
+		Order:          config.Order,
+		Groups:         make(map[string]rawGroup),
+		Theme:          config.Theme,
+		ThemeSchedule:  config.ThemeSchedule,
+		BorderStyle:    config.BorderStyle,
+		SummaryMetrics: config.SummaryMetrics,
+		WorktreePath:   config.WorktreePath,
+		AutoDiscover:   config.AutoDiscover,
+		Pager:          config.Pager,
+		Version:        config.Version,
+	}
+
+	// Configurations built in memory are written in the current schema
+	if rawConfig.Version == 0 {
+		rawConfig.Version = repositories.CurrentConfigVersion
 	}
 
 	// Convert groups
 	for name, group := range config.Groups {
-		rawConfig.Groups[name] = group.Repositories
+		rawConfig.Groups[name] = rawGroup{
+			Repositories:   group.Repositories,
+			DefaultCommand: group.DefaultCommand,
+			Composition:    group.Composition,
+		}
 	}
 
 	// Marshal to JSON with proper indentation
@@ -99,14 +209,44 @@ func (r *Repository) Save(ctx context.Context, config *repositories.Config) erro
 		return errors.WrapRepositoryOperationError(errors.ErrFailedToMarshalConfig, err)
 	}
 
+	// Keep a copy of the previous file
+	if err := r.backupBeforeWrite(data); err != nil {
+		return err
+	}
+
 	// Write to file
 	if err := os.WriteFile(r.configPath, data, 0644); err != nil {
 		return errors.WrapRepositoryOperationError(errors.ErrFailedToWriteConfig, err)
 	}
+	r.checksum = checksum(data)
 
 	return nil
 }
 
+// ChangedOnDisk reports whether the configuration file changed since it was last loaded
+// or saved. A file never loaded or since removed has no changes to keep.
+func (r *Repository) ChangedOnDisk(ctx context.Context) (bool, error) {
+	if r.checksum == "" {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(r.configPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WrapRepositoryOperationError(errors.ErrFailedToReadConfig, err)
+	}
+
+	return checksum(data) != r.checksum, nil
+}
+
+// checksum returns the SHA-256 checksum of the data
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 // Exists checks if a configuration file exists
 func (r *Repository) Exists(ctx context.Context) bool {
 	_, err := os.Stat(r.configPath)
@@ -129,6 +269,7 @@ func (r *Repository) CreateDefault(ctx context.Context) error {
 		Groups: map[string]*entities.Group{
 			"all": entities.NewGroup("all", []string{"example-repo"}),
 		},
+		Version: repositories.CurrentConfigVersion,
 	}
 
 	return r.Save(ctx, defaultConfig)
@@ -148,6 +289,10 @@ func (r *Repository) Validate(ctx context.Context, config *repositories.Config)
 		return errors.ErrGroupsCannotBeNil
 	}
 
+	if config.BorderStyle != "" && !themes.IsValidBorderStyle(config.BorderStyle) {
+		return errors.WrapInvalidBorderStyle(config.BorderStyle, themes.BorderStyleNames)
+	}
+
 	// Validate groups reference existing repositories
 	for groupName, group := range config.Groups {
 		for _, repoName := range group.Repositories {
@@ -157,5 +302,62 @@ func (r *Repository) Validate(ctx context.Context, config *repositories.Config)
 		}
 	}
 
+	// Validate compositions reference existing groups and are not composed of themselves
+	for groupName, group := range config.Groups {
+		if !group.IsComposed() {
+			continue
+		}
+		if err := group.Composition.Validate(); err != nil {
+			return errors.WrapInvalidGroupComposition(groupName, err)
+		}
+		for _, composedGroup := range group.Composition.Groups {
+			if _, exists := config.Groups[composedGroup]; !exists {
+				return errors.WrapComposesNonExistentGroup(groupName, composedGroup)
+			}
+		}
+		if _, err := config.GroupRepositoryNames(groupName); err != nil {
+			return err
+		}
+	}
+
+	// Validate dependencies reference existing repositories and timeouts are durations
+	for repoName, repo := range config.Repositories {
+		if repo == nil {
+			continue
+		}
+		for _, dependency := range repo.DependsOn {
+			if _, exists := config.Repositories[dependency]; !exists {
+				return errors.WrapDependsOnNonExistentRepo(repoName, dependency)
+			}
+		}
+		if repo.Timeout != "" {
+			if timeout, err := time.ParseDuration(repo.Timeout); err != nil || timeout <= 0 {
+				return errors.WrapInvalidRepositoryTimeout(repoName, repo.Timeout)
+			}
+		}
+	}
+
+	return validateAutoDiscover(config.AutoDiscover)
+}
+
+// validateAutoDiscover checks that the automatic discovery runs at a known time, from
+// at least one root and with a cooldown that is a duration
+func validateAutoDiscover(autoDiscover *repositories.AutoDiscover) error {
+	if autoDiscover == nil {
+		return nil
+	}
+
+	if autoDiscover.When != "" && autoDiscover.When != repositories.AutoDiscoverOnStartup {
+		return errors.WrapInvalidAutoDiscover(fmt.Sprintf("when is '%s', expected '%s'", autoDiscover.When, repositories.AutoDiscoverOnStartup))
+	}
+	if autoDiscover.When != "" && len(autoDiscover.Roots) == 0 {
+		return errors.WrapInvalidAutoDiscover("no roots to discover repositories in")
+	}
+	if autoDiscover.Cooldown != "" {
+		if cooldown, err := time.ParseDuration(autoDiscover.Cooldown); err != nil || cooldown < 0 {
+			return errors.WrapInvalidAutoDiscover(fmt.Sprintf("cooldown is '%s', expected a duration such as 1h", autoDiscover.Cooldown))
+		}
+	}
+
 	return nil
 }
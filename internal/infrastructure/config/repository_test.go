@@ -2,7 +2,9 @@ package config
 
 import (
 	"context"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
@@ -21,6 +23,22 @@ func TestNewRepository(t *testing.T) {
 	}
 }
 
+func TestNewRepositoryWithPath(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "work.json")
+
+	repo := NewRepositoryWithPath(path)
+	if got := repo.GetPath(); got != path {
+		t.Errorf("GetPath() = %s, want %s", got, path)
+	}
+
+	t.Chdir(tmpDir)
+	relative := NewRepositoryWithPath("personal.json")
+	if got := relative.GetPath(); got != filepath.Join(tmpDir, "personal.json") {
+		t.Errorf("GetPath() = %s, want the path resolved against the working directory", got)
+	}
+}
+
 func TestRepository_GetPath(t *testing.T) {
 	repo := &Repository{
 		configPath: "/test/path/config.json",
@@ -124,6 +142,126 @@ func TestRepository_Validate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "repository depends on non-existent repository",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{
+					"repo1": {Path: "/path/to/repo1", DependsOn: []string{"nonexistent"}},
+				},
+				Groups: map[string]*entities.Group{},
+			},
+			expectError: true,
+		},
+		{
+			name: "composed group",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{
+					"repo1": {Path: "/path/to/repo1"},
+				},
+				Groups: map[string]*entities.Group{
+					"group1": entities.NewGroup("group1", []string{"repo1"}),
+					"group2": entities.NewGroup("group2", []string{"repo1"}),
+					"both": {Name: "both", Composition: &entities.GroupComposition{
+						Operation: entities.CompositionIntersect, Groups: []string{"group1", "group2"},
+					}},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "composition of non-existent group",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{},
+				Groups: map[string]*entities.Group{
+					"group1": entities.NewGroup("group1", []string{}),
+					"both": {Name: "both", Composition: &entities.GroupComposition{
+						Operation: entities.CompositionUnion, Groups: []string{"group1", "missing"},
+					}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "composition cycle",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{},
+				Groups: map[string]*entities.Group{
+					"a": {Name: "a", Composition: &entities.GroupComposition{
+						Operation: entities.CompositionUnion, Groups: []string{"b", "c"},
+					}},
+					"b": {Name: "b", Composition: &entities.GroupComposition{
+						Operation: entities.CompositionUnion, Groups: []string{"a", "c"},
+					}},
+					"c": entities.NewGroup("c", []string{}),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "repository with timeout",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{
+					"repo1": {Path: "/path/to/repo1", Timeout: "10m"},
+				},
+				Groups: map[string]*entities.Group{},
+			},
+			expectError: false,
+		},
+		{
+			name: "repository with invalid timeout",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{
+					"repo1": {Path: "/path/to/repo1", Timeout: "ten minutes"},
+				},
+				Groups: map[string]*entities.Group{},
+			},
+			expectError: true,
+		},
+		{
+			name: "auto discovery on startup",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{},
+				Groups:       map[string]*entities.Group{},
+				AutoDiscover: &repositories.AutoDiscover{When: "on-startup", Roots: []string{"~/src"}, Cooldown: "30m"},
+			},
+			expectError: false,
+		},
+		{
+			name: "known border style",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{},
+				Groups:       map[string]*entities.Group{},
+				BorderStyle:  "rounded",
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown border style",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{},
+				Groups:       map[string]*entities.Group{},
+				BorderStyle:  "dotted",
+			},
+			expectError: true,
+		},
+		{
+			name: "auto discovery without roots",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{},
+				Groups:       map[string]*entities.Group{},
+				AutoDiscover: &repositories.AutoDiscover{When: "on-startup"},
+			},
+			expectError: true,
+		},
+		{
+			name: "auto discovery with invalid cooldown",
+			config: &repositories.Config{
+				Repositories: map[string]*repositories.RepositoryConfig{},
+				Groups:       map[string]*entities.Group{},
+				AutoDiscover: &repositories.AutoDiscover{When: "on-startup", Roots: []string{"~/src"}, Cooldown: "hourly"},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -160,8 +298,13 @@ func TestRepository_SaveAndLoad(t *testing.T) {
 		Groups: map[string]*entities.Group{
 			"group1": entities.NewGroup("group1", []string{"repo1", "repo2"}),
 		},
-		Theme:   "dark",
-		Version: "1.0.0",
+		Order:          []string{"repo2"},
+		Theme:          "time",
+		ThemeSchedule:  &repositories.ThemeSchedule{DayStart: 8, DayEnd: 19},
+		BorderStyle:    "none",
+		SummaryMetrics: []string{"total", "slowest"},
+		Pager:          true,
+		Version:        1,
 	}
 
 	// Test Save
@@ -194,12 +337,183 @@ func TestRepository_SaveAndLoad(t *testing.T) {
 		t.Errorf("Expected 1 group, got %d", len(loadedConfig.Groups))
 	}
 
-	if loadedConfig.Theme != "dark" {
-		t.Errorf("Expected theme 'dark', got %q", loadedConfig.Theme)
+	if len(loadedConfig.Order) != 1 || loadedConfig.Order[0] != "repo2" {
+		t.Errorf("Expected order [repo2], got %v", loadedConfig.Order)
+	}
+
+	if loadedConfig.Theme != "time" {
+		t.Errorf("Expected theme 'time', got %q", loadedConfig.Theme)
 	}
 
-	if loadedConfig.Version != "1.0.0" {
-		t.Errorf("Expected version '1.0.0', got %q", loadedConfig.Version)
+	if schedule := loadedConfig.ThemeSchedule; schedule == nil || schedule.DayStart != 8 || schedule.DayEnd != 19 {
+		t.Errorf("Expected theme schedule from 8 to 19, got %+v", schedule)
+	}
+
+	if loadedConfig.BorderStyle != "none" {
+		t.Errorf("Expected border style 'none', got %q", loadedConfig.BorderStyle)
+	}
+
+	if len(loadedConfig.SummaryMetrics) != 2 || loadedConfig.SummaryMetrics[1] != "slowest" {
+		t.Errorf("Expected summary metrics [total slowest], got %v", loadedConfig.SummaryMetrics)
+	}
+
+	if !loadedConfig.Pager {
+		t.Error("Expected the pager to be enabled")
+	}
+
+	if loadedConfig.Version != 1 {
+		t.Errorf("Expected version 1, got %d", loadedConfig.Version)
+	}
+}
+
+func TestRepository_ChangedOnDisk(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "test_config.json")
+	repo := &Repository{configPath: configPath}
+	ctx := context.Background()
+
+	if changed, err := repo.ChangedOnDisk(ctx); err != nil || changed {
+		t.Errorf("ChangedOnDisk() before loading = %v, %v, want false", changed, err)
+	}
+
+	if err := repo.CreateDefault(ctx); err != nil {
+		t.Fatalf("CreateDefault() failed: %v", err)
+	}
+	if _, err := repo.Load(ctx); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if changed, err := repo.ChangedOnDisk(ctx); err != nil || changed {
+		t.Errorf("ChangedOnDisk() after loading = %v, %v, want false", changed, err)
+	}
+
+	edited := `{"repositories": {"edited": {"path": "/path/to/edited"}}, "groups": {}, "version": 1}`
+	if err := os.WriteFile(configPath, []byte(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if changed, err := repo.ChangedOnDisk(ctx); err != nil || !changed {
+		t.Errorf("ChangedOnDisk() after an edit = %v, %v, want true", changed, err)
+	}
+
+	config, err := repo.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if err := repo.Save(ctx, config); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if changed, err := repo.ChangedOnDisk(ctx); err != nil || changed {
+		t.Errorf("ChangedOnDisk() after saving = %v, %v, want false", changed, err)
+	}
+}
+
+func TestRepository_GroupDefaultCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test_config.json")
+
+	repo := &Repository{
+		configPath: configPath,
+	}
+
+	ctx := context.Background()
+
+	// Groups can be stored either as a list or as an object with settings
+	data := `{
+  "repositories": {
+    "repo1": {"path": "/path/to/repo1"},
+    "repo2": {"path": "/path/to/repo2"}
+  },
+  "groups": {
+    "all": ["repo1", "repo2"],
+    "docs": {"repositories": ["repo2"], "default_command": "pull"}
+  }
+}`
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	config, err := repo.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	if config.Groups["all"].HasDefaultCommand() {
+		t.Error("Group 'all' should not have a default command")
+	}
+	if len(config.Groups["all"].Repositories) != 2 {
+		t.Errorf("Expected 2 repositories in 'all', got %d", len(config.Groups["all"].Repositories))
+	}
+	if config.Groups["docs"].DefaultCommand != "pull" {
+		t.Errorf("Expected default command 'pull', got %q", config.Groups["docs"].DefaultCommand)
+	}
+	if len(config.Groups["docs"].Repositories) != 1 {
+		t.Errorf("Expected 1 repository in 'docs', got %d", len(config.Groups["docs"].Repositories))
+	}
+
+	if err := repo.Save(ctx, config); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	saved, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read saved config: %v", err)
+	}
+	if !strings.Contains(string(saved), `"default_command": "pull"`) {
+		t.Errorf("Saved config should keep the default command, got:\n%s", saved)
+	}
+
+	reloaded, err := repo.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() after Save() failed: %v", err)
+	}
+	if reloaded.Groups["docs"].DefaultCommand != "pull" {
+		t.Errorf("Expected default command 'pull' after reload, got %q", reloaded.Groups["docs"].DefaultCommand)
+	}
+}
+
+func TestRepository_GroupComposition(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "test_config.json")
+
+	repo := &Repository{
+		configPath: configPath,
+	}
+
+	ctx := context.Background()
+
+	data := `{
+  "repositories": {
+    "web": {"path": "/path/to/web"},
+    "api": {"path": "/path/to/api"}
+  },
+  "groups": {
+    "frontend": ["web"],
+    "critical": ["web", "api"],
+    "critical-frontend": {"composition": {"operation": "intersect", "groups": ["critical", "frontend"]}}
+  }
+}`
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	config, err := repo.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	names, err := config.GroupRepositoryNames("critical-frontend")
+	if err != nil || len(names) != 1 || names[0] != "web" {
+		t.Errorf("Expected critical-frontend to resolve to web, got %v, %v", names, err)
+	}
+
+	if err := repo.Save(ctx, config); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	saved, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read saved config: %v", err)
+	}
+	if !strings.Contains(string(saved), `"operation": "intersect"`) || strings.Contains(string(saved), `"repositories": null`) {
+		t.Errorf("Saved config should keep the composition alone, got:\n%s", saved)
 	}
 }
 
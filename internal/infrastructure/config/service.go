@@ -6,18 +6,23 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/domain/services"
 	gitfleetErrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 	"github.com/qskkk/git-fleet/v2/internal/pkg/logger"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/themes"
 )
 
-// Service implements the ConfigService interface
+// Service implements the ConfigService interface.
+// mu guards config and parentChildMap, so that discovery may mutate the
+// configuration from several goroutines.
 type Service struct {
 	repo           repositories.ConfigRepository
 	logger         logger.Service
+	mu             sync.RWMutex
 	config         *repositories.Config
 	parentChildMap map[string][]string // parent repo -> list of child repos
 }
@@ -47,13 +52,21 @@ func (s *Service) LoadConfig(ctx context.Context) error {
 		return gitfleetErrors.WrapConfigLoad(err)
 	}
 
+	if config.Version > repositories.CurrentConfigVersion {
+		s.logger.Warn(ctx, "Configuration was written by a newer release, some settings may be ignored",
+			"version", config.Version, "supported_version", repositories.CurrentConfigVersion)
+	}
+
 	// Validate configuration
 	if err := s.repo.Validate(ctx, config); err != nil {
 		s.logger.Warn(ctx, "Configuration validation failed", "error", err)
 		// Don't fail loading for validation errors, just warn
 	}
 
+	s.mu.Lock()
 	s.config = config
+	s.mu.Unlock()
+
 	s.logger.Info(ctx, "Configuration loaded successfully",
 		"repositories", len(config.Repositories),
 		"groups", len(config.Groups))
@@ -63,6 +76,9 @@ func (s *Service) LoadConfig(ctx context.Context) error {
 
 // SaveConfig saves the application configuration
 func (s *Service) SaveConfig(ctx context.Context) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil {
 		return gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -79,6 +95,9 @@ func (s *Service) SaveConfig(ctx context.Context) error {
 
 // GetRepository gets a repository by name
 func (s *Service) GetRepository(ctx context.Context, name string) (*entities.Repository, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil {
 		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -93,6 +112,9 @@ func (s *Service) GetRepository(ctx context.Context, name string) (*entities.Rep
 
 // GetGroup gets a group by name
 func (s *Service) GetGroup(ctx context.Context, name string) (*entities.Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil {
 		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -107,6 +129,9 @@ func (s *Service) GetGroup(ctx context.Context, name string) (*entities.Group, e
 
 // GetRepositoriesForGroups gets repositories for multiple groups
 func (s *Service) GetRepositoriesForGroups(ctx context.Context, groupNames []string) ([]*entities.Repository, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil {
 		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -115,11 +140,21 @@ func (s *Service) GetRepositoriesForGroups(ctx context.Context, groupNames []str
 	seenRepos := make(map[string]bool)
 
 	for _, groupName := range groupNames {
-		repos, err := s.config.GetRepositoriesForGroup(groupName)
+		selection, err := entities.ParseGroupSelection(groupName)
+		if err != nil {
+			return nil, err
+		}
+
+		repos, err := s.config.GetRepositoriesForGroup(selection.Group)
 		if err != nil {
 			return nil, err
 		}
 
+		repos, clamped := selection.Apply(repos)
+		if clamped {
+			s.logger.Warn(ctx, "Group range is past the last repository, clamping it", "group", groupName, "repositories", len(repos))
+		}
+
 		// Add unique repositories
 		for _, repo := range repos {
 			if !seenRepos[repo.Name] {
@@ -134,6 +169,9 @@ func (s *Service) GetRepositoriesForGroups(ctx context.Context, groupNames []str
 
 // GetAllGroups gets all configured groups
 func (s *Service) GetAllGroups(ctx context.Context) ([]*entities.Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil {
 		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -143,6 +181,9 @@ func (s *Service) GetAllGroups(ctx context.Context) ([]*entities.Group, error) {
 
 // GetAllRepositories gets all configured repositories
 func (s *Service) GetAllRepositories(ctx context.Context) ([]*entities.Repository, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil {
 		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -152,6 +193,9 @@ func (s *Service) GetAllRepositories(ctx context.Context) ([]*entities.Repositor
 
 // AddRepository adds a new repository to configuration
 func (s *Service) AddRepository(ctx context.Context, name, path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if s.config == nil {
 		return gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -164,6 +208,9 @@ func (s *Service) AddRepository(ctx context.Context, name, path string) error {
 
 // RemoveRepository removes a repository from configuration
 func (s *Service) RemoveRepository(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if s.config == nil {
 		return gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -176,6 +223,9 @@ func (s *Service) RemoveRepository(ctx context.Context, name string) error {
 
 // AddGroup adds a new group to configuration
 func (s *Service) AddGroup(ctx context.Context, group *entities.Group) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if s.config == nil {
 		return gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -188,6 +238,9 @@ func (s *Service) AddGroup(ctx context.Context, group *entities.Group) error {
 
 // RemoveGroup removes a group from configuration
 func (s *Service) RemoveGroup(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if s.config == nil {
 		return gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -200,6 +253,9 @@ func (s *Service) RemoveGroup(ctx context.Context, name string) error {
 
 // ValidateConfig validates the current configuration
 func (s *Service) ValidateConfig(ctx context.Context) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil {
 		return gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -226,23 +282,16 @@ func (s *Service) GetConfigPath() string {
 
 // SetTheme sets the UI theme
 func (s *Service) SetTheme(ctx context.Context, theme string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if s.config == nil {
 		return gitfleetErrors.ErrConfigurationCannotBeNil
 	}
 
-	validThemes := []string{"dark", "light", "fleet"} // TODO use theme package constants
 	theme = strings.ToLower(theme)
-
-	valid := false
-	for _, validTheme := range validThemes {
-		if theme == validTheme {
-			valid = true
-			break
-		}
-	}
-
-	if !valid {
-		return gitfleetErrors.WrapConfigSetTheme(theme, validThemes)
+	if !themes.IsValid(theme) {
+		return gitfleetErrors.WrapConfigSetTheme(theme, themes.Names)
 	}
 
 	s.logger.Info(ctx, "Setting theme", "theme", theme)
@@ -253,18 +302,78 @@ func (s *Service) SetTheme(ctx context.Context, theme string) error {
 
 // GetTheme gets the current UI theme
 func (s *Service) GetTheme(ctx context.Context) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if s.config == nil || s.config.Theme == "" {
-		return "fleet" // TODO use theme package constants
+		return defaultTheme
 	}
 	return s.config.Theme
 }
 
+// GetThemeSchedule gets the hours of the time theme, nil when they are not configured
+func (s *Service) GetThemeSchedule(ctx context.Context) *repositories.ThemeSchedule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.config == nil {
+		return nil
+	}
+	return s.config.ThemeSchedule
+}
+
+// GetBorderStyle gets the table border style
+func (s *Service) GetBorderStyle(ctx context.Context) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.config == nil {
+		return ""
+	}
+	return s.config.BorderStyle
+}
+
+// GetSummaryMetrics gets the metrics shown in execution summaries
+func (s *Service) GetSummaryMetrics(ctx context.Context) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.config == nil {
+		return nil
+	}
+	return s.config.SummaryMetrics
+}
+
+// GetWorktreePath gets the path template of the worktrees created by worktree-add
+func (s *Service) GetWorktreePath(ctx context.Context) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.config == nil {
+		return ""
+	}
+	return s.config.WorktreePath
+}
+
+// GetPager reports whether the output of CLI commands goes through the pager by default
+func (s *Service) GetPager(ctx context.Context) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.config != nil && s.config.Pager
+}
+
+// ConfigChangedOnDisk reports whether the configuration file changed since it was loaded
+func (s *Service) ConfigChangedOnDisk(ctx context.Context) (bool, error) {
+	return s.repo.ChangedOnDisk(ctx)
+}
+
 // DiscoverRepositories discovers repositories in the file system
 func (s *Service) DiscoverRepositories(ctx context.Context) ([]*entities.Repository, error) {
 	s.logger.Info(ctx, "Starting repository discovery")
 
 	// Check if configuration is loaded
-	if s.config == nil {
+	if !s.hasConfig() {
 		s.logger.Warn(ctx, "No configuration loaded, cannot discover repositories")
 		return nil, gitfleetErrors.ErrConfigurationCannotBeNil
 	}
@@ -329,11 +438,9 @@ func (s *Service) scanForGitRepositories(ctx context.Context, rootPath string) (
 			repoName := filepath.Base(path)
 
 			// Skip if repository already exists in config
-			if s.config != nil {
-				if _, exists := s.config.GetRepository(repoName); exists {
-					s.logger.Debug(ctx, "Repository already exists in config, skipping", "name", repoName, "path", path)
-					return filepath.SkipDir // Skip this directory tree since we found it already exists
-				}
+			if s.isConfigured(repoName) {
+				s.logger.Debug(ctx, "Repository already exists in config, skipping", "name", repoName, "path", path)
+				return filepath.SkipDir // Skip this directory tree since we found it already exists
 			}
 
 			repo := &entities.Repository{
@@ -350,13 +457,7 @@ func (s *Service) scanForGitRepositories(ctx context.Context, rootPath string) (
 				childNames := make([]string, 0, len(childRepos))
 				for _, childRepo := range childRepos {
 					// Skip if child repository already exists in config
-					if s.config != nil {
-						if _, exists := s.config.GetRepository(childRepo.Name); !exists {
-							repositories = append(repositories, childRepo)
-							childNames = append(childNames, childRepo.Name)
-							s.logger.Debug(ctx, "Found child Git repository", "parent", repoName, "child", childRepo.Name, "path", childRepo.Path)
-						}
-					} else {
+					if !s.isConfigured(childRepo.Name) {
 						repositories = append(repositories, childRepo)
 						childNames = append(childNames, childRepo.Name)
 						s.logger.Debug(ctx, "Found child Git repository", "parent", repoName, "child", childRepo.Name, "path", childRepo.Path)
@@ -383,7 +484,9 @@ func (s *Service) scanForGitRepositories(ctx context.Context, rootPath string) (
 	}
 
 	// Store parent-child relationships for group creation
+	s.mu.Lock()
 	s.parentChildMap = parentChildMap
+	s.mu.Unlock()
 
 	return repositories, nil
 }
@@ -442,6 +545,8 @@ func (s *Service) groupRepositoriesByParent(ctx context.Context, repositories []
 	}
 
 	// Add parent-child groups
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	if s.parentChildMap != nil {
 		for parentName, childNames := range s.parentChildMap {
 
@@ -455,6 +560,9 @@ func (s *Service) groupRepositoriesByParent(ctx context.Context, repositories []
 
 // addDiscoveredRepositoriesToConfig adds discovered repositories and groups to the configuration
 func (s *Service) addDiscoveredRepositoriesToConfig(ctx context.Context, repositories []*entities.Repository, groups map[string][]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if s.config == nil {
 		s.logger.Warn(ctx, "No configuration loaded, cannot add repositories")
 		return gitfleetErrors.ErrConfigurationCannotBeNil
@@ -478,6 +586,26 @@ func (s *Service) addDiscoveredRepositoriesToConfig(ctx context.Context, reposit
 	return nil
 }
 
+// hasConfig reports whether a configuration is loaded
+func (s *Service) hasConfig() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.config != nil
+}
+
+// isConfigured reports whether a repository with this name is already in the loaded configuration
+func (s *Service) isConfigured(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.config == nil {
+		return false
+	}
+	_, exists := s.config.GetRepository(name)
+	return exists
+}
+
 // scanDirectChildRepositories scans only the direct child directories (one level down) for Git repositories
 func (s *Service) scanDirectChildRepositories(ctx context.Context, parentPath string) []*entities.Repository {
 	var repositories []*entities.Repository
@@ -3,10 +3,14 @@ package config
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
@@ -489,6 +493,41 @@ func TestService_GetRepositoriesForGroups(t *testing.T) {
 		}
 	})
 
+	t.Run("group ranges", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := &repositories.Config{
+			Repositories: map[string]*repositories.RepositoryConfig{
+				"repo1": {Path: "/path/to/repo1"},
+				"repo2": {Path: "/path/to/repo2"},
+				"repo3": {Path: "/path/to/repo3"},
+			},
+			Groups: map[string]*entities.Group{
+				"all": entities.NewGroup("all", []string{"repo1", "repo2", "repo3"}),
+			},
+		}
+
+		repo := repositories.NewMockConfigRepository(ctrl)
+		logger := logger.NewMockService(ctrl)
+		logger.EXPECT().Warn(ctx, gomock.Any(), "group", "all[2:10]", "repositories", 1)
+
+		service := NewService(repo, logger).(*Service)
+		service.config = config
+
+		repos, err := service.GetRepositoriesForGroups(ctx, []string{"all[0:1]", "all[2:10]"})
+		if err != nil {
+			t.Fatalf("GetRepositoriesForGroups() error = %v, want nil", err)
+		}
+		if len(repos) != 2 || repos[0].Name != "repo1" || repos[1].Name != "repo3" {
+			t.Errorf("GetRepositoriesForGroups() = %v, want repo1 and repo3", repos)
+		}
+
+		if _, err := service.GetRepositoriesForGroups(ctx, []string{"all[3:1]"}); err == nil {
+			t.Error("GetRepositoriesForGroups() error = nil, want an invalid range error")
+		}
+	})
+
 	t.Run("config not loaded", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -999,6 +1038,32 @@ func TestService_SetTheme(t *testing.T) {
 		}
 	})
 
+	t.Run("set auto theme", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := &repositories.Config{
+			Repositories: make(map[string]*repositories.RepositoryConfig),
+			Groups:       make(map[string]*entities.Group),
+			Theme:        "dark",
+		}
+
+		repo := repositories.NewMockConfigRepository(ctrl)
+		logger := logger.NewMockService(ctrl)
+		logger.EXPECT().Info(ctx, "Setting theme", "theme", "auto").Times(1)
+
+		service := NewService(repo, logger).(*Service)
+		service.config = config
+
+		if err := service.SetTheme(ctx, "auto"); err != nil {
+			t.Errorf("SetTheme() error = %v, want nil", err)
+		}
+
+		if config.Theme != "auto" {
+			t.Errorf("SetTheme() theme = %v, want auto", config.Theme)
+		}
+	})
+
 	t.Run("set valid theme case insensitive", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -1955,3 +2020,146 @@ func TestService_UnitCoverage(t *testing.T) {
 		}
 	})
 }
+
+// Run with -race: discovery across several roots mutates the configuration concurrently
+func TestService_ConcurrentDiscovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repositories.NewMockConfigRepository(ctrl)
+	logger := logger.NewMockService(ctrl)
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	service := NewService(repo, logger).(*Service)
+	service.config = &repositories.Config{
+		Repositories: make(map[string]*repositories.RepositoryConfig),
+		Groups:       make(map[string]*entities.Group),
+	}
+
+	ctx := context.Background()
+	const roots, reposPerRoot = 8, 5
+
+	rootPaths := make([]string, roots)
+	for i := range rootPaths {
+		rootPaths[i] = t.TempDir()
+		for j := 0; j < reposPerRoot; j++ {
+			gitDir := filepath.Join(rootPaths[i], fmt.Sprintf("root%d-repo%d", i, j), ".git")
+			if err := os.MkdirAll(gitDir, 0755); err != nil {
+				t.Fatalf("Failed to create repository: %v", err)
+			}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i, rootPath := range rootPaths {
+		wg.Add(2)
+		go func(rootPath string, group string) {
+			defer wg.Done()
+			found, err := service.scanForGitRepositories(ctx, rootPath)
+			if err != nil {
+				t.Errorf("scanForGitRepositories() error = %v", err)
+				return
+			}
+			names := make([]string, 0, len(found))
+			for _, repo := range found {
+				names = append(names, repo.Name)
+			}
+			if err := service.addDiscoveredRepositoriesToConfig(ctx, found, map[string][]string{group: names}); err != nil {
+				t.Errorf("addDiscoveredRepositoriesToConfig() error = %v", err)
+			}
+		}(rootPath, fmt.Sprintf("root%d", i))
+
+		go func() {
+			defer wg.Done()
+			_, _ = service.GetAllRepositories(ctx)
+			_, _ = service.GetAllGroups(ctx)
+			_ = service.SaveConfig(ctx)
+		}()
+	}
+	wg.Wait()
+
+	repos, err := service.GetAllRepositories(ctx)
+	if err != nil {
+		t.Fatalf("GetAllRepositories() error = %v", err)
+	}
+	if len(repos) != roots*reposPerRoot {
+		t.Errorf("Config has %d repositories, want %d", len(repos), roots*reposPerRoot)
+	}
+
+	groups, err := service.GetAllGroups(ctx)
+	if err != nil {
+		t.Fatalf("GetAllGroups() error = %v", err)
+	}
+	if len(groups) != roots {
+		t.Errorf("Config has %d groups, want %d", len(groups), roots)
+	}
+}
+
+func TestService_DiscoverNewRepositories(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	root := t.TempDir()
+	for _, dir := range []string{"api/.git", "team/web/.git", "team/web/plugins/.git", "a/b/c/deep/.git", ".cache/hidden/.git", "known/.git"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	logger := logger.NewMockService(ctrl)
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	service := NewService(repositories.NewMockConfigRepository(ctrl), logger).(*Service)
+	service.config = &repositories.Config{
+		Repositories: map[string]*repositories.RepositoryConfig{
+			"renamed": {Path: filepath.Join(root, "known")},
+		},
+		Groups: make(map[string]*entities.Group),
+	}
+
+	discovered, err := service.DiscoverNewRepositories(ctx, []string{root}, 3)
+	if err != nil {
+		t.Fatalf("DiscoverNewRepositories() error = %v", err)
+	}
+
+	var names []string
+	for _, repo := range discovered {
+		names = append(names, repo.Name)
+	}
+	sort.Strings(names)
+	if strings.Join(names, ",") != "api,web" {
+		t.Errorf("DiscoverNewRepositories() = %v, want api and web only", names)
+	}
+	if _, exists := service.config.GetRepository("web"); !exists {
+		t.Error("DiscoverNewRepositories() did not add the repositories to the configuration")
+	}
+	if len(service.config.Groups) != 0 {
+		t.Errorf("DiscoverNewRepositories() changed the groups: %v", service.config.Groups)
+	}
+}
+
+func TestService_RecordDiscovery(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repositories.NewMockConfigRepository(ctrl)
+	repo.EXPECT().GetPath().Return(filepath.Join(t.TempDir(), ".gfconfig.json")).AnyTimes()
+	service := NewService(repo, logger.NewMockService(ctrl)).(*Service)
+
+	if last := service.LastDiscoveryTime(ctx); !last.IsZero() {
+		t.Errorf("LastDiscoveryTime() = %v before any discovery, want the zero time", last)
+	}
+
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	if err := service.RecordDiscovery(ctx, at); err != nil {
+		t.Fatalf("RecordDiscovery() error = %v", err)
+	}
+	if last := service.LastDiscoveryTime(ctx); !last.Equal(at) {
+		t.Errorf("LastDiscoveryTime() = %v, want %v", last, at)
+	}
+}
@@ -0,0 +1,152 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// AutostashConflictWarning is the warning of a pull whose stashed changes did not apply cleanly
+const AutostashConflictWarning = "local changes conflicted with the pulled commits when restored, resolve the conflicts; they are kept in the stash"
+
+// autostashFlag asks git pull to stash local changes before pulling and restore them afterwards
+const autostashFlag = "--autostash"
+
+// minAutostashVersion is the first git release supporting --autostash on merging pulls
+var minAutostashVersion = [2]int{2, 27}
+
+// autostashConflictMarker is printed by git when the stash could not be re-applied
+const autostashConflictMarker = "applying autostash resulted in conflicts"
+
+var (
+	nativeAutostashOnce      sync.Once
+	nativeAutostashSupported bool
+)
+
+// isAutostashPull returns true for git pull commands given --autostash
+func isAutostashPull(cmd *entities.Command) bool {
+	return cmd.IsGitCommand() && cmd.Subcommand() == "pull" && cmd.HasOption(autostashFlag)
+}
+
+// supportsNativeAutostash returns true if the installed git can autostash merging pulls.
+// The version is looked up once.
+func supportsNativeAutostash(ctx context.Context) bool {
+	nativeAutostashOnce.Do(func() {
+		output, err := exec.CommandContext(ctx, "git", "version").Output()
+		if err != nil {
+			return
+		}
+		major, minor, ok := parseGitVersion(string(output))
+		nativeAutostashSupported = ok && (major > minAutostashVersion[0] ||
+			major == minAutostashVersion[0] && minor >= minAutostashVersion[1])
+	})
+	return nativeAutostashSupported
+}
+
+// parseGitVersion returns the major and minor version of a "git version x.y.z" output
+func parseGitVersion(output string) (major, minor int, ok bool) {
+	fields := strings.Fields(output)
+	if len(fields) < 3 || fields[0] != "git" || fields[1] != "version" {
+		return 0, 0, false
+	}
+
+	parts := strings.SplitN(fields[2], ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
+
+// warnOnAutostashConflict flags a successful pull whose autostash left conflicts
+func warnOnAutostashConflict(result *entities.ExecutionResult, stderr string) {
+	output := strings.ToLower(result.Output + "\n" + stderr)
+	if result.IsSuccess() && strings.Contains(output, autostashConflictMarker) {
+		result.Warning = AutostashConflictWarning
+	}
+}
+
+// runWithManualAutostash stashes the local changes, runs the command without --autostash
+// and restores the changes, for git releases that cannot autostash merging pulls and for
+// commands such as checkout that have no --autostash
+func (r *Repository) runWithManualAutostash(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+	stashed, stashOutput, err := r.stashLocalChanges(ctx, repo)
+	if err != nil {
+		result := entities.NewExecutionResultWithClock(repo.Name, cmd.GetFullCommand(), r.clock)
+		result.MarkAsFailed(stashOutput, getExitCode(err), "failed to stash local changes: "+err.Error())
+		result.ErrorCode = errors.Code(failureError(stashOutput, entities.FailureCategoryOther))
+		return result, nil
+	}
+
+	run := *cmd
+	run.Autostash = false
+	run.Args = make([]string, 0, len(cmd.Args))
+	for _, arg := range cmd.Args {
+		if arg != autostashFlag {
+			run.Args = append(run.Args, arg)
+		}
+	}
+
+	result, err := r.ExecuteCommand(ctx, repo, &run)
+	if result != nil {
+		result.Command = cmd.GetFullCommand()
+	}
+	if err != nil || !stashed {
+		return result, err
+	}
+
+	// Restore the changes even when the command failed, leaving the stash on conflicts
+	pop := exec.CommandContext(ctx, "git", "stash", "pop")
+	pop.Dir = repo.Path
+	if output, err := pop.CombinedOutput(); err != nil {
+		result.Warning = AutostashConflictWarning
+		result.Output = strings.TrimSpace(result.Output + "\n" + string(output))
+	}
+
+	return result, nil
+}
+
+// stashLocalChanges stashes the changes to tracked files and returns whether a stash entry
+// was created, along with the error output of git stash
+func (r *Repository) stashLocalChanges(ctx context.Context, repo *entities.Repository) (bool, string, error) {
+	before := r.stashHead(ctx, repo)
+
+	cmd := exec.CommandContext(ctx, "git", "stash", "push", "-m", "gf autostash")
+	cmd.Dir = repo.Path
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return false, stderr.String(), err
+	}
+
+	after := r.stashHead(ctx, repo)
+	return after != "" && after != before, "", nil
+}
+
+// stashHead returns the commit of the latest stash entry, or an empty string when there is none
+func (r *Repository) stashHead(ctx context.Context, repo *entities.Repository) string {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "-q", "--verify", "refs/stash")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
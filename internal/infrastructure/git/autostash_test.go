@@ -0,0 +1,230 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+func TestParseGitVersion(t *testing.T) {
+	tests := []struct {
+		output string
+		major  int
+		minor  int
+		ok     bool
+	}{
+		{"git version 2.39.5\n", 2, 39, true},
+		{"git version 2.26.2.windows.1", 2, 26, true},
+		{"git version 2.50.1 (Apple Git-155)", 2, 50, true},
+		{"not git", 0, 0, false},
+		{"git version unknown", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		major, minor, ok := parseGitVersion(tt.output)
+		if major != tt.major || minor != tt.minor || ok != tt.ok {
+			t.Errorf("parseGitVersion(%q) = %d, %d, %v, want %d, %d, %v", tt.output, major, minor, ok, tt.major, tt.minor, tt.ok)
+		}
+	}
+}
+
+func TestIsAutostashPull(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected bool
+	}{
+		{[]string{"pull", "--autostash"}, true},
+		{[]string{"git", "pull", "--rebase", "--autostash"}, true},
+		{[]string{"pull"}, false},
+		{[]string{"fetch", "--autostash"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := isAutostashPull(entities.NewGitCommand(tt.args)); got != tt.expected {
+			t.Errorf("isAutostashPull(%v) = %v, want %v", tt.args, got, tt.expected)
+		}
+	}
+}
+
+func TestWarnOnAutostashConflict(t *testing.T) {
+	result := entities.NewExecutionResult("repo", "pull --autostash")
+	result.MarkAsSuccess("Updating 1a2b3c4..5d6e7f8\n", 0)
+
+	warnOnAutostashConflict(result, "Applying autostash resulted in conflicts.\nYour changes are safe in the stash.\n")
+	if result.Warning != AutostashConflictWarning {
+		t.Errorf("Warning = %q, want the autostash conflict warning", result.Warning)
+	}
+
+	clean := entities.NewExecutionResult("repo", "pull --autostash")
+	clean.MarkAsSuccess("Already up to date.\n", 0)
+	warnOnAutostashConflict(clean, "Created autostash: 1a2b3c4\nApplied autostash.\n")
+	if clean.HasWarning() {
+		t.Errorf("Warning = %q, want none", clean.Warning)
+	}
+}
+
+func TestRepository_RunWithManualAutostash(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	tests := []struct {
+		name         string
+		localChange  string
+		expectWarn   bool
+		expectedFile string
+	}{
+		{
+			name:         "local changes are restored",
+			localChange:  "line\n\nlocal note\n",
+			expectedFile: "upstream\n\nlocal note\n",
+		},
+		{
+			name:        "conflicting local changes are reported",
+			localChange: "mine\n",
+			expectWarn:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upstream, clone := setupPullFixture(t)
+
+			// Advance the upstream repository
+			writeFile(t, filepath.Join(upstream, "file.txt"), "upstream\n\n\n")
+			runGit(t, upstream, "commit", "-q", "-am", "upstream change")
+
+			writeFile(t, filepath.Join(clone, "file.txt"), tt.localChange)
+
+			repo := &entities.Repository{Name: "clone", Path: clone}
+			cmd := entities.NewGitCommand([]string{"pull", "--autostash", "--no-rebase"})
+
+			result, err := (&Repository{}).runWithManualAutostash(context.Background(), repo, cmd)
+			if err != nil {
+				t.Fatalf("runWithManualAutostash() unexpected error: %v", err)
+			}
+
+			if !result.IsSuccess() {
+				t.Fatalf("runWithManualAutostash() status = %s: %s %s", result.Status, result.ErrorMessage, result.ErrorOutput)
+			}
+			if result.Command != "pull --autostash --no-rebase" {
+				t.Errorf("Command = %q, want the requested command", result.Command)
+			}
+			if result.HasWarning() != tt.expectWarn {
+				t.Errorf("HasWarning() = %v, want %v (warning %q)", result.HasWarning(), tt.expectWarn, result.Warning)
+			}
+
+			if tt.expectedFile != "" {
+				data, err := os.ReadFile(filepath.Join(clone, "file.txt"))
+				if err != nil {
+					t.Fatalf("Failed to read file: %v", err)
+				}
+				if string(data) != tt.expectedFile {
+					t.Errorf("file.txt = %q, want %q", data, tt.expectedFile)
+				}
+			}
+		})
+	}
+}
+
+func TestRepository_ExecuteCommand_AutostashCheckout(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	_, clone := setupPullFixture(t)
+	runGit(t, clone, "checkout", "-q", "-b", "feature")
+	writeFile(t, filepath.Join(clone, "file.txt"), "feature\n\n\n")
+	runGit(t, clone, "commit", "-q", "-am", "feature change")
+	runGit(t, clone, "checkout", "-q", "-")
+
+	// git checkout alone refuses to overwrite the local change
+	writeFile(t, filepath.Join(clone, "file.txt"), "line\n\nlocal note\n")
+	repo := &entities.Repository{Name: "clone", Path: clone}
+	cmd := entities.NewGitCommand([]string{"checkout", "feature"})
+	cmd.SetAutostash()
+
+	result, err := (&Repository{}).ExecuteCommand(context.Background(), repo, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+	}
+	if !result.IsSuccess() || result.HasWarning() {
+		t.Fatalf("ExecuteCommand() status = %s, warning %q: %s %s", result.Status, result.Warning, result.ErrorMessage, result.ErrorOutput)
+	}
+
+	data, err := os.ReadFile(filepath.Join(clone, "file.txt"))
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	if string(data) != "feature\n\nlocal note\n" {
+		t.Errorf("file.txt = %q, want the local change restored on feature", data)
+	}
+}
+
+func TestRepository_ExecuteCommand_AutostashConflict(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	upstream, clone := setupPullFixture(t)
+	writeFile(t, filepath.Join(upstream, "file.txt"), "upstream\n\n\n")
+	runGit(t, upstream, "commit", "-q", "-am", "upstream change")
+	writeFile(t, filepath.Join(clone, "file.txt"), "mine\n")
+
+	repo := &entities.Repository{Name: "clone", Path: clone}
+	cmd := entities.NewGitCommand([]string{"pull", "--autostash", "--no-rebase"})
+
+	result, err := (&Repository{}).ExecuteCommand(context.Background(), repo, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+	}
+
+	if !result.IsSuccess() || result.Warning != AutostashConflictWarning {
+		t.Errorf("ExecuteCommand() status = %s, warning = %q, want success with the autostash conflict warning", result.Status, result.Warning)
+	}
+}
+
+// setupPullFixture creates an upstream repository with one commit and a clone of it
+func setupPullFixture(t *testing.T) (upstream, clone string) {
+	t.Helper()
+
+	upstream = filepath.Join(t.TempDir(), "upstream")
+	runGit(t, "", "init", "-q", upstream)
+	configureGitUser(t, upstream)
+	writeFile(t, filepath.Join(upstream, "file.txt"), "line\n\n\n")
+	runGit(t, upstream, "add", "file.txt")
+	runGit(t, upstream, "commit", "-q", "-m", "initial")
+
+	clone = filepath.Join(t.TempDir(), "clone")
+	runGit(t, "", "clone", "-q", upstream, clone)
+	configureGitUser(t, clone)
+
+	return upstream, clone
+}
+
+func configureGitUser(t *testing.T, dir string) {
+	t.Helper()
+	runGit(t, dir, "config", "user.email", "test@example.com")
+	runGit(t, dir, "config", "user.name", "Test")
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	if dir != "" {
+		args = append([]string{"-C", dir}, args...)
+	}
+	if output, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %v\n%s", args, err, output)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
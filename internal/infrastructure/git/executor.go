@@ -3,21 +3,28 @@ package git
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/progress"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
 )
 
 var maxConcurrency = 10
 
+// retryDelay is the wait before the first retry of a failed command, growing with each attempt
+const retryDelay = time.Second
+
 // Executor implements the ExecutorRepository interface
 type Executor struct {
 	gitRepo          repositories.GitRepository
 	running          map[string]*entities.ExecutionResult
 	mutex            sync.RWMutex
 	progressReporter progress.ProgressReporter
+	clock            clock.Clock
 }
 
 // NewExecutor creates a new Git executor
@@ -25,6 +32,7 @@ func NewExecutor(styleService styles.Service) repositories.ExecutorRepository {
 	return &Executor{
 		running:          make(map[string]*entities.ExecutionResult),
 		progressReporter: progress.NewProgressService(styleService),
+		clock:            clock.New(),
 	}
 }
 
@@ -33,12 +41,26 @@ func NewExecutorWithProgressReporter(progressReporter progress.ProgressReporter)
 	return &Executor{
 		running:          make(map[string]*entities.ExecutionResult),
 		progressReporter: progressReporter,
+		clock:            clock.New(),
 	}
 }
 
-// ExecuteInParallel executes a command on multiple repositories in parallel
+// SetClock sets the clock timing the executions and their summaries, so that tests can
+// supply a fake one
+func (e *Executor) SetClock(c clock.Clock) {
+	e.clock = c
+}
+
+// Clock returns the clock timing the executions and their summaries
+func (e *Executor) Clock() clock.Clock {
+	return e.clock
+}
+
+// ExecuteInParallel executes a command on multiple repositories in parallel. With
+// FailFast on the command, the first failure cancels the running executions and the
+// repositories not started yet, which are reported as cancelled.
 func (e *Executor) ExecuteInParallel(ctx context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
-	summary := entities.NewSummary()
+	summary := entities.NewSummaryWithClock(e.clock)
 
 	// Prepare repository names for progress tracking
 	repoNames := make([]string, len(repos))
@@ -49,6 +71,11 @@ func (e *Executor) ExecuteInParallel(ctx context.Context, repos []*entities.Repo
 	// Start progress reporting
 	e.progressReporter.StartProgress(repoNames, cmd.GetFullCommand())
 
+	// Cancelled on the first failure when failing fast, killing the running processes
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var failed atomic.Bool
+
 	// Channel to collect results
 	resultChan := make(chan *entities.ExecutionResult, len(repos))
 
@@ -58,10 +85,19 @@ func (e *Executor) ExecuteInParallel(ctx context.Context, repos []*entities.Repo
 
 	// Execute command on each repository in parallel
 	for _, repo := range repos {
-		wg.Add(1)
 		// Limit concurrency
 		sem <- struct{}{}
 
+		// Repositories still waiting when the run fails fast are not started
+		if cmd.FailFast && failed.Load() {
+			<-sem
+			result := entities.NewExecutionResultWithClock(repo.Name, cmd.GetFullCommand(), e.clock)
+			result.MarkAsCancelled()
+			resultChan <- result
+			continue
+		}
+
+		wg.Add(1)
 		go func(r *entities.Repository) {
 			defer wg.Done()
 			defer func() { <-sem }()
@@ -69,13 +105,22 @@ func (e *Executor) ExecuteInParallel(ctx context.Context, repos []*entities.Repo
 			// Mark repository as starting
 			e.progressReporter.MarkRepositoryAsStarting(r.Name)
 
-			result, err := e.ExecuteSingle(ctx, r, cmd)
+			result, err := e.ExecuteSingle(runCtx, r, cmd)
 			if err != nil {
 				// Create a failed result if there was an error
-				result = entities.NewExecutionResult(r.Name, cmd.GetFullCommand())
+				result = entities.NewExecutionResultWithClock(r.Name, cmd.GetFullCommand(), e.clock)
 				result.MarkAsFailed("", -1, err.Error())
 			}
 
+			// The first failure cancels the others, whose failures are then cancellations
+			if cmd.FailFast && !result.IsSuccess() && !result.IsSkipped() {
+				if failed.CompareAndSwap(false, true) {
+					cancel()
+				} else if runCtx.Err() != nil {
+					result.MarkAsCancelled()
+				}
+			}
+
 			resultChan <- result
 		}(repo)
 	}
@@ -99,7 +144,7 @@ func (e *Executor) ExecuteInParallel(ctx context.Context, repos []*entities.Repo
 
 // ExecuteSequential executes a command on multiple repositories sequentially
 func (e *Executor) ExecuteSequential(ctx context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
-	summary := entities.NewSummary()
+	summary := entities.NewSummaryWithClock(e.clock)
 
 	// Prepare repository names for progress tracking
 	repoNames := make([]string, len(repos))
@@ -117,7 +162,7 @@ func (e *Executor) ExecuteSequential(ctx context.Context, repos []*entities.Repo
 		result, err := e.ExecuteSingle(ctx, repo, cmd)
 		if err != nil {
 			// Create a failed result if there was an error
-			result = entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+			result = entities.NewExecutionResultWithClock(repo.Name, cmd.GetFullCommand(), e.clock)
 			result.MarkAsFailed("", -1, err.Error())
 		}
 
@@ -135,15 +180,67 @@ func (e *Executor) ExecuteSequential(ctx context.Context, repos []*entities.Repo
 	return summary, nil
 }
 
+// ExecuteInOrder executes a command on repositories already sorted by dependency.
+// A repository runs only once its dependencies have succeeded; otherwise it is skipped,
+// and so are the repositories depending on it.
+func (e *Executor) ExecuteInOrder(ctx context.Context, repos []*entities.Repository, cmd *entities.Command) (*entities.Summary, error) {
+	summary := entities.NewSummaryWithClock(e.clock)
+
+	// Prepare repository names for progress tracking
+	repoNames := make([]string, len(repos))
+	for i, repo := range repos {
+		repoNames[i] = repo.Name
+	}
+
+	// Start progress reporting
+	e.progressReporter.StartProgress(repoNames, cmd.GetFullCommand())
+
+	// Repositories that failed or were skipped, whose dependents must not run
+	failed := make(map[string]bool, len(repos))
+
+	for _, repo := range repos {
+		if repo.HasFailedDependency(failed) {
+			result := entities.NewExecutionResultWithClock(repo.Name, cmd.GetFullCommand(), e.clock)
+			result.MarkAsSkipped(entities.DependencyFailedReason)
+			failed[repo.Name] = true
+
+			summary.AddResult(*result)
+			e.progressReporter.UpdateProgress(result)
+			continue
+		}
+
+		// Mark repository as starting
+		e.progressReporter.MarkRepositoryAsStarting(repo.Name)
+
+		result, err := e.ExecuteSingle(ctx, repo, cmd)
+		if err != nil {
+			// Create a failed result if there was an error
+			result = entities.NewExecutionResultWithClock(repo.Name, cmd.GetFullCommand(), e.clock)
+			result.MarkAsFailed("", -1, err.Error())
+		}
+
+		if !result.IsSuccess() {
+			failed[repo.Name] = true
+		}
+
+		summary.AddResult(*result)
+		e.progressReporter.UpdateProgress(result)
+	}
+
+	summary.Finalize()
+	e.progressReporter.FinishProgress()
+	return summary, nil
+}
+
 // ExecuteSingle executes a command on a single repository
 func (e *Executor) ExecuteSingle(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
 	// Create Git repository if not set
 	if e.gitRepo == nil {
-		e.gitRepo = NewRepository()
+		e.gitRepo = NewRepositoryWithClock(e.clock)
 	}
 
 	// Create execution result
-	result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+	result := entities.NewExecutionResultWithClock(repo.Name, cmd.GetFullCommand(), e.clock)
 
 	// Add to running executions
 	e.mutex.Lock()
@@ -157,13 +254,17 @@ func (e *Executor) ExecuteSingle(ctx context.Context, repo *entities.Repository,
 		e.mutex.Unlock()
 	}()
 
+	// A repository timeout overrides the command's. The command is shared between
+	// repositories, so the override applies to a copy.
+	if repo.Timeout > 0 {
+		repoCmd := *cmd
+		repoCmd.Timeout = repo.Timeout
+		cmd = &repoCmd
+	}
+
 	// Execute the command
 	if cmd.IsGitCommand() || cmd.IsShellCommand() {
-		result, err := e.gitRepo.ExecuteCommand(ctx, repo, cmd)
-		if err != nil {
-			return result, err
-		}
-		return result, nil
+		return e.executeWithRetries(ctx, repo, cmd)
 	}
 
 	// For built-in commands, we would handle them differently
@@ -172,6 +273,31 @@ func (e *Executor) ExecuteSingle(ctx context.Context, repo *entities.Repository,
 	return result, nil
 }
 
+// executeWithRetries runs the command until it succeeds or fails in a way the command
+// does not retry, waiting longer before each new attempt
+func (e *Executor) executeWithRetries(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+	for attempt := 1; ; attempt++ {
+		result, err := e.gitRepo.ExecuteCommand(ctx, repo, cmd)
+		if err != nil || !cmd.ShouldRetry(result, attempt) || !e.waitForRetry(ctx, attempt) {
+			if result != nil && attempt > 1 {
+				result.Attempts = attempt
+			}
+			return result, err
+		}
+	}
+}
+
+// waitForRetry waits on the clock before running the command again after the given
+// attempt, and returns false if the context is done first
+func (e *Executor) waitForRetry(ctx context.Context, attempt int) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-e.clock.After(time.Duration(attempt) * retryDelay):
+		return true
+	}
+}
+
 // Cancel cancels all running executions
 func (e *Executor) Cancel(ctx context.Context) error {
 	e.mutex.Lock()
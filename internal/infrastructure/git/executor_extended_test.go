@@ -11,6 +11,8 @@ import (
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/progress"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
+	gferrors "github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 // Helper function to create a styles service for extended git tests
@@ -81,6 +83,78 @@ func (m *MockGitRepository) GetAheadBehind(ctx context.Context, repo *entities.R
 	return 0, 0, nil
 }
 
+func (m *MockGitRepository) GetAheadBehindBranch(ctx context.Context, repo *entities.Repository, branch string) (ahead, behind int, err error) {
+	return 0, 0, nil
+}
+
+func (m *MockGitRepository) GetRemoteURL(ctx context.Context, repo *entities.Repository, remote string) (string, error) {
+	return "", nil
+}
+
+func (m *MockGitRepository) GetDiffStat(ctx context.Context, repo *entities.Repository, cached bool) (*repositories.DiffStat, error) {
+	return &repositories.DiffStat{}, nil
+}
+
+func (m *MockGitRepository) GetUntrackedFiles(ctx context.Context, repo *entities.Repository) ([]repositories.UntrackedFile, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) GetInProgressOperation(ctx context.Context, repo *entities.Repository) (string, error) {
+	return "", nil
+}
+
+func (m *MockGitRepository) GetLockFile(ctx context.Context, repo *entities.Repository) (string, error) {
+	return "", nil
+}
+
+func (m *MockGitRepository) GetDiskUsage(ctx context.Context, repo *entities.Repository, gitOnly bool) (*repositories.DiskUsage, error) {
+	return &repositories.DiskUsage{}, nil
+}
+
+func (m *MockGitRepository) CountTrackedFiles(ctx context.Context, repo *entities.Repository) (int, error) {
+	return 0, nil
+}
+
+func (m *MockGitRepository) GetSubmodules(ctx context.Context, repo *entities.Repository) ([]repositories.Submodule, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) GetAuthorCommitCounts(ctx context.Context, repo *entities.Repository, since string) (map[string]int, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) CleanUntracked(ctx context.Context, repo *entities.Repository, dryRun bool) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) HasTag(ctx context.Context, repo *entities.Repository, tag string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockGitRepository) PruneRemote(ctx context.Context, repo *entities.Repository, remote string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) FetchPruneTags(ctx context.Context, repo *entities.Repository, remote string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) GetDefaultBranch(ctx context.Context, repo *entities.Repository) (string, error) {
+	return "main", nil
+}
+
+func (m *MockGitRepository) GetMergedBranches(ctx context.Context, repo *entities.Repository, base string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) DeleteBranch(ctx context.Context, repo *entities.Repository, branch string) error {
+	return nil
+}
+
+func (m *MockGitRepository) SetRemoteURL(ctx context.Context, repo *entities.Repository, remote, url string) error {
+	return nil
+}
+
 func (m *MockGitRepository) GetCallCount() int {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
@@ -305,6 +379,159 @@ func TestExecutor_ExecuteInParallel_WithErrors(t *testing.T) {
 	}
 }
 
+// TestExecutor_ExecuteInParallel_FailFast tests that the first failure cancels the running
+// and remaining repositories
+func TestExecutor_ExecuteInParallel_FailFast(t *testing.T) {
+	previous := maxConcurrency
+	maxConcurrency = 2
+	defer func() { maxConcurrency = previous }()
+
+	var mutex sync.Mutex
+	var started []string
+	mockGitRepo := &MockGitRepository{
+		executeCommandFunc: func(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+			mutex.Lock()
+			started = append(started, repo.Name)
+			mutex.Unlock()
+
+			result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+			if repo.Name == "repo1" {
+				result.MarkAsFailed("boom", 1, "exit status 1")
+				return result, nil
+			}
+
+			// The other running repository waits until it is killed
+			select {
+			case <-ctx.Done():
+				result.MarkAsFailed("", -1, "signal: killed")
+			case <-time.After(5 * time.Second):
+				result.MarkAsSuccess("too late", 0)
+			}
+			return result, nil
+		},
+	}
+
+	executor := &Executor{
+		gitRepo:          mockGitRepo,
+		running:          make(map[string]*entities.ExecutionResult),
+		progressReporter: &MockProgressReporter{},
+	}
+
+	repos := []*entities.Repository{
+		{Name: "repo1", Path: "/tmp/repo1"},
+		{Name: "repo2", Path: "/tmp/repo2"},
+		{Name: "repo3", Path: "/tmp/repo3"},
+		{Name: "repo4", Path: "/tmp/repo4"},
+	}
+
+	cmd := entities.NewGitCommand([]string{"status"})
+	cmd.FailFast = true
+
+	summary, err := executor.ExecuteInParallel(context.Background(), repos, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteInParallel() error = %v, want nil", err)
+	}
+
+	if summary.TotalRepositories != len(repos) {
+		t.Errorf("ExecuteInParallel() total repositories = %d, want %d", summary.TotalRepositories, len(repos))
+	}
+	if summary.FailedExecutions != 1 {
+		t.Errorf("ExecuteInParallel() failed = %d, want only the first failure", summary.FailedExecutions)
+	}
+	if summary.CancelledCount() != 3 {
+		t.Errorf("ExecuteInParallel() cancelled = %d, want 3", summary.CancelledCount())
+	}
+	if len(started) != 2 {
+		t.Errorf("ExecuteInParallel() started %v, want no repository dispatched after the failure", started)
+	}
+}
+
+// TestExecutor_ExecuteSingle_Retries tests that only the failures with a retried error
+// code are run again, waiting on the clock longer before each new attempt
+func TestExecutor_ExecuteSingle_Retries(t *testing.T) {
+	tests := []struct {
+		name             string
+		errorCodes       []string
+		retryOn          []string
+		expectedCalls    int
+		expectedSuccess  bool
+		expectedAttempts int
+		expectedWait     time.Duration
+	}{
+		{
+			name:             "transient failure retried until success",
+			errorCodes:       []string{gferrors.CodeNetworkFailure, gferrors.CodeTimeout},
+			expectedCalls:    3,
+			expectedSuccess:  true,
+			expectedAttempts: 3,
+			expectedWait:     3 * retryDelay,
+		},
+		{
+			name:          "conflict not retried by default",
+			errorCodes:    []string{gferrors.CodeMergeConflict},
+			expectedCalls: 1,
+		},
+		{
+			name:             "retries exhausted",
+			errorCodes:       []string{gferrors.CodeNetworkFailure, gferrors.CodeNetworkFailure, gferrors.CodeNetworkFailure},
+			expectedCalls:    3,
+			expectedAttempts: 3,
+			expectedWait:     3 * retryDelay,
+		},
+		{
+			name:          "code outside retry-on not retried",
+			errorCodes:    []string{gferrors.CodeNetworkFailure},
+			retryOn:       []string{gferrors.CodeAuthRequired},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGitRepo := &MockGitRepository{}
+			mockGitRepo.executeCommandFunc = func(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+				result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+				if call := mockGitRepo.callCount - 1; call < len(tt.errorCodes) {
+					result.MarkAsFailed("boom", 1, "exit status 1")
+					result.ErrorCode = tt.errorCodes[call]
+					return result, nil
+				}
+				result.MarkAsSuccess("done", 0)
+				return result, nil
+			}
+
+			start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+			fakeClock := clock.NewFake(start, 0)
+			executor := &Executor{
+				gitRepo: mockGitRepo,
+				running: make(map[string]*entities.ExecutionResult),
+				clock:   fakeClock,
+			}
+
+			cmd := entities.NewGitCommand([]string{"fetch"})
+			cmd.Retries = 2
+			cmd.RetryOn = tt.retryOn
+
+			result, err := executor.ExecuteSingle(context.Background(), &entities.Repository{Name: "repo1", Path: "/tmp/repo1"}, cmd)
+			if err != nil {
+				t.Fatalf("ExecuteSingle() error = %v", err)
+			}
+			if mockGitRepo.callCount != tt.expectedCalls {
+				t.Errorf("ExecuteSingle() ran the command %d times, want %d", mockGitRepo.callCount, tt.expectedCalls)
+			}
+			if result.IsSuccess() != tt.expectedSuccess {
+				t.Errorf("ExecuteSingle() success = %v, want %v", result.IsSuccess(), tt.expectedSuccess)
+			}
+			if result.Attempts != tt.expectedAttempts {
+				t.Errorf("ExecuteSingle() attempts = %d, want %d", result.Attempts, tt.expectedAttempts)
+			}
+			if waited := fakeClock.Now().Sub(start); waited != tt.expectedWait {
+				t.Errorf("ExecuteSingle() waited %v before retrying, want %v", waited, tt.expectedWait)
+			}
+		})
+	}
+}
+
 // TestExecutor_ExecuteSequential_Success tests successful sequential execution
 func TestExecutor_ExecuteSequential_Success(t *testing.T) {
 	mockGitRepo := &MockGitRepository{}
@@ -468,6 +695,63 @@ func TestExecutor_ExecuteSequential_ContinueOnFailure(t *testing.T) {
 	}
 }
 
+// TestExecutor_ExecuteInOrder_SkipsDependentsOfFailure tests that a failed dependency skips its dependents
+func TestExecutor_ExecuteInOrder_SkipsDependentsOfFailure(t *testing.T) {
+	callOrder := []string{}
+
+	mockGitRepo := &MockGitRepository{
+		executeCommandFunc: func(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+			callOrder = append(callOrder, repo.Name)
+
+			result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+			if repo.Name == "lib" {
+				result.MarkAsFailed("build failed", 1, "exit status 1")
+				return result, nil
+			}
+			result.MarkAsSuccess("mock output", 0)
+			return result, nil
+		},
+	}
+
+	executor := &Executor{
+		gitRepo:          mockGitRepo,
+		running:          make(map[string]*entities.ExecutionResult),
+		progressReporter: &MockProgressReporter{},
+	}
+
+	repos := []*entities.Repository{
+		{Name: "lib", Path: "/tmp/lib"}, // This will fail
+		{Name: "docs", Path: "/tmp/docs"},
+		{Name: "app", Path: "/tmp/app", DependsOn: []string{"lib"}},
+		{Name: "cli", Path: "/tmp/cli", DependsOn: []string{"app"}},
+	}
+
+	cmd := entities.NewGitCommand([]string{"pull"})
+	summary, err := executor.ExecuteInOrder(context.Background(), repos, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteInOrder() error = %v, want nil", err)
+	}
+
+	expectedOrder := []string{"lib", "docs"}
+	if len(callOrder) != len(expectedOrder) || callOrder[0] != expectedOrder[0] || callOrder[1] != expectedOrder[1] {
+		t.Errorf("ExecuteInOrder() executed %v, want %v", callOrder, expectedOrder)
+	}
+
+	if summary.TotalRepositories != len(repos) {
+		t.Errorf("ExecuteInOrder() total repositories = %d, want %d", summary.TotalRepositories, len(repos))
+	}
+
+	for _, result := range summary.Results {
+		if result.Repository != "app" && result.Repository != "cli" {
+			continue
+		}
+		if !result.IsSkipped() || result.ErrorMessage != entities.DependencyFailedReason {
+			t.Errorf("ExecuteInOrder() %s status = %s (%s), want skipped due to failed dependency",
+				result.Repository, result.Status, result.ErrorMessage)
+		}
+	}
+}
+
 // TestExecutor_ExecuteSingle_BuiltInCommand tests execution of built-in commands
 func TestExecutor_ExecuteSingle_BuiltInCommand(t *testing.T) {
 	executor := &Executor{
@@ -535,6 +819,43 @@ func TestExecutor_ExecuteSingle_ShellCommand(t *testing.T) {
 	}
 }
 
+// TestExecutor_ExecuteSingle_RepositoryTimeout tests that a repository timeout overrides the command's
+func TestExecutor_ExecuteSingle_RepositoryTimeout(t *testing.T) {
+	var timeouts []time.Duration
+	mockGitRepo := &MockGitRepository{
+		executeCommandFunc: func(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
+			timeouts = append(timeouts, cmd.Timeout)
+			result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+			result.MarkAsSuccess("", 0)
+			return result, nil
+		},
+	}
+
+	executor := &Executor{
+		gitRepo: mockGitRepo,
+		running: make(map[string]*entities.ExecutionResult),
+	}
+
+	monorepo := &entities.Repository{Name: "monorepo", Path: "/tmp/monorepo", Timeout: 10 * time.Minute}
+	small := &entities.Repository{Name: "small", Path: "/tmp/small"}
+	cmd := entities.NewGitCommand([]string{"fetch"})
+	ctx := context.Background()
+
+	for _, repo := range []*entities.Repository{monorepo, small} {
+		if _, err := executor.ExecuteSingle(ctx, repo, cmd); err != nil {
+			t.Fatalf("ExecuteSingle(%s) error = %v, want nil", repo.Name, err)
+		}
+	}
+
+	want := []time.Duration{10 * time.Minute, 30 * time.Second}
+	if len(timeouts) != 2 || timeouts[0] != want[0] || timeouts[1] != want[1] {
+		t.Errorf("ExecuteSingle() timeouts = %v, want %v", timeouts, want)
+	}
+	if cmd.Timeout != 30*time.Second {
+		t.Errorf("ExecuteSingle() changed the shared command timeout to %v", cmd.Timeout)
+	}
+}
+
 // TestExecutor_ExecuteSingle_RunningTracking tests that running executions are tracked
 func TestExecutor_ExecuteSingle_RunningTracking(t *testing.T) {
 	executionStarted := make(chan struct{})
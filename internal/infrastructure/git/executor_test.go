@@ -2,10 +2,14 @@ package git
 
 import (
 	"context"
+	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/progress"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
 )
 
 // Helper function to create a styles service for git tests
@@ -52,6 +56,36 @@ func TestExecutor_ExecuteInParallel(t *testing.T) {
 	}
 }
 
+func TestExecutor_SetClock(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	_, clone := setupPullFixture(t)
+	executor := NewExecutorWithProgressReporter(&progress.NoOpProgressReporter{}).(*Executor)
+
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	executor.SetClock(clock.NewFake(start, time.Second))
+
+	repos := []*entities.Repository{{Name: "api", Path: clone}, {Name: "web", Path: clone}}
+	summary, err := executor.ExecuteSequential(context.Background(), repos, entities.NewGitCommand([]string{"status"}))
+	if err != nil {
+		t.Fatalf("ExecuteSequential() error = %v", err)
+	}
+
+	if !summary.StartTime.Equal(start) {
+		t.Errorf("summary StartTime = %v, want %v", summary.StartTime, start)
+	}
+	for _, result := range summary.Results {
+		if !result.IsSuccess() || result.Duration != time.Second {
+			t.Errorf("%s: status %s, duration %v, want a success of 1s", result.Repository, result.Status, result.Duration)
+		}
+	}
+	if summary.TotalDuration != 2*time.Second {
+		t.Errorf("summary TotalDuration = %v, want 2s", summary.TotalDuration)
+	}
+}
+
 func TestExecutor_ExecuteInParallel_EmptyRepos(t *testing.T) {
 	executor := NewExecutor(createGitTestStylesService()).(*Executor)
 
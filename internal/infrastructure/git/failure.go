@@ -0,0 +1,213 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// clientHooks lists the client-side hooks that git runs for each subcommand
+var clientHooks = map[string][]string{
+	"commit":      {"pre-commit", "prepare-commit-msg", "commit-msg"},
+	"merge":       {"pre-merge-commit", "commit-msg"},
+	"push":        {"pre-push"},
+	"rebase":      {"pre-rebase"},
+	"am":          {"applypatch-msg", "pre-applypatch"},
+	"cherry-pick": {"pre-commit", "commit-msg"},
+}
+
+// hookPatterns appear in the output of commands rejected by a local or remote hook
+var hookPatterns = []string{
+	"hook declined",
+	"hook exited with",
+	"hook failed",
+	"pre-receive hook",
+	"update hook",
+	"husky -",
+}
+
+// conflictPatterns appear in the output of commands stopped by conflicting changes
+var conflictPatterns = []string{
+	"conflict (",
+	"automatic merge failed",
+	"would be overwritten by",
+	"not possible to fast-forward",
+	"needs merge",
+	"unmerged files",
+}
+
+// networkPatterns appear in the output of commands that could not reach a remote
+var networkPatterns = []string{
+	"could not resolve host",
+	"could not read from remote repository",
+	"unable to access",
+	"connection refused",
+	"connection timed out",
+	"connection reset",
+	"network is unreachable",
+	"operation timed out",
+	"the remote end hung up unexpectedly",
+}
+
+// notAGitRepoPatterns appear in the output of commands run outside of a git repository
+var notAGitRepoPatterns = []string{
+	"not a git repository",
+}
+
+// lockPatterns appear in the output of commands that found a lock file of another git process
+var lockPatterns = []string{
+	".lock': file exists",
+	"another git process seems to be running",
+}
+
+// lockFilePattern captures the lock file named in the output of a locked command
+var lockFilePattern = regexp.MustCompile(`'([^']+\.lock)'`)
+
+// authPatterns appear in the output of commands refused by a remote for lack of credentials
+var authPatterns = []string{
+	"authentication failed",
+	"permission denied (publickey",
+	"could not read username",
+	"could not read password",
+	"terminal prompts disabled",
+	"invalid username or password",
+	"the requested url returned error: 401",
+	"the requested url returned error: 403",
+}
+
+// classifyFailure records on a failed result what caused the failure. Failures of
+// commands that run a hook present in the repository, without an error from git
+// itself, are attributed to the hook.
+func (r *Repository) classifyFailure(ctx context.Context, repo *entities.Repository, cmd *entities.Command, result *entities.ExecutionResult, stdout string) {
+	hooked := r.hasActiveHook(ctx, repo, hooksForCommand(cmd))
+
+	result.FailureCategory = categorizeFailure(result.ErrorOutput, hooked)
+	result.ErrorCode = errors.Code(failureError(result.ErrorOutput, result.FailureCategory))
+	if result.ErrorCode == errors.CodeRepositoryLocked {
+		result.ErrorMessage = lockedMessage(result.ErrorOutput)
+	}
+	if result.FailureCategory == entities.FailureCategoryHook {
+		result.HookOutput = strings.TrimSpace(strings.TrimSpace(stdout) + "\n" + strings.TrimSpace(result.ErrorOutput))
+	}
+}
+
+// categorizeFailure returns the failure category matching the error output
+func categorizeFailure(errorOutput string, hooked bool) entities.FailureCategory {
+	output := strings.ToLower(errorOutput)
+
+	switch {
+	case containsAny(output, hookPatterns):
+		return entities.FailureCategoryHook
+	case containsAny(output, conflictPatterns):
+		return entities.FailureCategoryConflict
+	case containsAny(output, networkPatterns):
+		return entities.FailureCategoryNetwork
+	case hooked && !hasGitError(output):
+		return entities.FailureCategoryHook
+	default:
+		return entities.FailureCategoryOther
+	}
+}
+
+// failureError returns the typed error of a failure with the given error output and category.
+// Missing repositories and credentials are told apart from the network failures they cause.
+func failureError(errorOutput string, category entities.FailureCategory) error {
+	output := strings.ToLower(errorOutput)
+
+	switch {
+	case containsAny(output, notAGitRepoPatterns):
+		return errors.ErrNotAGitRepo
+	case containsAny(output, lockPatterns):
+		return errors.ErrRepositoryLocked
+	case containsAny(output, authPatterns):
+		return errors.ErrAuthRequired
+	case category == entities.FailureCategoryConflict:
+		return errors.ErrMergeConflict
+	case category == entities.FailureCategoryNetwork:
+		return errors.ErrNetworkFailure
+	case category == entities.FailureCategoryHook:
+		return errors.ErrHookRejected
+	default:
+		return errors.ErrGitCommandFailed
+	}
+}
+
+// lockedMessage explains a failure caused by the lock file of another git process,
+// naming the file when git reported it
+func lockedMessage(errorOutput string) string {
+	message := "repository " + errors.ErrRepositoryLocked.Error()
+	if match := lockFilePattern.FindStringSubmatch(errorOutput); match != nil {
+		message += " (" + match[1] + " exists; remove it if no git process is running)"
+	}
+	return message
+}
+
+// hooksForCommand returns the client hooks that the git subcommands of the command may run
+func hooksForCommand(cmd *entities.Command) []string {
+	var hooks []string
+	for _, arg := range cmd.Args {
+		hooks = append(hooks, clientHooks[arg]...)
+	}
+	return hooks
+}
+
+// hasActiveHook returns true if one of the hooks is an executable file of the repository,
+// honouring core.hooksPath
+func (r *Repository) hasActiveHook(ctx context.Context, repo *entities.Repository, hooks []string) bool {
+	if len(hooks) == 0 {
+		return false
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--git-path", "hooks")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return false
+	}
+
+	hooksDir := strings.TrimSpace(string(output))
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(repo.Path, hooksDir)
+	}
+
+	for _, hook := range hooks {
+		info, err := os.Stat(filepath.Join(hooksDir, hook))
+		if err == nil && info.Mode().IsRegular() && info.Mode().Perm()&0111 != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasGitError returns true if git itself reported an error in the lowercased output.
+// The generic push error that follows a pre-push hook failure does not count.
+func hasGitError(output string) bool {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "error: failed to push some refs"):
+			continue
+		case strings.HasPrefix(line, "fatal:"), strings.HasPrefix(line, "error:"), strings.Contains(line, "[rejected]"):
+			return true
+		}
+	}
+	return false
+}
+
+// containsAny returns true if the text contains one of the patterns
+func containsAny(text string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.Contains(text, pattern) {
+			return true
+		}
+	}
+	return false
+}
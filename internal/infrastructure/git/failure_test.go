@@ -0,0 +1,234 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestCategorizeFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		errorOutput string
+		hooked      bool
+		expected    entities.FailureCategory
+	}{
+		{
+			name:        "remote hook",
+			errorOutput: " ! [remote rejected] main -> main (pre-receive hook declined)\nerror: failed to push some refs to 'origin'",
+			expected:    entities.FailureCategoryHook,
+		},
+		{
+			name:        "local hook without git error",
+			errorOutput: "lint failed: 3 problems\n",
+			hooked:      true,
+			expected:    entities.FailureCategoryHook,
+		},
+		{
+			name:        "pre-push hook",
+			errorOutput: "tests failed\nerror: failed to push some refs to 'origin'\n",
+			hooked:      true,
+			expected:    entities.FailureCategoryHook,
+		},
+		{
+			name:        "git error with a hook present",
+			errorOutput: "fatal: not a git repository\n",
+			hooked:      true,
+			expected:    entities.FailureCategoryOther,
+		},
+		{
+			name:        "rejected push with a hook present",
+			errorOutput: " ! [rejected] main -> main (fetch first)\nerror: failed to push some refs to 'origin'\n",
+			hooked:      true,
+			expected:    entities.FailureCategoryOther,
+		},
+		{
+			name:        "merge conflict",
+			errorOutput: "CONFLICT (content): Merge conflict in main.go\nAutomatic merge failed; fix conflicts and then commit the result.",
+			expected:    entities.FailureCategoryConflict,
+		},
+		{
+			name:        "network",
+			errorOutput: "fatal: unable to access 'https://example.com/repo.git/': Could not resolve host: example.com",
+			expected:    entities.FailureCategoryNetwork,
+		},
+		{
+			name:        "unclassified",
+			errorOutput: "error: pathspec 'missing' did not match any file(s) known to git",
+			expected:    entities.FailureCategoryOther,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := categorizeFailure(tt.errorOutput, tt.hooked); got != tt.expected {
+				t.Errorf("categorizeFailure() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFailureError(t *testing.T) {
+	tests := []struct {
+		name        string
+		errorOutput string
+		category    entities.FailureCategory
+		expected    error
+	}{
+		{
+			name:        "not a git repository",
+			errorOutput: "fatal: not a git repository (or any of the parent directories): .git",
+			category:    entities.FailureCategoryOther,
+			expected:    errors.ErrNotAGitRepo,
+		},
+		{
+			name:        "ssh key refused",
+			errorOutput: "git@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository.",
+			category:    entities.FailureCategoryNetwork,
+			expected:    errors.ErrAuthRequired,
+		},
+		{
+			name:        "https credentials missing",
+			errorOutput: "fatal: could not read Username for 'https://github.com': terminal prompts disabled",
+			category:    entities.FailureCategoryOther,
+			expected:    errors.ErrAuthRequired,
+		},
+		{
+			name:     "merge conflict",
+			category: entities.FailureCategoryConflict,
+			expected: errors.ErrMergeConflict,
+		},
+		{
+			name:     "network",
+			category: entities.FailureCategoryNetwork,
+			expected: errors.ErrNetworkFailure,
+		},
+		{
+			name:     "hook",
+			category: entities.FailureCategoryHook,
+			expected: errors.ErrHookRejected,
+		},
+		{
+			name:        "index locked by another process",
+			errorOutput: "fatal: Unable to create '/src/api/.git/index.lock': File exists.\n\nAnother git process seems to be running in this repository",
+			category:    entities.FailureCategoryOther,
+			expected:    errors.ErrRepositoryLocked,
+		},
+		{
+			name:        "unclassified",
+			errorOutput: "error: pathspec 'missing' did not match any file(s) known to git",
+			category:    entities.FailureCategoryOther,
+			expected:    errors.ErrGitCommandFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := failureError(tt.errorOutput, tt.category); got != tt.expected {
+				t.Errorf("failureError() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRepository_ExecuteCommand_NotAGitRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := &entities.Repository{Name: "plain", Path: t.TempDir()}
+	cmd := entities.NewGitCommand([]string{"status"})
+
+	result, err := NewRepository().ExecuteCommand(context.Background(), repo, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+	}
+
+	if result.ErrorCode != errors.CodeNotAGitRepo {
+		t.Errorf("ErrorCode = %q, want %q", result.ErrorCode, errors.CodeNotAGitRepo)
+	}
+	if !errors.IsError(result.Err(), errors.ErrNotAGitRepo) {
+		t.Errorf("Err() = %v, want ErrNotAGitRepo", result.Err())
+	}
+}
+
+func TestRepository_ExecuteCommand_Locked(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := exec.Command("git", "init", "-q", tempDir).Run(); err != nil {
+		t.Skipf("git is not available: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "file.txt"), []byte("content\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	lockFile := filepath.Join(tempDir, ".git", "index.lock")
+	if err := os.WriteFile(lockFile, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := &entities.Repository{Name: "locked", Path: tempDir}
+	result, err := NewRepository().ExecuteCommand(context.Background(), repo, entities.NewGitCommand([]string{"add", "file.txt"}))
+	if err != nil {
+		t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+	}
+
+	if result.ErrorCode != errors.CodeRepositoryLocked {
+		t.Errorf("ErrorCode = %q, want %q", result.ErrorCode, errors.CodeRepositoryLocked)
+	}
+	if !strings.Contains(result.ErrorMessage, "locked by another process") || !strings.Contains(result.ErrorMessage, "index.lock") {
+		t.Errorf("ErrorMessage = %q, want the lock file named", result.ErrorMessage)
+	}
+}
+
+func TestHooksForCommand(t *testing.T) {
+	hooks := hooksForCommand(entities.NewShellCommand([]string{"git", "add", ".", "&&", "git", "commit", "-m", "fix"}))
+	if strings.Join(hooks, ",") != "pre-commit,prepare-commit-msg,commit-msg" {
+		t.Errorf("hooksForCommand() = %v, want the commit hooks", hooks)
+	}
+
+	if hooks := hooksForCommand(entities.NewGitCommand([]string{"status"})); len(hooks) != 0 {
+		t.Errorf("hooksForCommand() = %v, want no hooks for status", hooks)
+	}
+}
+
+func TestRepository_ExecuteCommand_HookFailure(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test"},
+	} {
+		if err := exec.Command("git", append([]string{"-C", dir}, args...)...).Run(); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+
+	hook := "#!/bin/sh\necho 'lint: trailing whitespace in main.go'\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(dir, ".git", "hooks", "pre-commit"), []byte(hook), 0755); err != nil {
+		t.Fatalf("Failed to write hook: %v", err)
+	}
+
+	repo := &entities.Repository{Name: "hooked", Path: dir}
+	cmd := entities.NewGitCommand([]string{"commit", "--allow-empty", "-m", "test"})
+
+	result, err := (&Repository{}).ExecuteCommand(context.Background(), repo, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+	}
+
+	if !result.IsHookFailure() {
+		t.Fatalf("ExecuteCommand() failure category = %q, want hook", result.FailureCategory)
+	}
+	if !strings.Contains(result.HookOutput, "trailing whitespace") {
+		t.Errorf("ExecuteCommand() hook output = %q, want the hook message", result.HookOutput)
+	}
+}
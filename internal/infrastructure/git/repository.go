@@ -3,32 +3,47 @@ package git
 import (
 	"bytes"
 	"context"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/clock"
 	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 // Repository implements the GitRepository interface
-type Repository struct{}
+type Repository struct {
+	// clock times the command executions, or the system time when nil
+	clock clock.Clock
+}
 
 // NewRepository creates a new Git repository
 func NewRepository() repositories.GitRepository {
-	return &Repository{}
+	return &Repository{clock: clock.New()}
+}
+
+// NewRepositoryWithClock creates a new Git repository whose command executions are timed
+// by the clock
+func NewRepositoryWithClock(c clock.Clock) repositories.GitRepository {
+	return &Repository{clock: c}
 }
 
 // GetStatus returns the status of a repository
 func (r *Repository) GetStatus(ctx context.Context, repo *entities.Repository) (*entities.Repository, error) {
 	// Create a copy to avoid modifying the original
 	result := &entities.Repository{
-		Name: repo.Name,
-		Path: repo.Path,
+		Name:        repo.Name,
+		Path:        repo.Path,
+		Description: repo.Description,
+		Tags:        repo.Tags,
 	}
 
 	// Check if it's a valid directory
@@ -42,7 +57,7 @@ func (r *Repository) GetStatus(ctx context.Context, repo *entities.Repository) (
 	// Check if it's a valid Git repository
 	if !r.IsValidRepository(ctx, repo.Path) {
 		result.IsValid = false
-		result.ErrorMessage = "not a git repository"
+		result.ErrorMessage = entities.NotAGitRepositoryMessage
 		result.Status = entities.StatusError
 		return result, nil
 	}
@@ -70,6 +85,19 @@ func (r *Repository) GetStatus(ctx context.Context, repo *entities.Repository) (
 	result.DeletedFiles = deleted
 	result.LastChecked = time.Now()
 
+	// Count commits ahead of and behind the upstream branch
+	ahead, behind, err := r.GetAheadBehind(ctx, repo)
+	if err == nil {
+		result.Ahead = ahead
+		result.Behind = behind
+	}
+
+	// Detect an unfinished merge or rebase
+	inProgress, err := r.GetInProgressOperation(ctx, repo)
+	if err == nil {
+		result.InProgress = inProgress
+	}
+
 	// Update status based on changes
 	result.UpdateStatus()
 
@@ -145,7 +173,12 @@ func (r *Repository) IsValidDirectory(ctx context.Context, path string) bool {
 
 // ExecuteCommand executes a Git command in a repository
 func (r *Repository) ExecuteCommand(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
-	result := entities.NewExecutionResult(repo.Name, cmd.GetFullCommand())
+	// Older git releases only autostash rebasing pulls, and other commands cannot
+	if cmd.Autostash || isAutostashPull(cmd) && !supportsNativeAutostash(ctx) {
+		return r.runWithManualAutostash(ctx, repo, cmd)
+	}
+
+	result := entities.NewExecutionResultWithClock(repo.Name, cmd.GetFullCommand(), r.clock)
 	result.MarkAsRunning()
 
 	// Prepare command
@@ -169,6 +202,12 @@ func (r *Repository) ExecuteCommand(ctx context.Context, repo *entities.Reposito
 	}
 
 	execCmd.Dir = repo.Path
+	if cmd.WorkingDir != "" {
+		execCmd.Dir = filepath.Join(repo.Path, cmd.WorkingDir)
+	}
+	if len(cmd.Env) > 0 || len(repo.Env) > 0 {
+		execCmd.Env = commandEnv(os.Environ(), cmd.Env, repo.Env)
+	}
 
 	// Set up output capture
 	var stdout, stderr bytes.Buffer
@@ -190,14 +229,39 @@ func (r *Repository) ExecuteCommand(ctx context.Context, repo *entities.Reposito
 			result.MarkAsTimeout()
 		} else {
 			result.MarkAsFailed(stderr.String(), getExitCode(err), err.Error())
+			r.classifyFailure(ctx, repo, cmd, result, stdout.String())
 		}
 	} else {
 		result.MarkAsSuccess(stdout.String(), 0)
+		if isAutostashPull(cmd) {
+			warnOnAutostashConflict(result, stderr.String())
+		}
 	}
 
 	return result, nil
 }
 
+// commandEnv returns the base environment extended with the given variables, in order,
+// so that later variables override earlier ones and the base environment
+func commandEnv(base []string, overrides ...map[string]string) []string {
+	env := make([]string, len(base))
+	copy(env, base)
+
+	for _, vars := range overrides {
+		keys := make([]string, 0, len(vars))
+		for key := range vars {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			env = append(env, key+"="+vars[key])
+		}
+	}
+
+	return env
+}
+
 // ExecuteShellCommand executes a shell command in a repository
 func (r *Repository) ExecuteShellCommand(ctx context.Context, repo *entities.Repository, cmd *entities.Command) (*entities.ExecutionResult, error) {
 	// For shell commands, we use the same logic as ExecuteCommand
@@ -221,6 +285,22 @@ func (r *Repository) GetRemotes(ctx context.Context, repo *entities.Repository)
 	return remotes, nil
 }
 
+// GetRemoteURL returns the URL of the given remote
+func (r *Repository) GetRemoteURL(ctx context.Context, repo *entities.Repository, remote string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "remote", "get-url", remote)
+	cmd.Dir = repo.Path
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		return "", errors.WrapGitError(errors.ErrFailedToGetRemoteURL, "getting remote url", err)
+	}
+
+	return strings.TrimSpace(out.String()), nil
+}
+
 // GetLastCommit returns information about the last commit
 func (r *Repository) GetLastCommit(ctx context.Context, repo *entities.Repository) (*repositories.CommitInfo, error) {
 	cmd := exec.CommandContext(ctx, "git", "log", "-1", "--pretty=format:%H|%an|%s|%ai")
@@ -276,6 +356,41 @@ func (r *Repository) GetAheadBehind(ctx context.Context, repo *entities.Reposito
 		return 0, 0, nil
 	}
 
+	return parseAheadBehind(parts)
+}
+
+// GetAheadBehindBranch returns how many commits the current branch of the repository is
+// ahead/behind of another branch, or ErrBranchNotFound when the repository lacks it
+func (r *Repository) GetAheadBehindBranch(ctx context.Context, repo *entities.Repository, branch string) (ahead, behind int, err error) {
+	verify := exec.CommandContext(ctx, "git", "rev-parse", "-q", "--verify", branch+"^{commit}")
+	verify.Dir = repo.Path
+
+	if err := verify.Run(); err != nil {
+		// rev-parse --verify exits with 1 when the reference does not exist
+		if getExitCode(err) == 1 {
+			return 0, 0, errors.ErrBranchNotFound
+		}
+		return 0, 0, errors.WrapGitError(errors.ErrFailedToCompareBranch, "verifying branch "+branch, err)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "rev-list", "--left-right", "--count", "HEAD..."+branch, "--")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, 0, errors.WrapGitError(errors.ErrFailedToCompareBranch, "comparing with branch "+branch, err)
+	}
+
+	parts := strings.Fields(string(output))
+	if len(parts) != 2 {
+		return 0, 0, errors.WrapGitError(errors.ErrFailedToCompareBranch, "comparing with branch "+branch, errors.ErrUnexpectedGitLogFormat)
+	}
+
+	return parseAheadBehind(parts)
+}
+
+// parseAheadBehind parses the ahead and behind counts printed by git rev-list --left-right --count
+func parseAheadBehind(parts []string) (ahead, behind int, err error) {
 	ahead, err = strconv.Atoi(parts[0])
 	if err != nil {
 		return 0, 0, errors.WrapGitError(errors.ErrFailedToParseAheadCount, "parsing ahead count", err)
@@ -289,10 +404,527 @@ func (r *Repository) GetAheadBehind(ctx context.Context, repo *entities.Reposito
 	return ahead, behind, nil
 }
 
-// getExitCode extracts exit code from error
+// GetDiffStat returns the unstaged changes of a repository, or the staged ones when cached is true
+func (r *Repository) GetDiffStat(ctx context.Context, repo *entities.Repository, cached bool) (*repositories.DiffStat, error) {
+	args := []string{"diff", "--shortstat"}
+	if cached {
+		args = append(args, "--cached")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = repo.Path
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToGetDiffStat, "getting diff stat", err)
+	}
+
+	return parseShortStat(out.String()), nil
+}
+
+// parseShortStat parses the output of git diff --shortstat, such as
+// " 3 files changed, 10 insertions(+), 2 deletions(-)". Empty output means no changes.
+func parseShortStat(output string) *repositories.DiffStat {
+	stat := &repositories.DiffStat{}
+
+	for _, part := range strings.Split(strings.TrimSpace(output), ",") {
+		fields := strings.Fields(part)
+		if len(fields) < 2 {
+			continue
+		}
+
+		count, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(fields[1], "file"):
+			stat.FilesChanged = count
+		case strings.HasPrefix(fields[1], "insertion"):
+			stat.Insertions = count
+		case strings.HasPrefix(fields[1], "deletion"):
+			stat.Deletions = count
+		}
+	}
+
+	return stat
+}
+
+// GetUntrackedFiles returns the untracked files of a repository with their size
+func (r *Repository) GetUntrackedFiles(ctx context.Context, repo *entities.Repository) ([]repositories.UntrackedFile, error) {
+	cmd := exec.CommandContext(ctx, "git", "status", "--porcelain", "-z", "--untracked-files=all")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToGetUntracked, "getting untracked files", err)
+	}
+
+	var files []repositories.UntrackedFile
+	for _, path := range parseUntrackedPaths(string(output)) {
+		info, err := os.Lstat(filepath.Join(repo.Path, path))
+		if err != nil {
+			// The file may have been removed since git listed it
+			continue
+		}
+		files = append(files, repositories.UntrackedFile{Path: path, Size: info.Size()})
+	}
+
+	return files, nil
+}
+
+// parseUntrackedPaths returns the untracked paths from the output of
+// git status --porcelain -z, where entries are separated by NUL characters
+func parseUntrackedPaths(output string) []string {
+	var paths []string
+
+	entries := strings.Split(output, "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 4 {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(entry, "?? "):
+			paths = append(paths, entry[3:])
+		case entry[0] == 'R' || entry[0] == 'C':
+			// Renames and copies are followed by an entry holding the original path
+			i++
+		}
+	}
+
+	return paths
+}
+
+// gitDir returns the absolute path of the git directory of the repository
+func gitDir(ctx context.Context, repo *entities.Repository) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--git-dir")
+	cmd.Dir = repo.Path
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+
+	if err := cmd.Run(); err != nil {
+		return "", errors.WrapGitError(errors.ErrFailedToGetGitDir, "getting git directory", err)
+	}
+
+	dir := strings.TrimSpace(out.String())
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(repo.Path, dir)
+	}
+	return dir, nil
+}
+
+// GetInProgressOperation returns the merge or rebase left in progress, or an empty string
+func (r *Repository) GetInProgressOperation(ctx context.Context, repo *entities.Repository) (string, error) {
+	gitDir, err := gitDir(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	for _, marker := range []string{"rebase-merge", "rebase-apply"} {
+		if _, err := os.Stat(filepath.Join(gitDir, marker)); err == nil {
+			return entities.OperationRebase, nil
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(gitDir, "MERGE_HEAD")); err == nil {
+		return entities.OperationMerge, nil
+	}
+
+	return "", nil
+}
+
+// lockFiles are the files git creates while updating the index or HEAD
+var lockFiles = []string{"index.lock", "HEAD.lock"}
+
+// GetLockFile returns the index.lock or HEAD.lock file held in the repository by
+// another git process, or an empty string when it is not locked
+func (r *Repository) GetLockFile(ctx context.Context, repo *entities.Repository) (string, error) {
+	gitDir, err := gitDir(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	for _, name := range lockFiles {
+		path := filepath.Join(gitDir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", nil
+}
+
+// GetDiskUsage returns the size of the working tree and of the git directory of a
+// repository, or only the git directory when gitOnly is true
+func (r *Repository) GetDiskUsage(ctx context.Context, repo *entities.Repository, gitOnly bool) (*repositories.DiskUsage, error) {
+	gitDir, err := gitDir(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &repositories.DiskUsage{}
+
+	usage.GitDir, err = directorySize(ctx, gitDir, "")
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToGetDiskUsage, "walking git directory", err)
+	}
+
+	if gitOnly {
+		return usage, nil
+	}
+
+	usage.WorkTree, err = directorySize(ctx, repo.Path, gitDir)
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToGetDiskUsage, "walking working tree", err)
+	}
+
+	return usage, nil
+}
+
+// directorySize sums the size of the files under root, without following symlinks
+// and skipping the directory at exclude
+func directorySize(ctx context.Context, root, exclude string) (int64, error) {
+	var size int64
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if entry.IsDir() {
+			if exclude != "" && path == exclude {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+
+	return size, err
+}
+
+// CountTrackedFiles returns the number of files tracked by git in a repository
+func (r *Repository) CountTrackedFiles(ctx context.Context, repo *entities.Repository) (int, error) {
+	cmd := exec.CommandContext(ctx, "git", "ls-files", "-z")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, errors.WrapGitError(errors.ErrFailedToListFiles, "listing tracked files", err)
+	}
+
+	// Each path is terminated by a NUL byte, so an empty repository counts zero files
+	return bytes.Count(output, []byte{0}), nil
+}
+
+// submoduleStates maps the prefix of a git submodule status line to the submodule state
+var submoduleStates = map[byte]repositories.SubmoduleState{
+	' ': repositories.SubmoduleStateCurrent,
+	'+': repositories.SubmoduleStateOutOfDate,
+	'-': repositories.SubmoduleStateUninitialized,
+	'U': repositories.SubmoduleStateConflicted,
+}
+
+// GetSubmodules returns the submodules of a repository, recursively, or none when it
+// has no .gitmodules file
+func (r *Repository) GetSubmodules(ctx context.Context, repo *entities.Repository) ([]repositories.Submodule, error) {
+	if _, err := os.Stat(filepath.Join(repo.Path, ".gitmodules")); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.WrapGitError(errors.ErrFailedToGetSubmodules, "reading .gitmodules", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "submodule", "status", "--recursive")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToGetSubmodules, "getting submodule status", err)
+	}
+
+	return parseSubmoduleStatus(string(output)), nil
+}
+
+// parseSubmoduleStatus parses the output of git submodule status, whose lines are a
+// state prefix, the commit, the path and an optional (describe) suffix
+func parseSubmoduleStatus(output string) []repositories.Submodule {
+	var submodules []repositories.Submodule
+	for _, line := range strings.Split(output, "\n") {
+		if len(line) < 2 {
+			continue
+		}
+
+		state, ok := submoduleStates[line[0]]
+		if !ok {
+			continue
+		}
+		commit, path, ok := strings.Cut(line[1:], " ")
+		if !ok || path == "" {
+			continue
+		}
+		if i := strings.LastIndex(path, " ("); i >= 0 && strings.HasSuffix(path, ")") {
+			path = path[:i]
+		}
+
+		submodules = append(submodules, repositories.Submodule{
+			Path:   path,
+			Commit: commit,
+			State:  state,
+		})
+	}
+	return submodules
+}
+
+// GetAuthorCommitCounts returns the number of commits of each author on the current
+// branch of a repository, only counting commits after since when it is set
+func (r *Repository) GetAuthorCommitCounts(ctx context.Context, repo *entities.Repository, since string) (map[string]int, error) {
+	// HEAD is given since shortlog reads a log from its input when it is not a terminal
+	args := []string{"shortlog", "-sn", "HEAD"}
+	if since != "" {
+		args = append(args, "--since="+since)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToGetAuthors, "summarizing the log", err)
+	}
+
+	return parseShortlog(string(output)), nil
+}
+
+// parseShortlog parses the output of git shortlog -sn, whose lines are a commit count
+// and an author name separated by a tab
+func parseShortlog(output string) map[string]int {
+	counts := make(map[string]int)
+	for _, line := range strings.Split(output, "\n") {
+		count, author, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if !ok {
+			continue
+		}
+		commits, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			continue
+		}
+		counts[strings.TrimSpace(author)] += commits
+	}
+	return counts
+}
+
+// HasTag checks if the repository has a tag with the given name
+func (r *Repository) HasTag(ctx context.Context, repo *entities.Repository, tag string) (bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "-q", "--verify", "refs/tags/"+tag)
+	cmd.Dir = repo.Path
+
+	if err := cmd.Run(); err != nil {
+		// rev-parse --verify exits with 1 when the reference does not exist
+		if getExitCode(err) == 1 {
+			return false, nil
+		}
+		return false, errors.WrapGitError(errors.ErrFailedToGetTags, "checking tag", err)
+	}
+
+	return true, nil
+}
+
+// PruneRemote deletes the remote-tracking branches of the remote whose branch no longer
+// exists and returns the pruned refs
+func (r *Repository) PruneRemote(ctx context.Context, repo *entities.Repository, remote string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "remote", "prune", remote)
+	cmd.Dir = repo.Path
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToPruneRemote, "pruning remote "+remote, err)
+	}
+
+	return parsePrunedRefs(string(output)), nil
+}
+
+// parsePrunedRefs returns the refs of the " * [pruned] origin/branch" lines of git remote prune
+func parsePrunedRefs(output string) []string {
+	var pruned []string
+	for _, line := range strings.Split(output, "\n") {
+		if _, ref, found := strings.Cut(line, "[pruned]"); found {
+			pruned = append(pruned, strings.TrimSpace(ref))
+		}
+	}
+	return pruned
+}
+
+// FetchPruneTags fetches the remote, pruning the branches and tags deleted on it, and
+// returns the local tags that were deleted
+func (r *Repository) FetchPruneTags(ctx context.Context, repo *entities.Repository, remote string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "fetch", "--prune", "--prune-tags", remote)
+	cmd.Dir = repo.Path
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToPruneTags, "fetching "+remote+" with --prune-tags", err)
+	}
+
+	return parseDeletedTags(string(output), remote), nil
+}
+
+// parseDeletedTags returns the tags of the " - [deleted] (none) -> v1.0" lines of git fetch
+// --prune, leaving out the remote-tracking branches, which start with the remote name
+func parseDeletedTags(output, remote string) []string {
+	var tags []string
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.Contains(line, "[deleted]") {
+			continue
+		}
+		_, ref, found := strings.Cut(line, "->")
+		ref = strings.TrimSpace(ref)
+		if found && ref != "" && !strings.HasPrefix(ref, remote+"/") {
+			tags = append(tags, ref)
+		}
+	}
+	return tags
+}
+
+// GetDefaultBranch returns the default branch of the repository's remote, falling back
+// to a local main or master branch
+func (r *Repository) GetDefaultBranch(ctx context.Context, repo *entities.Repository) (string, error) {
+	remote := repo.RemoteName()
+
+	cmd := exec.CommandContext(ctx, "git", "symbolic-ref", "--short", "refs/remotes/"+remote+"/HEAD")
+	cmd.Dir = repo.Path
+
+	if output, err := cmd.Output(); err == nil {
+		if branch := strings.TrimPrefix(strings.TrimSpace(string(output)), remote+"/"); branch != "" {
+			return branch, nil
+		}
+	}
+
+	for _, branch := range []string{"main", "master"} {
+		verify := exec.CommandContext(ctx, "git", "rev-parse", "-q", "--verify", "refs/heads/"+branch)
+		verify.Dir = repo.Path
+		if verify.Run() == nil {
+			return branch, nil
+		}
+	}
+
+	return "", errors.ErrDefaultBranchNotFound
+}
+
+// GetMergedBranches returns the local branches fully merged into the base branch
+func (r *Repository) GetMergedBranches(ctx context.Context, repo *entities.Repository, base string) ([]string, error) {
+	// for-each-ref prints bare names, unlike git branch which marks the current branch
+	cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--merged", base, "--format=%(refname:short)", "refs/heads/")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToGetMergedBranches, "listing merged branches", err)
+	}
+
+	var branches []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if branch := strings.TrimSpace(line); branch != "" {
+			branches = append(branches, branch)
+		}
+	}
+
+	return branches, nil
+}
+
+// DeleteBranch deletes a local branch. The branch is force deleted since callers only
+// delete branches known to be merged into the default branch, which git branch -d would
+// refuse when that branch is not checked out.
+func (r *Repository) DeleteBranch(ctx context.Context, repo *entities.Repository, branch string) error {
+	cmd := exec.CommandContext(ctx, "git", "branch", "-D", branch)
+	cmd.Dir = repo.Path
+
+	if err := cmd.Run(); err != nil {
+		return errors.WrapGitError(errors.ErrFailedToDeleteBranch, "deleting branch "+branch, err)
+	}
+
+	return nil
+}
+
+// CleanUntracked removes the untracked files and directories of a repository with git
+// clean -fd and returns their paths, or only lists them with git clean -nd when dryRun
+// is set. Ignored files are kept.
+func (r *Repository) CleanUntracked(ctx context.Context, repo *entities.Repository, dryRun bool) ([]string, error) {
+	mode := "-f"
+	if dryRun {
+		mode = "-n"
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "clean", mode, "-d")
+	cmd.Dir = repo.Path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.WrapGitError(errors.ErrFailedToClean, "cleaning untracked files", err)
+	}
+
+	return parseCleanOutput(string(output)), nil
+}
+
+// parseCleanOutput returns the paths listed by git clean, which prints "Removing <path>"
+// for each path it removes and "Would remove <path>" in a dry run. Nested repositories,
+// which git clean skips, are left out.
+func parseCleanOutput(output string) []string {
+	paths := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		for _, prefix := range []string{"Removing ", "Would remove "} {
+			if path, ok := strings.CutPrefix(line, prefix); ok {
+				paths = append(paths, path)
+				break
+			}
+		}
+	}
+	return paths
+}
+
+// SetRemoteURL changes the URL of the given remote
+func (r *Repository) SetRemoteURL(ctx context.Context, repo *entities.Repository, remote, url string) error {
+	cmd := exec.CommandContext(ctx, "git", "remote", "set-url", remote, url)
+	cmd.Dir = repo.Path
+
+	if err := cmd.Run(); err != nil {
+		return errors.WrapGitError(errors.ErrFailedToSetRemoteURL, "setting url of remote "+remote, err)
+	}
+
+	return nil
+}
+
+// getExitCode extracts exit code from error. A process killed by a signal exits with
+// 128 plus the signal number, as shells report it, and -1 is returned when no process ran.
 func getExitCode(err error) int {
-	if exitError, ok := err.(*exec.ExitError); ok {
-		return exitError.ExitCode()
+	exitError, ok := err.(*exec.ExitError)
+	if !ok {
+		return -1
+	}
+	if status, ok := exitError.Sys().(signaledStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal())
 	}
-	return -1
+	return exitError.ExitCode()
+}
+
+// signaledStatus is the part of syscall.WaitStatus telling whether a signal ended the process
+type signaledStatus interface {
+	Signaled() bool
+	Signal() syscall.Signal
 }
@@ -3,10 +3,15 @@ package git
 import (
 	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
 
 func TestNewRepository(t *testing.T) {
@@ -648,3 +653,580 @@ func TestRepository_GetRemotes(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_GetInProgressOperation(t *testing.T) {
+	repo := &Repository{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		marker   string
+		isDir    bool
+		expected string
+	}{
+		{"no operation", "", false, ""},
+		{"merge in progress", "MERGE_HEAD", false, entities.OperationMerge},
+		{"interactive rebase in progress", "rebase-merge", true, entities.OperationRebase},
+		{"apply rebase in progress", "rebase-apply", true, entities.OperationRebase},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := exec.Command("git", "init", "-q", tempDir).Run(); err != nil {
+				t.Skipf("git is not available: %v", err)
+			}
+
+			if tt.marker != "" {
+				markerPath := filepath.Join(tempDir, ".git", tt.marker)
+				var err error
+				if tt.isDir {
+					err = os.Mkdir(markerPath, 0755)
+				} else {
+					err = os.WriteFile(markerPath, []byte("0000000000000000000000000000000000000000\n"), 0644)
+				}
+				if err != nil {
+					t.Fatalf("Failed to create marker: %v", err)
+				}
+			}
+
+			testRepo := &entities.Repository{Name: "test-repo", Path: tempDir}
+			operation, err := repo.GetInProgressOperation(ctx, testRepo)
+			if err != nil {
+				t.Fatalf("GetInProgressOperation() unexpected error: %v", err)
+			}
+			if operation != tt.expected {
+				t.Errorf("GetInProgressOperation() = %q, want %q", operation, tt.expected)
+			}
+
+			status, err := repo.GetStatus(ctx, testRepo)
+			if err != nil {
+				t.Fatalf("GetStatus() unexpected error: %v", err)
+			}
+			if status.InProgress != tt.expected {
+				t.Errorf("GetStatus().InProgress = %q, want %q", status.InProgress, tt.expected)
+			}
+			if tt.expected != "" && status.Status != entities.StatusWarning {
+				t.Errorf("GetStatus().Status = %s, want %s", status.Status, entities.StatusWarning)
+			}
+		})
+	}
+}
+
+func TestRepository_GetInProgressOperation_InvalidPath(t *testing.T) {
+	repo := &Repository{}
+	testRepo := &entities.Repository{Name: "test-repo", Path: "/non/existent/path"}
+
+	if _, err := repo.GetInProgressOperation(context.Background(), testRepo); err == nil {
+		t.Error("GetInProgressOperation() should return error for invalid path")
+	}
+}
+
+func TestRepository_GetLockFile(t *testing.T) {
+	repo := &Repository{}
+	ctx := context.Background()
+
+	tempDir := t.TempDir()
+	if err := exec.Command("git", "init", "-q", tempDir).Run(); err != nil {
+		t.Skipf("git is not available: %v", err)
+	}
+	testRepo := &entities.Repository{Name: "test-repo", Path: tempDir}
+
+	if lockFile, err := repo.GetLockFile(ctx, testRepo); err != nil || lockFile != "" {
+		t.Errorf("GetLockFile() = %q, %v, want no lock file", lockFile, err)
+	}
+
+	headLock := filepath.Join(tempDir, ".git", "HEAD.lock")
+	if err := os.WriteFile(headLock, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if lockFile, err := repo.GetLockFile(ctx, testRepo); err != nil || lockFile != headLock {
+		t.Errorf("GetLockFile() = %q, %v, want %q", lockFile, err, headLock)
+	}
+
+	if _, err := repo.GetLockFile(ctx, &entities.Repository{Name: "missing", Path: "/non/existent/path"}); err == nil {
+		t.Error("GetLockFile() should return error for invalid path")
+	}
+}
+
+func TestRepository_GetDiskUsage(t *testing.T) {
+	repo := &Repository{}
+	ctx := context.Background()
+
+	tempDir := t.TempDir()
+	if err := exec.Command("git", "init", "-q", tempDir).Run(); err != nil {
+		t.Skipf("git is not available: %v", err)
+	}
+	testRepo := &entities.Repository{Name: "test-repo", Path: tempDir}
+
+	if err := os.MkdirAll(filepath.Join(tempDir, "src"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "README.md"), make([]byte, 100), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "src", "main.go"), make([]byte, 250), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	usage, err := repo.GetDiskUsage(ctx, testRepo, false)
+	if err != nil {
+		t.Fatalf("GetDiskUsage() error = %v", err)
+	}
+	if usage.WorkTree != 350 {
+		t.Errorf("GetDiskUsage() working tree = %d, want 350 without the .git directory", usage.WorkTree)
+	}
+	if usage.GitDir == 0 {
+		t.Error("GetDiskUsage() should measure the .git directory")
+	}
+
+	gitOnly, err := repo.GetDiskUsage(ctx, testRepo, true)
+	if err != nil {
+		t.Fatalf("GetDiskUsage() with gitOnly error = %v", err)
+	}
+	if gitOnly.WorkTree != 0 || gitOnly.GitDir != usage.GitDir {
+		t.Errorf("GetDiskUsage() with gitOnly = %+v, want only the git directory %d", gitOnly, usage.GitDir)
+	}
+
+	if _, err := repo.GetDiskUsage(ctx, &entities.Repository{Name: "missing", Path: "/non/existent/path"}, false); err == nil {
+		t.Error("GetDiskUsage() should return error for invalid path")
+	}
+}
+
+func TestRepository_CountTrackedFiles(t *testing.T) {
+	repo := &Repository{}
+	ctx := context.Background()
+
+	tempDir := t.TempDir()
+	if err := exec.Command("git", "init", "-q", tempDir).Run(); err != nil {
+		t.Skipf("git is not available: %v", err)
+	}
+	testRepo := &entities.Repository{Name: "test-repo", Path: tempDir}
+
+	if count, err := repo.CountTrackedFiles(ctx, testRepo); err != nil || count != 0 {
+		t.Errorf("CountTrackedFiles() on an empty repository = %d, %v, want 0", count, err)
+	}
+
+	for _, name := range []string{"a.txt", "with space.txt", "untracked.txt"} {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := exec.Command("git", "-C", tempDir, "add", "a.txt", "with space.txt").Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count, err := repo.CountTrackedFiles(ctx, testRepo); err != nil || count != 2 {
+		t.Errorf("CountTrackedFiles() = %d, %v, want 2", count, err)
+	}
+
+	if _, err := repo.CountTrackedFiles(ctx, &entities.Repository{Name: "missing", Path: "/non/existent/path"}); err == nil {
+		t.Error("CountTrackedFiles() should return error for invalid path")
+	}
+}
+
+func TestParseSubmoduleStatus(t *testing.T) {
+	output := " 1a2b3c4 vendor/proto (v1.2.0)\n+4d5e6f7 vendor/ui (heads/main)\n-7a8b9c0 docs site\nU0000000 conflicted\n"
+
+	submodules := parseSubmoduleStatus(output)
+	expected := []repositories.Submodule{
+		{Path: "vendor/proto", Commit: "1a2b3c4", State: repositories.SubmoduleStateCurrent},
+		{Path: "vendor/ui", Commit: "4d5e6f7", State: repositories.SubmoduleStateOutOfDate},
+		{Path: "docs site", Commit: "7a8b9c0", State: repositories.SubmoduleStateUninitialized},
+		{Path: "conflicted", Commit: "0000000", State: repositories.SubmoduleStateConflicted},
+	}
+	if !reflect.DeepEqual(submodules, expected) {
+		t.Errorf("parseSubmoduleStatus() = %+v, want %+v", submodules, expected)
+	}
+
+	if submodules := parseSubmoduleStatus(""); len(submodules) != 0 {
+		t.Errorf("parseSubmoduleStatus() of an empty output = %+v, want none", submodules)
+	}
+}
+
+func TestRepository_GetSubmodules_WithoutGitmodules(t *testing.T) {
+	repo := &Repository{}
+
+	submodules, err := repo.GetSubmodules(context.Background(), &entities.Repository{Name: "plain", Path: t.TempDir()})
+	if err != nil || len(submodules) != 0 {
+		t.Errorf("GetSubmodules() without .gitmodules = %+v, %v, want none", submodules, err)
+	}
+}
+
+func TestParseShortStat(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		expected repositories.DiffStat
+	}{
+		{
+			name:     "all counts",
+			output:   " 3 files changed, 10 insertions(+), 2 deletions(-)\n",
+			expected: repositories.DiffStat{FilesChanged: 3, Insertions: 10, Deletions: 2},
+		},
+		{
+			name:     "singular insertion only",
+			output:   " 1 file changed, 1 insertion(+)\n",
+			expected: repositories.DiffStat{FilesChanged: 1, Insertions: 1},
+		},
+		{
+			name:     "deletions only",
+			output:   " 2 files changed, 7 deletions(-)\n",
+			expected: repositories.DiffStat{FilesChanged: 2, Deletions: 7},
+		},
+		{
+			name:     "clean repository",
+			output:   "",
+			expected: repositories.DiffStat{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseShortStat(tt.output); *got != tt.expected {
+				t.Errorf("parseShortStat(%q) = %+v, want %+v", tt.output, *got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommandEnv(t *testing.T) {
+	base := []string{"PATH=/usr/bin", "MODE=base"}
+
+	env := commandEnv(base, map[string]string{"MODE": "file", "TOKEN": "abc"}, map[string]string{"MODE": "repo"})
+
+	expected := []string{"PATH=/usr/bin", "MODE=base", "MODE=file", "TOKEN=abc", "MODE=repo"}
+	if strings.Join(env, "|") != strings.Join(expected, "|") {
+		t.Errorf("commandEnv() = %v, want %v", env, expected)
+	}
+	if base[1] != "MODE=base" || len(base) != 2 {
+		t.Errorf("commandEnv() should not modify the base environment, got %v", base)
+	}
+}
+
+func TestRepository_ExecuteCommand_Env(t *testing.T) {
+	repo := &entities.Repository{Name: "env", Path: t.TempDir(), Env: map[string]string{"GF_REPO_VAR": "repo"}}
+	cmd := entities.NewShellCommand([]string{"echo $GF_FILE_VAR-$GF_REPO_VAR"})
+	cmd.Env = map[string]string{"GF_FILE_VAR": "file", "GF_REPO_VAR": "file"}
+
+	result, err := (&Repository{}).ExecuteCommand(context.Background(), repo, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+	}
+
+	if strings.TrimSpace(result.Output) != "file-repo" {
+		t.Errorf("ExecuteCommand() output = %q, want %q", result.Output, "file-repo")
+	}
+}
+
+func TestRepository_ExecuteCommand_WorkingDir(t *testing.T) {
+	repo := &entities.Repository{Name: "mono", Path: t.TempDir()}
+	if err := os.Mkdir(filepath.Join(repo.Path, "web"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	cmd := entities.NewShellCommand([]string{"pwd"})
+	cmd.WorkingDir = "web"
+
+	result, err := (&Repository{}).ExecuteCommand(context.Background(), repo, cmd)
+	if err != nil {
+		t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+	}
+
+	if filepath.Base(strings.TrimSpace(result.Output)) != "web" {
+		t.Errorf("ExecuteCommand() ran in %q, want the web subdirectory", result.Output)
+	}
+}
+
+func TestRepository_ExecuteCommand_ExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		expected int
+	}{
+		{"success", "true", 0},
+		{"exit status", "exit 3", 3},
+		{"killed by a signal", "kill -TERM $$", 128 + 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &entities.Repository{Name: "exit", Path: t.TempDir()}
+
+			result, err := (&Repository{}).ExecuteCommand(context.Background(), repo, entities.NewShellCommand([]string{tt.command}))
+			if err != nil {
+				t.Fatalf("ExecuteCommand() unexpected error: %v", err)
+			}
+
+			if result.ExitCode != tt.expected {
+				t.Errorf("ExecuteCommand() exit code = %d, want %d", result.ExitCode, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseShortlog(t *testing.T) {
+	output := "    12\tAda Lovelace\n     3\tBob\n\n     1\tAda Lovelace\n"
+
+	counts := parseShortlog(output)
+
+	if len(counts) != 2 || counts["Ada Lovelace"] != 13 || counts["Bob"] != 3 {
+		t.Errorf("parseShortlog() = %v, want Ada Lovelace: 13 and Bob: 3", counts)
+	}
+}
+
+func TestParseCleanOutput(t *testing.T) {
+	for _, output := range []string{
+		"Removing build/\nRemoving notes.txt\n",
+		"Would remove build/\nWould skip repository vendor/lib\nWould remove notes.txt\n",
+	} {
+		paths := parseCleanOutput(output)
+
+		if strings.Join(paths, "|") != "build/|notes.txt" {
+			t.Errorf("parseCleanOutput(%q) = %v, want build/ and notes.txt", output, paths)
+		}
+	}
+}
+
+func TestParseUntrackedPaths(t *testing.T) {
+	output := "?? build/app.bin\x00 M main.go\x00R  new.go\x00?? old.go\x00?? dir with space/file.zip\x00"
+
+	paths := parseUntrackedPaths(output)
+
+	expected := []string{"build/app.bin", "dir with space/file.zip"}
+	if strings.Join(paths, "|") != strings.Join(expected, "|") {
+		t.Errorf("parseUntrackedPaths() = %v, want %v", paths, expected)
+	}
+}
+
+func TestRepository_GetDiffStat_InvalidPath(t *testing.T) {
+	repo := &Repository{}
+	testRepo := &entities.Repository{Name: "test-repo", Path: "/non/existent/path"}
+
+	_, err := repo.GetDiffStat(context.Background(), testRepo, false)
+	if !errors.IsError(err, errors.ErrFailedToGetDiffStat) {
+		t.Errorf("expected ErrFailedToGetDiffStat, got %v", err)
+	}
+}
+
+func TestRepository_HasTag(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	upstream, _ := setupPullFixture(t)
+	runGit(t, upstream, "tag", "-a", "v1.0.0", "-m", "Release v1.0.0")
+
+	repo := &Repository{}
+	ctx := context.Background()
+	testRepo := &entities.Repository{Name: "upstream", Path: upstream}
+
+	for tag, expected := range map[string]bool{"v1.0.0": true, "v2.0.0": false} {
+		exists, err := repo.HasTag(ctx, testRepo, tag)
+		if err != nil {
+			t.Fatalf("HasTag(%s) unexpected error: %v", tag, err)
+		}
+		if exists != expected {
+			t.Errorf("HasTag(%s) = %v, want %v", tag, exists, expected)
+		}
+	}
+
+	if _, err := repo.HasTag(ctx, &entities.Repository{Name: "plain", Path: t.TempDir()}, "v1.0.0"); !errors.IsError(err, errors.ErrFailedToGetTags) {
+		t.Errorf("HasTag() outside a repository error = %v, want ErrFailedToGetTags", err)
+	}
+}
+
+func TestRepository_GetAheadBehindBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	_, clone := setupPullFixture(t)
+	runGit(t, clone, "branch", "release")
+	runGit(t, clone, "commit", "-q", "--allow-empty", "-m", "feature")
+	runGit(t, clone, "commit", "-q", "--allow-empty", "-m", "feature again")
+	runGit(t, clone, "checkout", "-q", "release")
+	runGit(t, clone, "commit", "-q", "--allow-empty", "-m", "fix")
+	runGit(t, clone, "checkout", "-q", "-")
+
+	repo := &Repository{}
+	ctx := context.Background()
+	testRepo := &entities.Repository{Name: "clone", Path: clone}
+
+	ahead, behind, err := repo.GetAheadBehindBranch(ctx, testRepo, "release")
+	if err != nil {
+		t.Fatalf("GetAheadBehindBranch() unexpected error: %v", err)
+	}
+	if ahead != 2 || behind != 1 {
+		t.Errorf("GetAheadBehindBranch() = (%d, %d), want (2, 1)", ahead, behind)
+	}
+
+	if _, _, err := repo.GetAheadBehindBranch(ctx, testRepo, "missing"); !errors.IsError(err, errors.ErrBranchNotFound) {
+		t.Errorf("GetAheadBehindBranch() of a missing branch error = %v, want ErrBranchNotFound", err)
+	}
+
+	if _, _, err := repo.GetAheadBehindBranch(ctx, &entities.Repository{Name: "plain", Path: t.TempDir()}, "release"); !errors.IsError(err, errors.ErrFailedToCompareBranch) {
+		t.Errorf("GetAheadBehindBranch() outside a repository error = %v, want ErrFailedToCompareBranch", err)
+	}
+}
+
+func TestParsePrunedRefs(t *testing.T) {
+	output := "Pruning origin\nURL: /srv/git/api.git\n * [pruned] origin/feature-a\n * [pruned] origin/feature-b\n"
+
+	pruned := parsePrunedRefs(output)
+	if len(pruned) != 2 || pruned[0] != "origin/feature-a" || pruned[1] != "origin/feature-b" {
+		t.Errorf("parsePrunedRefs() = %v", pruned)
+	}
+
+	if pruned := parsePrunedRefs(""); len(pruned) != 0 {
+		t.Errorf("parsePrunedRefs() of an empty output = %v, want none", pruned)
+	}
+}
+
+func TestRepository_PruneRemote(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	upstream, clone := setupPullFixture(t)
+	runGit(t, upstream, "branch", "feature")
+	runGit(t, clone, "fetch", "-q", "origin")
+	runGit(t, upstream, "branch", "-D", "feature")
+
+	repo := &Repository{}
+	ctx := context.Background()
+	testRepo := &entities.Repository{Name: "clone", Path: clone}
+
+	pruned, err := repo.PruneRemote(ctx, testRepo, "origin")
+	if err != nil {
+		t.Fatalf("PruneRemote() unexpected error: %v", err)
+	}
+	if len(pruned) != 1 || pruned[0] != "origin/feature" {
+		t.Errorf("PruneRemote() = %v, want [origin/feature]", pruned)
+	}
+
+	// Nothing is left to prune the second time
+	if pruned, err = repo.PruneRemote(ctx, testRepo, "origin"); err != nil || len(pruned) != 0 {
+		t.Errorf("PruneRemote() = %v, %v, want nothing pruned", pruned, err)
+	}
+
+	if _, err := repo.PruneRemote(ctx, testRepo, "missing"); !errors.IsError(err, errors.ErrFailedToPruneRemote) {
+		t.Errorf("PruneRemote() of an unknown remote error = %v, want ErrFailedToPruneRemote", err)
+	}
+}
+
+func TestParseDeletedTags(t *testing.T) {
+	output := "From /srv/git/api\n - [deleted]         (none)     -> origin/feature\n - [deleted]         (none)     -> v1.0\n - [deleted]         (none)     -> v1.1-rc\n"
+
+	tags := parseDeletedTags(output, "origin")
+	if len(tags) != 2 || tags[0] != "v1.0" || tags[1] != "v1.1-rc" {
+		t.Errorf("parseDeletedTags() = %v, want [v1.0 v1.1-rc]", tags)
+	}
+
+	if tags := parseDeletedTags("", "origin"); len(tags) != 0 {
+		t.Errorf("parseDeletedTags() of an empty output = %v, want none", tags)
+	}
+}
+
+func TestRepository_FetchPruneTags(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	upstream, clone := setupPullFixture(t)
+	runGit(t, upstream, "tag", "v1.0")
+	runGit(t, upstream, "tag", "v2.0")
+	runGit(t, clone, "fetch", "-q", "--tags", "origin")
+	runGit(t, upstream, "tag", "-d", "v1.0")
+
+	repo := &Repository{}
+	ctx := context.Background()
+	testRepo := &entities.Repository{Name: "clone", Path: clone}
+
+	tags, err := repo.FetchPruneTags(ctx, testRepo, "origin")
+	if err != nil {
+		t.Fatalf("FetchPruneTags() unexpected error: %v", err)
+	}
+	if len(tags) != 1 || tags[0] != "v1.0" {
+		t.Errorf("FetchPruneTags() = %v, want [v1.0]", tags)
+	}
+
+	// Nothing is left to prune the second time
+	if tags, err = repo.FetchPruneTags(ctx, testRepo, "origin"); err != nil || len(tags) != 0 {
+		t.Errorf("FetchPruneTags() = %v, %v, want nothing pruned", tags, err)
+	}
+
+	if _, err := repo.FetchPruneTags(ctx, testRepo, "missing"); !errors.IsError(err, errors.ErrFailedToPruneTags) {
+		t.Errorf("FetchPruneTags() of an unknown remote error = %v, want ErrFailedToPruneTags", err)
+	}
+}
+
+func TestRepository_BranchCleanupHelpers(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	_, clone := setupPullFixture(t)
+	runGit(t, clone, "branch", "merged")
+	runGit(t, clone, "checkout", "-q", "-b", "unmerged")
+	writeFile(t, filepath.Join(clone, "other.txt"), "other\n")
+	runGit(t, clone, "add", "other.txt")
+	runGit(t, clone, "commit", "-q", "-m", "unmerged work")
+
+	repo := &Repository{}
+	ctx := context.Background()
+	testRepo := &entities.Repository{Name: "clone", Path: clone}
+
+	defaultBranch, err := repo.GetDefaultBranch(ctx, testRepo)
+	if err != nil {
+		t.Fatalf("GetDefaultBranch() unexpected error: %v", err)
+	}
+	if defaultBranch != "main" && defaultBranch != "master" {
+		t.Fatalf("GetDefaultBranch() = %q, want the clone's default branch", defaultBranch)
+	}
+
+	merged, err := repo.GetMergedBranches(ctx, testRepo, defaultBranch)
+	if err != nil {
+		t.Fatalf("GetMergedBranches() unexpected error: %v", err)
+	}
+	if strings.Join(merged, ",") != strings.Join([]string{defaultBranch, "merged"}, ",") {
+		t.Errorf("GetMergedBranches() = %v, want [%s merged]", merged, defaultBranch)
+	}
+
+	if err := repo.DeleteBranch(ctx, testRepo, "merged"); err != nil {
+		t.Fatalf("DeleteBranch() unexpected error: %v", err)
+	}
+	if merged, _ = repo.GetMergedBranches(ctx, testRepo, defaultBranch); len(merged) != 1 {
+		t.Errorf("GetMergedBranches() after deleting = %v, want only the default branch", merged)
+	}
+
+	// The checked out branch cannot be deleted
+	if err := repo.DeleteBranch(ctx, testRepo, "unmerged"); !errors.IsError(err, errors.ErrFailedToDeleteBranch) {
+		t.Errorf("DeleteBranch() of the current branch error = %v, want ErrFailedToDeleteBranch", err)
+	}
+
+	if _, err := repo.GetDefaultBranch(ctx, &entities.Repository{Name: "plain", Path: t.TempDir()}); !errors.IsError(err, errors.ErrDefaultBranchNotFound) {
+		t.Errorf("GetDefaultBranch() outside a repository error = %v, want ErrDefaultBranchNotFound", err)
+	}
+}
+
+func TestRepository_SetRemoteURL(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	_, clone := setupPullFixture(t)
+	repo := &Repository{}
+	ctx := context.Background()
+	testRepo := &entities.Repository{Name: "clone", Path: clone}
+
+	if err := repo.SetRemoteURL(ctx, testRepo, "origin", "git@git.example.com:team/clone.git"); err != nil {
+		t.Fatalf("SetRemoteURL() unexpected error: %v", err)
+	}
+	if url, err := repo.GetRemoteURL(ctx, testRepo, "origin"); err != nil || url != "git@git.example.com:team/clone.git" {
+		t.Errorf("GetRemoteURL() = %q, %v, want the new URL", url, err)
+	}
+
+	if err := repo.SetRemoteURL(ctx, testRepo, "missing", "git@git.example.com:team/clone.git"); !errors.IsError(err, errors.ErrFailedToSetRemoteURL) {
+		t.Errorf("SetRemoteURL() of an unknown remote error = %v, want ErrFailedToSetRemoteURL", err)
+	}
+}
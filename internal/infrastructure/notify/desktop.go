@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// DesktopNotifier shows notifications with the notification tool of the platform
+type DesktopNotifier struct {
+	goos     string
+	lookPath func(file string) (string, error)
+	run      func(ctx context.Context, name string, args ...string) error
+}
+
+// NewDesktopNotifier creates a notifier for the current platform
+func NewDesktopNotifier() output.NotifierPort {
+	return &DesktopNotifier{
+		goos:     runtime.GOOS,
+		lookPath: exec.LookPath,
+		run: func(ctx context.Context, name string, args ...string) error {
+			return exec.CommandContext(ctx, name, args...).Run()
+		},
+	}
+}
+
+// Notify shows the notification, returning ErrNotifierUnavailable when the
+// platform tool is not installed
+func (n *DesktopNotifier) Notify(ctx context.Context, title, message string) error {
+	name, args, ok := notifierCommand(n.goos, title, message)
+	if !ok {
+		return errors.ErrNotifierUnavailable
+	}
+
+	if _, err := n.lookPath(name); err != nil {
+		return errors.ErrNotifierUnavailable
+	}
+
+	return n.run(ctx, name, args...)
+}
+
+// notifierCommand returns the command showing a notification on the given platform:
+// terminal-notifier on macOS, notify-send on Linux and a PowerShell balloon on Windows
+func notifierCommand(goos, title, message string) (string, []string, bool) {
+	switch goos {
+	case "darwin":
+		return "terminal-notifier", []string{"-title", title, "-message", message, "-group", "git-fleet"}, true
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return "notify-send", []string{"--app-name=GitFleet", title, message}, true
+	case "windows":
+		script := "Add-Type -AssemblyName System.Windows.Forms; " +
+			"$n = New-Object System.Windows.Forms.NotifyIcon; " +
+			"$n.Icon = [System.Drawing.SystemIcons]::Information; $n.Visible = $true; " +
+			"$n.ShowBalloonTip(5000, " + powerShellQuote(title) + ", " + powerShellQuote(message) + ", 'Info'); " +
+			"Start-Sleep -Seconds 5; $n.Dispose()"
+		return "powershell", []string{"-NoProfile", "-NonInteractive", "-Command", script}, true
+	default:
+		return "", nil, false
+	}
+}
+
+// powerShellQuote quotes a value as a PowerShell single-quoted string
+func powerShellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestNotifierCommand(t *testing.T) {
+	tests := []struct {
+		goos     string
+		expected string
+		ok       bool
+	}{
+		{goos: "darwin", expected: "terminal-notifier", ok: true},
+		{goos: "linux", expected: "notify-send", ok: true},
+		{goos: "windows", expected: "powershell", ok: true},
+		{goos: "plan9", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goos, func(t *testing.T) {
+			name, args, ok := notifierCommand(tt.goos, "GitFleet: pull on @api", "✅ 2 succeeded, ❌ 0 failed")
+			if ok != tt.ok || name != tt.expected {
+				t.Fatalf("notifierCommand(%q) = %q, %t, want %q, %t", tt.goos, name, ok, tt.expected, tt.ok)
+			}
+			if ok && !strings.Contains(strings.Join(args, " "), "✅ 2 succeeded") {
+				t.Errorf("notifierCommand(%q) args %v should contain the message", tt.goos, args)
+			}
+		})
+	}
+}
+
+func TestPowerShellQuote(t *testing.T) {
+	if got := powerShellQuote("it's done"); got != "'it''s done'" {
+		t.Errorf("powerShellQuote() = %s, want %s", got, "'it''s done'")
+	}
+}
+
+func TestDesktopNotifier_Notify(t *testing.T) {
+	var ran []string
+	notifier := &DesktopNotifier{
+		goos:     "linux",
+		lookPath: func(file string) (string, error) { return "/usr/bin/" + file, nil },
+		run: func(ctx context.Context, name string, args ...string) error {
+			ran = append([]string{name}, args...)
+			return nil
+		},
+	}
+
+	if err := notifier.Notify(context.Background(), "title", "message"); err != nil {
+		t.Fatalf("Notify() unexpected error: %v", err)
+	}
+	if len(ran) == 0 || ran[0] != "notify-send" {
+		t.Errorf("Notify() ran %v, want notify-send", ran)
+	}
+}
+
+func TestDesktopNotifier_NotifyUnavailable(t *testing.T) {
+	notifier := &DesktopNotifier{
+		goos:     "linux",
+		lookPath: func(file string) (string, error) { return "", exec.ErrNotFound },
+		run: func(ctx context.Context, name string, args ...string) error {
+			t.Error("Notify() should not run a missing notifier")
+			return nil
+		},
+	}
+
+	if err := notifier.Notify(context.Background(), "title", "message"); !errors.IsError(err, errors.ErrNotifierUnavailable) {
+		t.Errorf("Notify() error = %v, want %v", err, errors.ErrNotifierUnavailable)
+	}
+}
@@ -0,0 +1,93 @@
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// FileName is the name of the state file, kept next to the configuration
+const FileName = ".gfstate.json"
+
+// Repository implements the StateRepository interface with a JSON file
+type Repository struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewRepository creates a state repository reading and writing the file at the given path
+func NewRepository(path string) repositories.StateRepository {
+	return &Repository{path: path}
+}
+
+// storedState is the stored form of the state
+type storedState struct {
+	LastOperations map[string]*entities.LastOperation `json:"last_operations"`
+}
+
+// LastOperations returns the last operation run in each repository. A missing state
+// file has no operations.
+func (r *Repository) LastOperations(ctx context.Context) (map[string]*entities.LastOperation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	return state.LastOperations, nil
+}
+
+// RecordOperations stores the last operation of the given repositories, keeping those
+// of the other repositories
+func (r *Repository) RecordOperations(ctx context.Context, operations map[string]*entities.LastOperation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state, err := r.load()
+	if err != nil {
+		return err
+	}
+	for name, operation := range operations {
+		state.LastOperations[name] = operation
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToWriteState, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToWriteState, err)
+	}
+	if err := os.WriteFile(r.path, data, 0644); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToWriteState, err)
+	}
+	return nil
+}
+
+// load reads the state file, returning an empty state when it does not exist yet
+func (r *Repository) load() (*storedState, error) {
+	state := &storedState{LastOperations: make(map[string]*entities.LastOperation)}
+
+	data, err := os.ReadFile(r.path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToReadState, err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.WrapRepositoryOperationError(errors.ErrFailedToReadState, err)
+	}
+	if state.LastOperations == nil {
+		state.LastOperations = make(map[string]*entities.LastOperation)
+	}
+	return state, nil
+}
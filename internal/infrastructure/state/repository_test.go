@@ -0,0 +1,60 @@
+package state
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+func TestRepository_RecordOperations(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "nested", FileName)
+	repo := NewRepository(path)
+
+	operations, err := repo.LastOperations(ctx)
+	if err != nil || len(operations) != 0 {
+		t.Fatalf("LastOperations() without a state file = %v, %v, want no operations", operations, err)
+	}
+
+	at := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	if err := repo.RecordOperations(ctx, map[string]*entities.LastOperation{
+		"api": {Command: "git pull", Time: at, Status: entities.ExecutionStatusSuccess},
+		"web": {Command: "git pull", Time: at, Status: entities.ExecutionStatusFailed},
+	}); err != nil {
+		t.Fatalf("RecordOperations() error = %v", err)
+	}
+
+	// Recording again only replaces the given repositories
+	later := at.Add(time.Hour)
+	if err := repo.RecordOperations(ctx, map[string]*entities.LastOperation{
+		"web": {Command: "git fetch", Time: later, Status: entities.ExecutionStatusSuccess},
+	}); err != nil {
+		t.Fatalf("RecordOperations() error = %v", err)
+	}
+
+	operations, err = NewRepository(path).LastOperations(ctx)
+	if err != nil {
+		t.Fatalf("LastOperations() error = %v", err)
+	}
+	if len(operations) != 2 || operations["api"].Command != "git pull" || !operations["api"].Time.Equal(at) {
+		t.Errorf("LastOperations()[api] = %+v, want the first pull", operations["api"])
+	}
+	if operations["web"].Command != "git fetch" || !operations["web"].Time.Equal(later) {
+		t.Errorf("LastOperations()[web] = %+v, want the later fetch", operations["web"])
+	}
+}
+
+func TestRepository_LastOperations_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := os.WriteFile(path, []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewRepository(path).LastOperations(context.Background()); err == nil {
+		t.Error("LastOperations() with an invalid state file did not return an error")
+	}
+}
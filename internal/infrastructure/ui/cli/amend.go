@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// parseAmendArgs parses the arguments of amend: an optional -m/--message and --force
+func parseAmendArgs(args []string) (*usecases.AmendInput, error) {
+	input := &usecases.AmendInput{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+
+		switch {
+		case arg == "--force":
+			input.Force = true
+		case name == "-m" || name == "--message":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, err
+			}
+			if v == "" {
+				return nil, errors.ErrUsageAmend
+			}
+			input.Message = v
+			i = next
+		default:
+			return nil, errors.ErrUsageAmend
+		}
+	}
+
+	return input, nil
+}
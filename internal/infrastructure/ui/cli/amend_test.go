@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseAmendArgs(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		expectedMessage string
+		expectedForce   bool
+		expectedErr     error
+	}{
+		{name: "no arguments", args: []string{}},
+		{name: "message and force", args: []string{"-m", "Fix typo", "--force"}, expectedMessage: "Fix typo", expectedForce: true},
+		{name: "message with equals", args: []string{"--message=Fix typo"}, expectedMessage: "Fix typo"},
+		{name: "empty message", args: []string{"--message="}, expectedErr: errors.ErrUsageAmend},
+		{name: "unknown option", args: []string{"--no-verify"}, expectedErr: errors.ErrUsageAmend},
+		{name: "message without value", args: []string{"-m"}, expectedErr: errors.ErrFlagRequiresValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := parseAmendArgs(tt.args)
+			if tt.expectedErr != nil {
+				if !errors.IsError(err, tt.expectedErr) {
+					t.Errorf("parseAmendArgs() error = %v, want %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseAmendArgs() unexpected error: %v", err)
+			}
+
+			if input.Message != tt.expectedMessage || input.Force != tt.expectedForce {
+				t.Errorf("parseAmendArgs() = %+v, want message %q and force %v", input, tt.expectedMessage, tt.expectedForce)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// isApplyBuiltIn reports whether the arguments following apply are those of the
+// built-in: a patch file and an optional --3way. git apply given any other option, or
+// several patches, runs as a git command.
+func isApplyBuiltIn(args []string) bool {
+	patches := 0
+	for _, arg := range args {
+		switch {
+		case arg == "--3way":
+		case strings.HasPrefix(arg, "-"):
+			return false
+		default:
+			patches++
+		}
+	}
+	return patches == 1
+}
+
+// parseApplyArgs parses the arguments of the apply built-in: the patch file and an
+// optional --3way
+func parseApplyArgs(args []string) (*usecases.ApplyPatchInput, error) {
+	input := &usecases.ApplyPatchInput{}
+
+	for _, arg := range args {
+		switch {
+		case arg == "--3way":
+			input.ThreeWay = true
+		case input.PatchPath == "" && !strings.HasPrefix(arg, "-"):
+			input.PatchPath = arg
+		default:
+			return nil, errors.ErrUsageApply
+		}
+	}
+
+	if input.PatchPath == "" {
+		return nil, errors.ErrUsageApply
+	}
+
+	return input, nil
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestIsApplyBuiltIn(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"ci.patch"}, true},
+		{[]string{"--3way", "ci.patch"}, true},
+		{[]string{}, false},
+		{[]string{"--3way"}, false},
+		{[]string{"--stat", "ci.patch"}, false},
+		{[]string{"one.patch", "two.patch"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := isApplyBuiltIn(tt.args); got != tt.want {
+			t.Errorf("isApplyBuiltIn(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestParseApplyArgs(t *testing.T) {
+	input, err := parseApplyArgs([]string{"ci.patch", "--3way"})
+	if err != nil {
+		t.Fatalf("parseApplyArgs() error = %v", err)
+	}
+	if input.PatchPath != "ci.patch" || !input.ThreeWay {
+		t.Errorf("parseApplyArgs() = %+v, want ci.patch with --3way", input)
+	}
+
+	for _, args := range [][]string{{}, {"--3way"}, {"one.patch", "two.patch"}, {"ci.patch", "--stat"}} {
+		if _, err := parseApplyArgs(args); !errors.IsError(err, errors.ErrUsageApply) {
+			t.Errorf("parseApplyArgs(%v) error = %v, want ErrUsageApply", args, err)
+		}
+	}
+}
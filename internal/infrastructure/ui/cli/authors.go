@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// parseAuthorsArgs returns the --since date given to the authors built-in, if any
+func parseAuthorsArgs(args []string) (string, error) {
+	since := ""
+	for i := 0; i < len(args); i++ {
+		name, value, hasValue := strings.Cut(args[i], "=")
+		if name != "--since" || since != "" {
+			return "", errors.ErrUsageAuthors
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return "", errors.ErrUsageAuthors
+			}
+			i++
+			value = args[i]
+		}
+		if value == "" {
+			return "", errors.ErrUsageAuthors
+		}
+		since = value
+	}
+
+	return since, nil
+}
+
+// formatAuthors renders the authors of the report ranked by commits as a table, followed
+// by the repositories whose log could not be read
+func formatAuthors(stylesService styles.Service, report *usecases.AuthorsReport, since string) string {
+	var result bytes.Buffer
+
+	title := "👥 Authors"
+	if since != "" {
+		title += " since " + since
+	}
+	result.WriteString(stylesService.GetTitleStyle().Render(title) + "\n\n")
+
+	if len(report.Authors) == 0 {
+		result.WriteString("No commits found\n")
+	} else {
+		headers := []string{"#", "Author", "Commits", "Repositories"}
+		rows := make([][]string, 0, len(report.Authors))
+		for i, author := range report.Authors {
+			rows = append(rows, []string{
+				strconv.Itoa(i + 1),
+				author.Author,
+				strconv.Itoa(author.Commits),
+				strconv.Itoa(author.Repositories),
+			})
+		}
+		result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	}
+
+	if len(report.Failed) > 0 {
+		result.WriteString("\n⚠️ Could not read the log of: " + strings.Join(report.Failed, ", ") + "\n")
+	}
+
+	return result.String()
+}
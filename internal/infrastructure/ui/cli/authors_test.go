@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseAuthorsArgs(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected string
+		wantErr  bool
+	}{
+		{args: nil},
+		{args: []string{"--since", "2.weeks"}, expected: "2.weeks"},
+		{args: []string{"--since=2024-01-01"}, expected: "2024-01-01"},
+		{args: []string{"--since"}, wantErr: true},
+		{args: []string{"--until", "monday"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		since, err := parseAuthorsArgs(tt.args)
+		if tt.wantErr {
+			if !errors.IsError(err, errors.ErrUsageAuthors) {
+				t.Errorf("parseAuthorsArgs(%v) error = %v, want %v", tt.args, err, errors.ErrUsageAuthors)
+			}
+			continue
+		}
+		if err != nil || since != tt.expected {
+			t.Errorf("parseAuthorsArgs(%v) = %q, %v, want %q", tt.args, since, err, tt.expected)
+		}
+	}
+}
+
+func TestFormatAuthors(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	report := &usecases.AuthorsReport{
+		Authors: []*usecases.AuthorContribution{
+			{Author: "Ada", Commits: 7, Repositories: 2},
+			{Author: "Bob", Commits: 5, Repositories: 1},
+		},
+		Repositories: 3,
+		Failed:       []string{"broken"},
+	}
+
+	output := formatAuthors(stylesService, report, "2.weeks")
+
+	for _, want := range []string{"Authors since 2.weeks", "Ada", "7", "Bob", "5", "Could not read the log of: broken"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatAuthors() should contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Index(output, "Ada") > strings.Index(output, "Bob") {
+		t.Errorf("formatAuthors() should rank Ada first, got:\n%s", output)
+	}
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// formatBackupList renders the backups as a numbered list, newest first
+func formatBackupList(backups []string) string {
+	var b strings.Builder
+	b.WriteString("Available configuration backups (newest first):\n")
+	for i, name := range backups {
+		fmt.Fprintf(&b, "  %2d) %s\n", i+1, name)
+	}
+	return b.String()
+}
+
+// promptBackup lists the backups and reads the user's choice; an empty answer
+// or a closed input returns an empty choice
+func promptBackup(in io.Reader, out io.Writer, backups []string) (string, error) {
+	fmt.Fprint(out, formatBackupList(backups))
+	fmt.Fprintf(out, "Restore which backup? [1-%d, empty to cancel]: ", len(backups))
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	return strings.TrimSpace(answer), nil
+}
+
+// selectBackup resolves a choice, given as a list number or a backup name, to a backup name
+func selectBackup(choice string, backups []string) (string, error) {
+	if index, err := strconv.Atoi(choice); err == nil {
+		if index < 1 || index > len(backups) {
+			return "", errors.WrapBackupNotFound(choice)
+		}
+		return backups[index-1], nil
+	}
+
+	for _, name := range backups {
+		if name == choice {
+			return name, nil
+		}
+	}
+
+	return "", errors.WrapBackupNotFound(choice)
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestSelectBackup(t *testing.T) {
+	backups := []string{"b.bak", "a.bak"}
+
+	tests := []struct {
+		name     string
+		choice   string
+		expected string
+		wantErr  bool
+	}{
+		{"by number", "2", "a.bak", false},
+		{"by name", "b.bak", "b.bak", false},
+		{"number out of range", "3", "", true},
+		{"zero", "0", "", true},
+		{"unknown name", "c.bak", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := selectBackup(tt.choice, backups)
+			if tt.wantErr {
+				if !errors.IsError(err, errors.ErrBackupNotFound) {
+					t.Errorf("Expected ErrBackupNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if name != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, name)
+			}
+		})
+	}
+}
+
+func TestPromptBackup(t *testing.T) {
+	var out bytes.Buffer
+	choice, err := promptBackup(strings.NewReader(" 1 \n"), &out, []string{"b.bak", "a.bak"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if choice != "1" {
+		t.Errorf("Expected choice 1, got %q", choice)
+	}
+
+	output := out.String()
+	for _, want := range []string{" 1) b.bak", " 2) a.bak", "[1-2, empty to cancel]"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected prompt to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	choice, err = promptBackup(strings.NewReader(""), &out, []string{"a.bak"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if choice != "" {
+		t.Errorf("Expected an empty choice on closed input, got %q", choice)
+	}
+}
@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
@@ -14,6 +16,8 @@ import (
 type BasicHandler struct {
 	stylesService styles.Service
 	hasHandled    bool
+	httpClient    *http.Client
+	releaseURL    string
 }
 
 // NewBasicHandler creates a new CLI handler
@@ -22,6 +26,8 @@ func NewBasicHandler(
 ) *BasicHandler {
 	return &BasicHandler{
 		stylesService: stylesService,
+		httpClient:    http.DefaultClient,
+		releaseURL:    version.LatestReleaseURL,
 	}
 }
 
@@ -54,7 +60,16 @@ func (h *BasicHandler) Execute(ctx context.Context, args []string) error {
 		return h.showHelp(ctx)
 	case "version":
 		h.hasHandled = true
-		return h.showVersion(ctx)
+		if err := h.showVersion(ctx); err != nil {
+			return err
+		}
+		if slices.Contains(command.Args, "--check") {
+			return h.showUpdateCheck(ctx, ScanFlags(args[1:]).NoUpdateCheck)
+		}
+		return nil
+	case "shell-init":
+		h.hasHandled = true
+		return h.showShellInit(ctx, command.Args)
 	default:
 		return nil // For now, we don't handle other commands in BasicHandler
 	}
@@ -65,7 +80,7 @@ func (h *BasicHandler) parseCommand(args []string) (*Command, error) {
 	// Filter out verbose/debug flags from arguments
 	filteredArgs := make([]string, 0, len(args))
 	for _, arg := range args {
-		if arg != "-v" && arg != "--verbose" && arg != "-d" && arg != "--debug" {
+		if arg != "-v" && arg != "--verbose" && arg != "-d" && arg != "--debug" && arg != "--no-update-check" {
 			filteredArgs = append(filteredArgs, arg)
 		}
 	}
@@ -86,12 +101,37 @@ func (h *BasicHandler) parseCommand(args []string) (*Command, error) {
 		return cmd, nil
 	case "version", "--version":
 		cmd.Type = "version"
+		cmd.Args = filteredArgs[1:]
+		return cmd, nil
+	case "shell-init":
+		cmd.Type = "shell-init"
+		cmd.Args = filteredArgs[1:]
 		return cmd, nil
 	default:
 		return nil, nil // No global command matched
 	}
 }
 
+// showShellInit prints the shell function integrating goto with cd
+func (h *BasicHandler) showShellInit(ctx context.Context, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return errors.ErrUsageShellInit
+	}
+
+	functionName := ""
+	if len(args) == 2 {
+		functionName = args[1]
+	}
+
+	script, err := shellInitScript(args[0], functionName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(script)
+	return nil
+}
+
 // showHelp shows help information
 func (h *BasicHandler) showHelp(ctx context.Context) error {
 	// Get styles service
@@ -107,6 +147,7 @@ func (h *BasicHandler) showHelp(ctx context.Context) error {
 	usageData := [][]string{
 		{"gf", "Interactive group selection"},
 		{"gf @<group1> [@group2] <command>", "Execute command on groups (@ prefix required)"},
+		{"gf @<group>[start:end] <command>", "Execute command on part of a group, e.g. @all[0:10]"},
 		{"gf <group> <command>", "Execute command on single group (legacy)"},
 		{"gf <command>", "Execute global command"},
 	}
@@ -120,9 +161,18 @@ func (h *BasicHandler) showHelp(ctx context.Context) error {
 		{"config, -c, --config", "⚙️ Show configuration info"},
 		{"config validate", "✔️ Validate configuration file"},
 		{"config init", "🆕 Create default configuration"},
+		{"config backup", "💾 Back up the configuration file"},
+		{"config restore [n|name]", "⏪ Restore a configuration backup, picking from a list"},
+		{"groups", "🏷️ List groups with their number of repositories"},
 		{"goto <repository>", "📂 Get path to repository (for shell integration)"},
+		{"goto -e <regexp>", "🎯 Get path to the only repository matching a regular expression"},
+		{"shell-init <shell> [name]", "🐚 Print a shell function (default gfcd) to cd into repositories"},
+		{"resolve @<group>...", "🎯 List the repositories selected by groups, one per line"},
+		{"export mr", "📤 Print a myrepos (.mrconfig) configuration"},
+		{"rerun --report <file>", "🔁 Re-run the command on repositories that failed in a report"},
 		{"help, -h, --help", "📚 Show this help message"},
 		{"version, --version", "📦 Show version information"},
+		{"version --check", "⬆️ Check GitHub for a newer release"},
 	}
 	globalHeaders := []string{"Command", "Description"}
 	result.WriteString(styles.CreateResponsiveTable(globalHeaders, globalData) + "\n")
@@ -131,6 +181,50 @@ func (h *BasicHandler) showHelp(ctx context.Context) error {
 	result.WriteString(styles.GetSectionStyle().Render("🏳️ FLAGS:") + "\n")
 	flagsData := [][]string{
 		{"-v, --verbose, -d, --debug", "🔍 Enable verbose/debug logging"},
+		{"--config <path>", "🗂️ Use the configuration file at path instead of the default one"},
+		{"--log-level <level>", "📶 Log level: trace, debug, info, warn, error"},
+		{"--yes", "✅ Run dangerous commands such as reset --hard without confirmation"},
+		{"--force-unsafe", "☢️ Also run in repositories at a filesystem root, the home directory or outside git, after confirmation"},
+		{"--sort <key>", "🔢 Sort status by name, dirty, branch, ahead or the configured order; config show by name, path or group-count"},
+		{"--group-by tag", "🏷️ Show status in one section per repository tag"},
+		{"--format <table|compact>", "📱 Status layout; compact prints one line per repository (default on narrow terminals)"},
+		{"--path-style <style>", "🗂️ Status paths: abbrev (~ for home, default), relative to the current directory, full or none"},
+		{"--filter status=<status>", "🔎 Only show repositories in a status: clean, modified, warning or error"},
+		{"--json", "🧾 Print status as JSON"},
+		{"--with-commit", "📝 Add each repository's last commit to status --json"},
+		{"--last-op", "🕒 Show the last command gf ran in each repository with status"},
+		{"--notify", "🔔 Send a desktop notification with the results when the command ends"},
+		{"--dedupe-output", "🧺 Show each command output once, with the repositories sharing it"},
+		{"--matrix", "🧮 End with a pass/fail grid of the repositories and their exit codes"},
+		{"--order-output name", "🔤 Show the results of the repositories sorted by name rather than as they complete"},
+		{"--summary-only", "📋 Print only the final statistics, exiting non-zero on failures"},
+		{"--max-size <size>", "📏 Size threshold for precommit-check, e.g. 500K or 10M"},
+		{"--repo-order <names>", "🔢 Run the comma-separated repositories first, in that order"},
+		{"--env-file <file>", "🌱 Add the KEY=VALUE lines of a .env file to the command environment"},
+		{"--report <file>", "📝 Write the execution results to a JSON report"},
+		{"--on-branch <name>", "🌱 Only run in repositories currently on the branch, skipping the others"},
+		{"--fail-fast", "🛑 Cancel the running and remaining repositories on the first failure"},
+		{"--retry[=<n>]", "🔁 Retry failed commands up to n times (default 2), only on transient errors"},
+		{"--retry-on <codes>", "🎯 Error codes to retry, e.g. auth,timeout,network (implies --retry)"},
+		{"--git-jobs <n>", "🧵 Add --jobs=n to fetch, pull and clone so git parallelizes inside each repository"},
+		{"--report-conflicts", "🔀 With pull: fast-forward only, then list the diverged repositories with ahead/behind counts"},
+		{"--interactive-commit", "📝 Ask once for the message of a commit without -m; repositories with nothing staged are skipped"},
+		{"--on-dirty <policy>", "🧹 With pull, checkout or switch: fail (default), skip or stash around repositories with uncommitted changes"},
+		{"--skip-locked", "🔒 Skip repositories locked by another git process instead of failing"},
+		{"--workdir <dir>", "📂 Run the command in a subdirectory of each repository, skipping those without it"},
+		{"--git-only", "💾 Report only the .git directory sizes with size"},
+		{"--log-dir <dir>", "🗂️ Write the full output of each repository to <dir>/<repo>.log"},
+		{"--no-update-check", "📴 Never query GitHub, even with version --check"},
+		{"--no-discover", "🔭 Skip the auto_discover run on startup"},
+		{"--select", "☑️ Pick the repositories of the groups to run the command in"},
+		{"--dirty", "✏️ Before the command: run it only in repositories with uncommitted changes, checking them all without a group"},
+		{"--name-only", "🎯 Before the command: list the selected repositories instead of running it"},
+		{"--copy[=styled]", "📋 Also copy the output to the clipboard, as plain text unless styled"},
+		{"--pager", "📜 Show the output in $PAGER (less -FRX by default) on a terminal; --no-pager turns it off"},
+		{"--no-color", "⬜ Print the output without colors and never page it, like NO_COLOR"},
+		{"--events jsonl", "📡 Stream execution events as JSON lines on stdout, other output going to stderr"},
+		{"--border <style>", "🔲 Table border style: none, normal, rounded, thick"},
+		{"-- <command...>", "⏩ Run the following arguments as given, never reading them as gf flags or built-ins"},
 	}
 	flagsHeaders := []string{"Flag", "Description"}
 	result.WriteString(styles.CreateResponsiveTable(flagsHeaders, flagsData) + "\n")
@@ -150,8 +244,33 @@ func (h *BasicHandler) showHelp(ctx context.Context) error {
 	result.WriteString(styles.GetSectionStyle().Render("🎯 GROUP COMMANDS:") + "\n")
 	groupData := [][]string{
 		{"status, ls", "📊 Show git status for group repositories"},
+		{"diffstat", "📈 Show insertions and deletions for group repositories"},
+		{"diverged", "🔀 List repositories both ahead and behind their upstream, suggesting a rebase or merge"},
+		{"remotes", "🌐 List the remotes and URLs of each repository, flagging those without remotes or on another host"},
+		{"size", "💾 Show working tree and .git disk usage, largest first (--git-only)"},
+		{"ls-files", "🗂️ Count the files tracked in each repository, largest first"},
+		{"authors [--since <date>]", "👥 Rank the authors of all the repositories by commits on their current branch"},
+		{"submodule status", "🧩 Count the submodules of each repository that are up to date, out of date or uninitialized"},
+		{"submodule update", "🧩 Initialize and update submodules recursively, skipping repositories without .gitmodules"},
+		{"\"<git command> && status\"", "🔗 Run status, ls, diffstat or ls-files on the same repositories once the git command completes"},
+		{"precommit-check", "🛡️ Report untracked files above --max-size (default 5MB)"},
+		{"remote-prune", "🧹 Prune stale remote-tracking branches of each repository's remote"},
+		{"fetch --prune-tags", "🏷️ Fetch with pruning and report the local tags deleted on the remote"},
+		{"branch-cleanup", "🌿 Delete local branches merged into the default branch (--dry-run to list them)"},
+		{"clean", "🧽 List the untracked files git clean -fd would remove; --force removes them after confirmation"},
+		{"reset-to-upstream", "⏪ Fetch and hard-reset to upstream, skipping repositories with local work (--force)"},
+		{"rebase-onto <branch>", "🔀 Fetch and rebase onto the branch; conflicts are left to resolve (--abort-on-conflict)"},
+		{"compare-branch <branch>", "🔀 Count the commits of the current branch ahead and behind another branch"},
+		{"amend", "✏️ Amend the last commit with staged changes; pushed commits need --force (-m <message>)"},
+		{"worktree-add <branch>", "🌳 Add a worktree of the branch in each repository (path from worktree_path)"},
+		{"switch-remote <remote> <url>", "🔀 Set the URL of a remote, {repo} taken from its old URL (--dry-run to preview)"},
+		{"grep <pattern>", "🔎 Search the repositories with git grep (--files-only to list matching files)"},
+		{"apply <patch-file>", "🩹 Apply a patch, skipping repositories where git apply --check fails (--3way to merge)"},
+		{"count <pattern>", "🔢 Count the lines matching a pattern in each repository, with the total"},
+		{"tag-release <version>", "🏷️ Create an annotated tag and push it to origin (-m <message>, --no-push)"},
 		{"pull, pl", "🔄 Pull latest changes for group repositories"},
 		{"fetch, fa", "📡 Fetch all remotes for group repositories"},
+		{"run-in-order <git-cmd>", "🪜 Execute a git command following repository dependencies"},
 		{"<git-cmd>", "🔧 Execute any git command on group"},
 	}
 	groupHeaders := []string{"Command", "Description"}
@@ -168,8 +287,13 @@ func (h *BasicHandler) showHelp(ctx context.Context) error {
 		{"gf @frontend @backend pull", "Pull latest for multiple groups"},
 		{"gf @api status", "Status for api group"},
 		{"gf -v @api \"commit -m 'fix'\"", "Commit with verbose logging to api group"},
+		{"gf @frontend --name-only", "List repositories in the frontend group"},
+		{"gf @all pull --report out.json", "Pull and save results to out.json"},
+		{"gf rerun --report out.json", "Re-run pull on repositories that failed"},
 		{"cd $(gf goto myrepo)", "Change to 'myrepo' directory"},
+		{"eval \"$(gf shell-init zsh)\"", "Enable 'gfcd myrepo' in zsh"},
 		{"gf config", "Show current configuration"},
+		{"gf config show --json", "Print the resolved configuration as JSON"},
 	}
 	exampleHeaders := []string{"Command", "Description"}
 	result.WriteString(styles.CreateResponsiveTable(exampleHeaders, exampleData) + "\n")
@@ -180,6 +304,7 @@ func (h *BasicHandler) showHelp(ctx context.Context) error {
 		{"Location", "~/.config/git-fleet/.gfconfig.json"},
 		{"Format", "JSON with 'repositories' and 'groups' sections"},
 		{"Theme Support", "Add \"theme\": \"dark\" or \"theme\": \"light\""},
+		{"Border Style", "Add \"border_style\": \"none\", \"normal\", \"rounded\" or \"thick\""},
 	}
 	configFileHeaders := []string{"Metric", "Value"}
 	result.WriteString(styles.CreateResponsiveTable(configFileHeaders, configFileData) + "\n")
@@ -240,3 +365,29 @@ func (h *BasicHandler) showVersion(ctx context.Context) error {
 
 	return nil
 }
+
+// showUpdateCheck tells whether a newer release is available. Network failures are
+// reported without failing the command, and noUpdateCheck skips the request entirely.
+func (h *BasicHandler) showUpdateCheck(ctx context.Context, noUpdateCheck bool) error {
+	labelStyle := h.stylesService.GetLabelStyle()
+	highlightStyle := h.stylesService.GetHighlightStyle()
+
+	if noUpdateCheck {
+		fmt.Printf("%s %s\n", labelStyle.Render("Update:"), "check disabled by --no-update-check")
+		return nil
+	}
+
+	check, err := version.CheckForUpdate(ctx, h.httpClient, h.releaseURL)
+	switch {
+	case err != nil:
+		fmt.Printf("%s ⚠️ %v\n", labelStyle.Render("Update:"), err)
+	case check.Available:
+		fmt.Printf("%s ⬆️ %s is available\n", labelStyle.Render("Update:"), highlightStyle.Render(check.Latest))
+	case check.Current == "dev":
+		fmt.Printf("%s 🛠️ development build, the latest release is %s\n", labelStyle.Render("Update:"), highlightStyle.Render(check.Latest))
+	default:
+		fmt.Printf("%s ✅ up to date\n", labelStyle.Render("Update:"))
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -11,6 +13,8 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/version"
 )
 
 // setupMockStylesService creates a mock styles service for testing
@@ -222,6 +226,8 @@ func TestBasicHandler_parseCommand_Version(t *testing.T) {
 	testCases := [][]string{
 		{"version"},
 		{"--version"},
+		{"version", "--check"},
+		{"--no-update-check", "version", "--check"},
 	}
 
 	for _, args := range testCases {
@@ -371,6 +377,53 @@ func TestBasicHandler_showVersion(t *testing.T) {
 	}
 }
 
+func TestBasicHandler_showUpdateCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"tag_name": "v99.0.0"}`))
+	}))
+	defer server.Close()
+
+	original := version.Version
+	version.Version = "v1.0.0"
+	defer func() { version.Version = original }()
+
+	tests := []struct {
+		name          string
+		url           string
+		noUpdateCheck bool
+		expected      string
+	}{
+		{name: "update available", url: server.URL, expected: "v99.0.0 is available"},
+		{name: "disabled", url: server.URL, noUpdateCheck: true, expected: "disabled by --no-update-check"},
+		{name: "offline", url: "http://127.0.0.1:1", expected: "failed to check for updates"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewBasicHandler(setupMockStylesService(t))
+			handler.httpClient = server.Client()
+			handler.releaseURL = tt.url
+
+			old := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			err := handler.showUpdateCheck(context.Background(), tt.noUpdateCheck)
+
+			w.Close()
+			os.Stdout = old
+			out, _ := io.ReadAll(r)
+
+			if err != nil {
+				t.Errorf("showUpdateCheck should not return error, got: %v", err)
+			}
+			if !strings.Contains(string(out), tt.expected) {
+				t.Errorf("showUpdateCheck output should contain %q, got: %s", tt.expected, out)
+			}
+		})
+	}
+}
+
 func TestBasicHandler_Command_Structure(t *testing.T) {
 	stylesService := setupMockStylesService(t)
 	handler := NewBasicHandler(stylesService)
@@ -487,3 +540,29 @@ func TestBasicHandler_EdgeCases(t *testing.T) {
 		t.Error("Handler should have handled the help command with flags")
 	}
 }
+
+func TestBasicHandler_parseCommand_ShellInit(t *testing.T) {
+	stylesService := setupMockStylesService(t)
+	handler := NewBasicHandler(stylesService)
+
+	cmd, err := handler.parseCommand([]string{"shell-init", "zsh", "jump"})
+	if err != nil {
+		t.Fatalf("parseCommand returned error: %v", err)
+	}
+	if cmd.Type != "shell-init" {
+		t.Errorf("expected type 'shell-init', got '%s'", cmd.Type)
+	}
+	if len(cmd.Args) != 2 || cmd.Args[0] != "zsh" || cmd.Args[1] != "jump" {
+		t.Errorf("expected args [zsh jump], got %v", cmd.Args)
+	}
+}
+
+func TestBasicHandler_showShellInit_Usage(t *testing.T) {
+	stylesService := setupMockStylesService(t)
+	handler := NewBasicHandler(stylesService)
+
+	err := handler.showShellInit(context.Background(), []string{})
+	if !errors.IsError(err, errors.ErrUsageShellInit) {
+		t.Errorf("expected ErrUsageShellInit, got %v", err)
+	}
+}
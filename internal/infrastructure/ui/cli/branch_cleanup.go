@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatBranchCleanups renders the merged branches deleted in each repository as a table,
+// or the ones that would be deleted in a dry run
+func formatBranchCleanups(stylesService styles.Service, cleanups []*usecases.RepositoryBranchCleanup, dryRun bool) string {
+	var result bytes.Buffer
+
+	title := "🌿 Branch Cleanup"
+	if dryRun {
+		title += " (dry run)"
+	}
+	result.WriteString(stylesService.GetTitleStyle().Render(title) + "\n\n")
+
+	headers := []string{"Repository", "Default Branch", "Result"}
+	rows := make([][]string, 0, len(cleanups))
+
+	// The branches are listed below the table, where long names are not truncated
+	var details strings.Builder
+	total := 0
+	for _, cleanup := range cleanups {
+		switch {
+		case cleanup.Error != "":
+			rows = append(rows, []string{cleanup.Repository, orDash(cleanup.DefaultBranch), "❌ Error"})
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", cleanup.Repository, cleanup.Error))
+		case len(cleanup.Branches) == 0:
+			rows = append(rows, []string{cleanup.Repository, cleanup.DefaultBranch, "✨ Clean"})
+		case dryRun:
+			total += len(cleanup.Branches)
+			rows = append(rows, []string{cleanup.Repository, cleanup.DefaultBranch, fmt.Sprintf("🔍 %d to delete", len(cleanup.Branches))})
+			details.WriteString(fmt.Sprintf("🔍 %s: %s\n", cleanup.Repository, strings.Join(cleanup.Branches, ", ")))
+		default:
+			total += len(cleanup.Branches)
+			rows = append(rows, []string{cleanup.Repository, cleanup.DefaultBranch, fmt.Sprintf("🗑️ %d deleted", len(cleanup.Branches))})
+			details.WriteString(fmt.Sprintf("🗑️ %s: %s\n", cleanup.Repository, strings.Join(cleanup.Branches, ", ")))
+		}
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(details.String())
+
+	verb := "deleted"
+	if dryRun {
+		verb = "would be deleted"
+	}
+	result.WriteString(fmt.Sprintf("%d merged branches %s in %d repositories\n", total, verb, len(cleanups)))
+	return result.String()
+}
+
+// orDash returns the value, or "-" when it is empty
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatBranchCleanups(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	cleanups := []*usecases.RepositoryBranchCleanup{
+		{Repository: "api", DefaultBranch: "main", Branches: []string{"feature-a", "feature-b"}},
+		{Repository: "web", DefaultBranch: "master", Branches: []string{}},
+		{Repository: "broken", Error: "default branch not found"},
+	}
+
+	output := formatBranchCleanups(stylesService, cleanups, false)
+	for _, want := range []string{"api", "🗑️ 2 deleted", "feature-a, feature-b", "✨ Clean", "❌ Error", "default branch not found", "2 merged branches deleted in 3 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatBranchCleanups() should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output = formatBranchCleanups(stylesService, cleanups, true)
+	for _, want := range []string{"dry run", "🔍 2 to delete", "2 merged branches would be deleted in 3 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatBranchCleanups() in a dry run should contain %q, got:\n%s", want, output)
+		}
+	}
+}
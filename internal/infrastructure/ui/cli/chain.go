@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+// chainableBuiltIns maps the gf built-ins that can follow a git command with && to
+// their command type
+var chainableBuiltIns = map[string]string{
+	"status":   "status",
+	"ls":       "status",
+	"diffstat": "diffstat",
+	"ls-files": "ls-files",
+}
+
+// splitChainedBuiltIn splits "<git command> && <built-in>" into the arguments of the git
+// command and the type of the chainable built-in to run after it. Any other command line,
+// including a shell command or a chain of several commands, is returned unchanged with an
+// empty type so that it is run as given.
+func splitChainedBuiltIn(cmdArgs []string) ([]string, string) {
+	commandLine := strings.Join(cmdArgs, " ")
+	index := strings.LastIndex(commandLine, "&&")
+	if index < 0 {
+		return cmdArgs, ""
+	}
+
+	then, ok := chainableBuiltIns[strings.TrimSpace(commandLine[index+2:])]
+	if !ok {
+		return cmdArgs, ""
+	}
+
+	before := commandLine[:index]
+	if strings.ContainsAny(before, "&|;") {
+		return cmdArgs, ""
+	}
+
+	gitArgs := strings.Fields(before)
+	if len(gitArgs) == 0 || !entities.NewCommand(gitArgs...).IsGitCommand() {
+		return cmdArgs, ""
+	}
+
+	return gitArgs, then
+}
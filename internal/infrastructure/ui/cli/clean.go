@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// cleanOptions are the arguments of the clean built-in. git clean given any other
+// option runs as a git command.
+var cleanOptions = []string{"--dry-run", "--force"}
+
+// isCleanBuiltIn reports whether the arguments following clean are those of the built-in
+func isCleanBuiltIn(args []string) bool {
+	for _, arg := range args {
+		if !slices.Contains(cleanOptions, arg) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseCleanArgs parses the arguments of the clean built-in, which lists the files to
+// remove unless --force is given
+func parseCleanArgs(args []string) (*usecases.CleanInput, error) {
+	request := &usecases.CleanInput{}
+	dryRun := false
+	for _, arg := range args {
+		switch arg {
+		case "--dry-run":
+			dryRun = true
+		case "--force":
+			request.Force = true
+		default:
+			return nil, errors.ErrUsageClean
+		}
+	}
+
+	if dryRun && request.Force {
+		return nil, errors.ErrUsageClean
+	}
+	return request, nil
+}
+
+// formatCleans renders the number of untracked paths removed from each repository as a
+// table, or the ones that would be removed in a dry run, with the paths below it
+func formatCleans(stylesService styles.Service, cleans []*usecases.RepositoryClean, dryRun bool) string {
+	var result bytes.Buffer
+
+	title := "🧽 Clean"
+	if dryRun {
+		title += " (dry run)"
+	}
+	result.WriteString(stylesService.GetTitleStyle().Render(title) + "\n\n")
+
+	headers := []string{"Repository", "Result"}
+	rows := make([][]string, 0, len(cleans))
+
+	// The paths are listed below the table, where long paths are not truncated
+	var details strings.Builder
+	total := 0
+	for _, clean := range cleans {
+		switch {
+		case clean.Error != "":
+			rows = append(rows, []string{clean.Repository, "❌ Error"})
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", clean.Repository, clean.Error))
+		case len(clean.Paths) == 0:
+			rows = append(rows, []string{clean.Repository, "✨ Clean"})
+		case dryRun:
+			total += len(clean.Paths)
+			rows = append(rows, []string{clean.Repository, fmt.Sprintf("🔍 %d to remove", len(clean.Paths))})
+			details.WriteString(fmt.Sprintf("🔍 %s: %s\n", clean.Repository, strings.Join(clean.Paths, ", ")))
+		default:
+			total += len(clean.Paths)
+			rows = append(rows, []string{clean.Repository, fmt.Sprintf("🗑️ %d removed", len(clean.Paths))})
+			details.WriteString(fmt.Sprintf("🗑️ %s: %s\n", clean.Repository, strings.Join(clean.Paths, ", ")))
+		}
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(details.String())
+
+	verb := "removed"
+	if dryRun {
+		verb = "would be removed"
+	}
+	result.WriteString(fmt.Sprintf("%d untracked paths %s in %d repositories\n", total, verb, len(cleans)))
+	if dryRun && total > 0 {
+		result.WriteString("Run clean --force to remove them\n")
+	}
+	return result.String()
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseCleanArgs(t *testing.T) {
+	tests := []struct {
+		args      []string
+		wantForce bool
+		wantErr   bool
+	}{
+		{args: nil},
+		{args: []string{"--dry-run"}},
+		{args: []string{"--force"}, wantForce: true},
+		{args: []string{"--dry-run", "--force"}, wantErr: true},
+		{args: []string{"-x"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		request, err := parseCleanArgs(tt.args)
+		if tt.wantErr {
+			if !errors.IsError(err, errors.ErrUsageClean) {
+				t.Errorf("parseCleanArgs(%v) error = %v, want ErrUsageClean", tt.args, err)
+			}
+			continue
+		}
+		if err != nil || request.Force != tt.wantForce {
+			t.Errorf("parseCleanArgs(%v) = %+v, %v, want force %v", tt.args, request, err, tt.wantForce)
+		}
+	}
+}
+
+func TestFormatCleans(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	cleans := []*usecases.RepositoryClean{
+		{Repository: "api", Paths: []string{"build/", "notes.txt"}},
+		{Repository: "web", Paths: []string{}},
+		{Repository: "broken", Error: "not a git repository"},
+	}
+
+	output := formatCleans(stylesService, cleans, false)
+	for _, want := range []string{"api", "🗑️ 2 removed", "build/, notes.txt", "✨ Clean", "❌ Error", "not a git repository", "2 untracked paths removed in 3 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatCleans() should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output = formatCleans(stylesService, cleans, true)
+	for _, want := range []string{"dry run", "🔍 2 to remove", "2 untracked paths would be removed in 3 repositories", "clean --force"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatCleans() in a dry run should contain %q, got:\n%s", want, output)
+		}
+	}
+}
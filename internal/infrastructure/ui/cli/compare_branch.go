@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// parseCompareBranchArgs returns the branch given to the compare-branch built-in
+func parseCompareBranchArgs(args []string) (string, error) {
+	if len(args) != 1 || args[0] == "" || strings.HasPrefix(args[0], "-") {
+		return "", errors.ErrUsageCompareBranch
+	}
+	return args[0], nil
+}
+
+// formatBranchComparisons renders how many commits the current branch of each repository
+// is ahead and behind of the branch as a table, followed by the errors
+func formatBranchComparisons(stylesService styles.Service, branch string, comparisons []*usecases.BranchComparison) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🔀 Compared with "+branch) + "\n\n")
+
+	headers := []string{"Repository", "Ahead", "Behind"}
+	rows := make([][]string, 0, len(comparisons))
+
+	var details strings.Builder
+	compared, skipped := 0, 0
+	for _, comparison := range comparisons {
+		switch {
+		case comparison.Error != "":
+			rows = append(rows, []string{comparison.Repository, "❌ Error", "-"})
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", comparison.Repository, comparison.Error))
+		case comparison.Skipped != "":
+			skipped++
+			rows = append(rows, []string{comparison.Repository, "⏭️ " + comparison.Skipped, "-"})
+		default:
+			compared++
+			rows = append(rows, []string{comparison.Repository, strconv.Itoa(comparison.Ahead), strconv.Itoa(comparison.Behind)})
+		}
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(details.String())
+	result.WriteString(fmt.Sprintf("%d repositories compared, %d skipped\n", compared, skipped))
+
+	return result.String()
+}
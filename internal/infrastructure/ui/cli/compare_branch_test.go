@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseCompareBranchArgs(t *testing.T) {
+	if branch, err := parseCompareBranchArgs([]string{"origin/main"}); err != nil || branch != "origin/main" {
+		t.Errorf("parseCompareBranchArgs() = %q, %v, want origin/main", branch, err)
+	}
+
+	for _, args := range [][]string{nil, {"main", "develop"}, {"--all"}} {
+		if _, err := parseCompareBranchArgs(args); !errors.IsError(err, errors.ErrUsageCompareBranch) {
+			t.Errorf("parseCompareBranchArgs(%v) error = %v, want %v", args, err, errors.ErrUsageCompareBranch)
+		}
+	}
+}
+
+func TestFormatBranchComparisons(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+
+	output := formatBranchComparisons(stylesService, "main", []*usecases.BranchComparison{
+		{Repository: "api", Ahead: 3, Behind: 2},
+		{Repository: "broken", Error: "failed to compare branch"},
+		{Repository: "legacy", Skipped: usecases.NoSuchBranchReason + " main"},
+	})
+
+	for _, want := range []string{"main", "AHEAD", "BEHIND", "api", "❌ broken: failed to compare branch", "no such branch main", "1 repositories compared, 1 skipped"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatBranchComparisons() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
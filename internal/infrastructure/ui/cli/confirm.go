@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/input"
+)
+
+// TerminalConfirmer asks for confirmation on the terminal
+type TerminalConfirmer struct {
+	in  io.Reader
+	out io.Writer
+}
+
+// NewTerminalConfirmer creates a confirmer reading answers from in and writing prompts to out
+func NewTerminalConfirmer(in io.Reader, out io.Writer) input.ConfirmationPort {
+	return &TerminalConfirmer{
+		in:  in,
+		out: out,
+	}
+}
+
+// Confirm prints the prompt and accepts "y" or "yes"; anything else, including
+// a closed input, declines
+func (c *TerminalConfirmer) Confirm(ctx context.Context, prompt string) (bool, error) {
+	fmt.Fprintf(c.out, "%s\nContinue? [y/N]: ", prompt)
+
+	answer, err := bufio.NewReader(c.in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
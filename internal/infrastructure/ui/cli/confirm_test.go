@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestTerminalConfirmer_Confirm(t *testing.T) {
+	tests := []struct {
+		name     string
+		answer   string
+		expected bool
+	}{
+		{"yes", "yes\n", true},
+		{"short yes", "Y\n", true},
+		{"no", "n\n", false},
+		{"empty answer", "\n", false},
+		{"closed input", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			confirmer := NewTerminalConfirmer(strings.NewReader(tt.answer), &out)
+
+			confirmed, err := confirmer.Confirm(context.Background(), "About to run 'reset --hard' on 3 repositories")
+			if err != nil {
+				t.Fatalf("Confirm() returned error: %v", err)
+			}
+			if confirmed != tt.expected {
+				t.Errorf("Confirm() = %v, want %v", confirmed, tt.expected)
+			}
+			if !strings.Contains(out.String(), "reset --hard") {
+				t.Errorf("prompt should be written, got %q", out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// ansiEscapes matches the terminal escape sequences styling the output
+var ansiEscapes = regexp.MustCompile("\x1b\\[[0-9;?]*[ -/]*[@-~]")
+
+// errOutput returns the writer warnings are written to, defaulting to stderr
+func (h *Handler) errOutput() io.Writer {
+	if h.errOut == nil {
+		return os.Stderr
+	}
+	return h.errOut
+}
+
+// copyOutput copies the output kept for --copy to the clipboard, without its styling in
+// the plain format. A failure to copy is only a warning, as the command has already run.
+func (h *Handler) copyOutput(ctx context.Context, format string) {
+	text := h.copied.String()
+	h.copied = nil
+
+	if format == CopyFormatPlain {
+		text = ansiEscapes.ReplaceAllString(text, "")
+	}
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+
+	err := errors.ErrClipboardUnavailable
+	if h.clipboard != nil {
+		err = h.clipboard.Copy(ctx, text)
+	}
+	if err != nil {
+		fmt.Fprintf(h.errOutput(), "⚠️ Output not copied to the clipboard: %v\n", err)
+	}
+}
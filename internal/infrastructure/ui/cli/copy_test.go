@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+)
+
+func TestHandler_Execute_Copy(t *testing.T) {
+	tests := []struct {
+		name     string
+		flag     string
+		expected string
+	}{
+		{name: "plain", flag: "--copy", expected: "api\n"},
+		{name: "styled", flag: "--copy=styled", expected: "\x1b[1mapi\x1b[0m\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockManageConfigUC := usecases.NewMockManageConfigUCI(ctrl)
+			mockManageConfigUC.EXPECT().GetGroups(gomock.Any()).Return(nil, nil).AnyTimes()
+			mockManageConfigUC.EXPECT().ShowConfig(gomock.Any(), gomock.Any()).Return(&usecases.ShowConfigOutput{FormattedOutput: "\x1b[1mapi\x1b[0m\n"}, nil)
+
+			mockClipboard := output.NewMockClipboardPort(ctrl)
+			mockClipboard.EXPECT().Copy(gomock.Any(), tt.expected).Return(nil)
+
+			handler := &Handler{manageConfigUC: mockManageConfigUC}
+			handler.SetClipboard(mockClipboard)
+			var out bytes.Buffer
+			handler.SetOutput(&out)
+
+			if err := handler.Execute(context.Background(), []string{"gf", "config", "show", tt.flag}); err != nil {
+				t.Fatalf("Execute() returned error: %v", err)
+			}
+			if out.String() != "\x1b[1mapi\x1b[0m\n" {
+				t.Errorf("Execute() should still print the styled output, got %q", out.String())
+			}
+			if handler.out != &out {
+				t.Error("Execute() should restore the output once copied")
+			}
+		})
+	}
+}
+
+func TestHandler_CopyOutput_Unavailable(t *testing.T) {
+	var errOut bytes.Buffer
+	handler := &Handler{errOut: &errOut, copied: bytes.NewBufferString("status table\n")}
+
+	handler.copyOutput(context.Background(), CopyFormatPlain)
+
+	if !strings.Contains(errOut.String(), "not copied to the clipboard") {
+		t.Errorf("copyOutput() should warn when no clipboard is available, got %q", errOut.String())
+	}
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// parseCountArgs parses the arguments of count: exactly one pattern
+func parseCountArgs(args []string) (string, error) {
+	if len(args) != 1 || args[0] == "" {
+		return "", errors.ErrUsageCount
+	}
+	return args[0], nil
+}
+
+// formatCounts renders the number of lines matching the pattern in each repository as
+// a table ending with the grand total. Repositories without matches are shown with 0.
+func formatCounts(stylesService styles.Service, pattern string, counts []*usecases.RepositoryMatchCount) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🔢 Count: "+pattern) + "\n\n")
+
+	headers := []string{"Repository", "Matches"}
+	rows := make([][]string, 0, len(counts)+1)
+
+	var errorDetails bytes.Buffer
+	total := 0
+	for _, count := range counts {
+		if count.Error != "" {
+			rows = append(rows, []string{count.Repository, "❌ Error"})
+			errorDetails.WriteString(fmt.Sprintf("❌ %s: %s\n", count.Repository, count.Error))
+			continue
+		}
+		total += count.Matches
+		rows = append(rows, []string{count.Repository, strconv.Itoa(count.Matches)})
+	}
+	rows = append(rows, []string{"Total", strconv.Itoa(total)})
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(errorDetails.String())
+	return result.String()
+}
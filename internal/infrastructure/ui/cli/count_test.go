@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseCountArgs(t *testing.T) {
+	pattern, err := parseCountArgs([]string{"-TODO"})
+	if err != nil || pattern != "-TODO" {
+		t.Errorf("parseCountArgs() = %q, %v, want -TODO", pattern, err)
+	}
+
+	for _, args := range [][]string{{}, {""}, {"one", "two"}} {
+		if _, err := parseCountArgs(args); !errors.IsError(err, errors.ErrUsageCount) {
+			t.Errorf("parseCountArgs(%v) error = %v, want ErrUsageCount", args, err)
+		}
+	}
+}
+
+func TestFormatCounts(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	counts := []*usecases.RepositoryMatchCount{
+		{Repository: "api", Matches: 12},
+		{Repository: "docs", Matches: 0},
+		{Repository: "web", Matches: 3},
+		{Repository: "broken", Error: "fatal: not a git repository"},
+	}
+
+	output := formatCounts(stylesService, "TODO", counts)
+
+	for _, want := range []string{"Count: TODO", "api", "12", "docs", "0", "Total", "15", "❌ Error", "fatal: not a git repository"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatCounts() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatDiffStats renders the diff statistics of each repository as a table with a total row
+func formatDiffStats(stylesService styles.Service, stats []*usecases.RepositoryDiffStat) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("📈 Diff Stat") + "\n\n")
+
+	headers := []string{"Repository", "Unstaged", "Staged", "Files", "Insertions", "Deletions"}
+	rows := make([][]string, 0, len(stats)+1)
+
+	var unstaged, staged repositories.DiffStat
+	for _, stat := range stats {
+		if stat.Error != "" {
+			rows = append(rows, []string{stat.Repository, "❌ Error", "❌ Error", "-", "-", "-"})
+			continue
+		}
+
+		unstaged.Add(stat.Unstaged)
+		staged.Add(stat.Staged)
+		rows = append(rows, diffStatRow(stat.Repository, stat.Unstaged, stat.Staged))
+	}
+
+	rows = append(rows, diffStatRow("Total", unstaged, staged))
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	return result.String()
+}
+
+// diffStatRow builds a table row from the unstaged and staged changes of a repository
+func diffStatRow(name string, unstaged, staged repositories.DiffStat) []string {
+	total := unstaged
+	total.Add(staged)
+
+	return []string{
+		name,
+		formatDiffCounts(unstaged),
+		formatDiffCounts(staged),
+		strconv.Itoa(total.FilesChanged),
+		"+" + strconv.Itoa(total.Insertions),
+		"-" + strconv.Itoa(total.Deletions),
+	}
+}
+
+// formatDiffCounts formats insertions and deletions as "+10 -2"
+func formatDiffCounts(stat repositories.DiffStat) string {
+	return fmt.Sprintf("+%d -%d", stat.Insertions, stat.Deletions)
+}
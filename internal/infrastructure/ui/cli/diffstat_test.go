@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatDiffStats(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	stats := []*usecases.RepositoryDiffStat{
+		{
+			Repository: "api",
+			Unstaged:   repositories.DiffStat{FilesChanged: 1, Insertions: 4},
+			Staged:     repositories.DiffStat{FilesChanged: 2, Deletions: 3},
+		},
+		{Repository: "web"},
+		{Repository: "broken", Error: "not a git repository"},
+	}
+
+	output := formatDiffStats(stylesService, stats)
+
+	for _, want := range []string{"api", "web", "broken", "Total", "+4 -0", "+0 -3", "❌ Error"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatDiffStats() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestDiffStatRow(t *testing.T) {
+	row := diffStatRow("api",
+		repositories.DiffStat{FilesChanged: 1, Insertions: 4, Deletions: 1},
+		repositories.DiffStat{FilesChanged: 2, Insertions: 1, Deletions: 3},
+	)
+
+	expected := []string{"api", "+4 -1", "+1 -3", "3", "+5", "-4"}
+	if strings.Join(row, "|") != strings.Join(expected, "|") {
+		t.Errorf("diffStatRow() = %v, want %v", row, expected)
+	}
+}
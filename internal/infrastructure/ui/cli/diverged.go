@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// maxRebaseCommits is the number of local commits up to which a rebase is suggested to
+// reconcile a diverged branch. Rebasing more may mean resolving the same conflicts once
+// per commit, so a merge is suggested instead.
+const maxRebaseCommits = 5
+
+// formatDivergedRepositories renders the repositories both ahead and behind their upstream
+// as a table, with how to reconcile each of them
+func formatDivergedRepositories(stylesService styles.Service, diverged []*usecases.DivergedRepository) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🔀 Diverged Repositories") + "\n\n")
+
+	if len(diverged) == 0 {
+		result.WriteString(stylesService.GetSuccessStyle().Render("✨ No diverged repositories") + "\n")
+		return result.String()
+	}
+
+	headers := []string{"Repository", "Ahead", "Behind", "Suggestion"}
+	rows := make([][]string, 0, len(diverged))
+	for _, repo := range diverged {
+		if repo.Error != "" {
+			rows = append(rows, []string{repo.Repository, "❌ Error", "-", "-"})
+			continue
+		}
+		rows = append(rows, []string{repo.Repository, strconv.Itoa(repo.Ahead), strconv.Itoa(repo.Behind), divergedSuggestion(repo)})
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(fmt.Sprintf("⚠️ %d diverged repositories\n", len(diverged)))
+
+	return result.String()
+}
+
+// divergedSuggestion returns the command suggested to reconcile a diverged repository:
+// a rebase of a few local commits, or a merge of many
+func divergedSuggestion(repo *usecases.DivergedRepository) string {
+	if repo.Ahead <= maxRebaseCommits {
+		return "git pull --rebase"
+	}
+	return "git merge @{upstream}"
+}
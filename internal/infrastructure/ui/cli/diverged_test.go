@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatDivergedRepositories(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+
+	output := formatDivergedRepositories(stylesService, []*usecases.DivergedRepository{
+		{Repository: "api", Ahead: 2, Behind: 3},
+		{Repository: "web", Ahead: 12, Behind: 1},
+		{Repository: "broken", Error: "unexpected rev-list output"},
+	})
+
+	for _, want := range []string{"api", "web", "broken", "❌ Error", "git pull --rebase", "git merge @{upstream}", "3 diverged"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatDivergedRepositories() should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	if output := formatDivergedRepositories(stylesService, nil); !strings.Contains(output, "No diverged repositories") {
+		t.Errorf("formatDivergedRepositories() without repositories = %q", output)
+	}
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// envKeyPattern matches the names accepted for environment variables
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// readEnvFile reads the KEY=VALUE lines of an env file. Blank lines and lines
+// starting with # are ignored, and values may be wrapped in single or double quotes.
+func readEnvFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapPathError(errors.ErrFailedToReadEnvFile, path, err)
+	}
+
+	return parseEnvFile(path, data)
+}
+
+// parseEnvFile parses the content of an env file, reporting malformed lines with their number
+func parseEnvFile(path string, data []byte) (map[string]string, error) {
+	env := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || !envKeyPattern.MatchString(key) {
+			return nil, errors.WrapInvalidEnvFileLine(path, lineNumber, line)
+		}
+
+		env[key] = unquoteEnvValue(strings.TrimSpace(value))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WrapPathError(errors.ErrFailedToReadEnvFile, path, err)
+	}
+
+	return env, nil
+}
+
+// unquoteEnvValue removes the single or double quotes wrapping a value
+func unquoteEnvValue(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if first == last && (first == '"' || first == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	content := `# Build settings
+GOFLAGS=-mod=mod
+
+NODE_ENV = "production"
+  # indented comment
+GREETING='hello world'
+EMPTY=
+URL=https://example.com/?a=b
+`
+
+	env, err := parseEnvFile(".env", []byte(content))
+	if err != nil {
+		t.Fatalf("parseEnvFile() unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"GOFLAGS":  "-mod=mod",
+		"NODE_ENV": "production",
+		"GREETING": "hello world",
+		"EMPTY":    "",
+		"URL":      "https://example.com/?a=b",
+	}
+	if len(env) != len(expected) {
+		t.Errorf("parseEnvFile() returned %d variables, want %d: %v", len(env), len(expected), env)
+	}
+	for key, value := range expected {
+		if env[key] != value {
+			t.Errorf("parseEnvFile()[%s] = %q, want %q", key, env[key], value)
+		}
+	}
+}
+
+func TestParseEnvFile_MalformedLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "missing equals sign", content: "VALID=1\nNOT_A_PAIR\n"},
+		{name: "empty key", content: "=value\n"},
+		{name: "invalid key", content: "MY-KEY=value\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseEnvFile(".env", []byte(tt.content))
+			if !errors.IsError(err, errors.ErrInvalidEnvFileLine) {
+				t.Errorf("parseEnvFile() error = %v, want %v", err, errors.ErrInvalidEnvFileLine)
+			}
+		})
+	}
+}
+
+func TestReadEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte("TOKEN=abc\n"), 0644); err != nil {
+		t.Fatalf("Failed to write env file: %v", err)
+	}
+
+	env, err := readEnvFile(path)
+	if err != nil || env["TOKEN"] != "abc" {
+		t.Errorf("readEnvFile() = %v, %v, want TOKEN=abc", env, err)
+	}
+
+	if _, err := readEnvFile(filepath.Join(t.TempDir(), "missing.env")); !errors.IsError(err, errors.ErrFailedToReadEnvFile) {
+		t.Errorf("readEnvFile() error = %v, want %v", err, errors.ErrFailedToReadEnvFile)
+	}
+}
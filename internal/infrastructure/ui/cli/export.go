@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ExportEntry is a repository as seen by the exporters
+type ExportEntry struct {
+	Name      string
+	Path      string
+	RemoteURL string
+}
+
+// exporter renders repositories in the configuration format of another tool
+type exporter func(entries []ExportEntry) string
+
+// exporters maps each export format to its exporter
+var exporters = map[string]exporter{
+	"mr": exportMrConfig,
+}
+
+// exportFormats returns the supported export formats, sorted
+func exportFormats() []string {
+	formats := make([]string, 0, len(exporters))
+	for format := range exporters {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// exportMrConfig renders a myrepos (.mrconfig) configuration
+func exportMrConfig(entries []ExportEntry) string {
+	var result strings.Builder
+	result.WriteString("# Generated by gf export mr\n")
+
+	for _, entry := range entries {
+		result.WriteString(fmt.Sprintf("\n[%s]\n", entry.Path))
+		if entry.RemoteURL == "" {
+			result.WriteString(fmt.Sprintf("# %s has no remote, checkout is not available\n", entry.Name))
+			continue
+		}
+		result.WriteString(fmt.Sprintf("checkout = git clone %s %s\n",
+			shellQuote(entry.RemoteURL), shellQuote(filepath.Base(entry.Path))))
+	}
+
+	return result.String()
+}
+
+// shellQuote quotes a value for use as a single POSIX shell word
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
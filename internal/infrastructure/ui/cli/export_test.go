@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportMrConfig(t *testing.T) {
+	entries := []ExportEntry{
+		{Name: "api", Path: "/home/user/src/api", RemoteURL: "git@github.com:org/api.git"},
+		{Name: "notes", Path: "/home/user/notes"},
+	}
+
+	output := exportMrConfig(entries)
+
+	expected := []string{
+		"[/home/user/src/api]\ncheckout = git clone 'git@github.com:org/api.git' 'api'\n",
+		"[/home/user/notes]\n# notes has no remote",
+	}
+	for _, want := range expected {
+		if !strings.Contains(output, want) {
+			t.Errorf("exportMrConfig() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"simple", "'simple'"},
+		{"with space", "'with space'"},
+		{"it's", `'it'\''s'`},
+	}
+
+	for _, tt := range tests {
+		if got := shellQuote(tt.value); got != tt.expected {
+			t.Errorf("shellQuote(%q) = %s, want %s", tt.value, got, tt.expected)
+		}
+	}
+}
+
+func TestExportFormats(t *testing.T) {
+	formats := exportFormats()
+	if len(formats) == 0 || formats[0] != "mr" {
+		t.Errorf("exportFormats() = %v, want mr to be supported", formats)
+	}
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatFileCounts renders the number of tracked files of each repository as a table
+// with a total row
+func formatFileCounts(stylesService styles.Service, counts []*usecases.RepositoryFileCount) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🗂️ Tracked Files") + "\n\n")
+
+	headers := []string{"Repository", "Files"}
+	rows := make([][]string, 0, len(counts)+1)
+
+	total := 0
+	for _, count := range counts {
+		if count.Error != "" {
+			rows = append(rows, []string{count.Repository, "❌ Error"})
+			continue
+		}
+
+		total += count.Files
+		rows = append(rows, []string{count.Repository, strconv.Itoa(count.Files)})
+	}
+
+	rows = append(rows, []string{"Total", strconv.Itoa(total)})
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	return result.String()
+}
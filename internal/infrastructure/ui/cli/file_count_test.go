@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatFileCounts(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	counts := []*usecases.RepositoryFileCount{
+		{Repository: "web", Files: 340},
+		{Repository: "api", Files: 12},
+		{Repository: "empty"},
+		{Repository: "broken", Error: "not a git repository"},
+	}
+
+	output := formatFileCounts(stylesService, counts)
+
+	for _, want := range []string{"web", "340", "api", "12", "empty", "broken", "❌ Error", "Total", "352"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatFileCounts() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
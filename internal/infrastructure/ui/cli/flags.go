@@ -0,0 +1,556 @@
+package cli
+
+import (
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/logger"
+)
+
+// Flags holds the gf options extracted from the command line
+type Flags struct {
+	Verbose       bool
+	ReportPath    string
+	BorderStyle   string
+	NameOnly      bool
+	Events        string
+	LogLevel      string
+	Yes           bool
+	SortBy        string
+	GroupBy       string
+	DedupeOutput  bool
+	RepoOrder     []string
+	MaxSize       int64
+	EnvFile       string
+	Notify        bool
+	JSON          bool
+	WithCommit    bool
+	SummaryOnly   bool
+	ConfigPath    string
+	LogDir        string
+	NoUpdateCheck bool
+	NoDiscover    bool
+	OnBranch      string
+	Format        string
+	FailFast      bool
+	SkipLocked    bool
+	GitOnly       bool
+	Retries       int
+	RetryOn       []string
+	CommitPrompt  bool
+	GitJobs       int
+	Copy          string
+	PullReport    bool
+	Status        string
+	WorkDir       string
+	ForceUnsafe   bool
+	OrderOutput   string
+	OnDirty       string
+	PathStyle     string
+	Select        bool
+	Dirty         bool
+	LastOp        bool
+	Matrix        bool
+	Pager         bool
+	NoPager       bool
+	NoColor       bool
+}
+
+// EventsFormatJSONLines streams execution events as newline-delimited JSON
+const EventsFormatJSONLines = "jsonl"
+
+// eventsFormats lists the supported --events formats
+var eventsFormats = []string{EventsFormatJSONLines}
+
+// Status formats of --format
+const (
+	StatusFormatTable   = "table"
+	StatusFormatCompact = "compact"
+)
+
+// statusFormats lists the supported --format values
+var statusFormats = []string{StatusFormatTable, StatusFormatCompact}
+
+// Copy formats of --copy, the plain one dropping the colors and styling
+const (
+	CopyFormatPlain  = "plain"
+	CopyFormatStyled = "styled"
+)
+
+// copyFormats lists the supported --copy values
+var copyFormats = []string{CopyFormatPlain, CopyFormatStyled}
+
+// Output orders of --order-output: the results of the repositories are shown as they
+// complete unless sorted by repository name
+const (
+	OutputOrderCompletion = "completion"
+	OutputOrderName       = "name"
+)
+
+// outputOrders lists the supported --order-output values
+var outputOrders = []string{OutputOrderCompletion, OutputOrderName}
+
+// DefaultRetries is the number of times --retry runs a failed command again
+const DefaultRetries = 2
+
+// retryCodeAliases maps the short names accepted by --retry-on to their error code
+var retryCodeAliases = map[string]string{
+	"auth":     errors.CodeAuthRequired,
+	"network":  errors.CodeNetworkFailure,
+	"locked":   errors.CodeRepositoryLocked,
+	"conflict": errors.CodeMergeConflict,
+	"hook":     errors.CodeHookRejected,
+}
+
+// configSubcommands lists the subcommands of the config command, which --config
+// given without a path may be followed by
+var configSubcommands = []string{"show", "validate", "init", "create", "discover", "backup", "restore"}
+
+// globalCommands are the commands given without groups, as parseCommand reads them
+var globalCommands = []string{
+	"help", "-h", "--help", "version", "--version", "config", "-c", "--config", "status", "-s", "--status",
+	"goto", "rerun", "groups", "export", "resolve", "add", "remove", "rm",
+}
+
+// Commands reading the flags that sharedFlags limits to them
+var (
+	statusCommands = []string{"status", "-s", "--status", "ls"}
+	configCommands = []string{"config", "-c", "--config"}
+)
+
+// sharedFlags lists the gf flags whose name git commands use too, with the gf commands
+// they are read for once the command is given. Before the command they are always gf
+// flags, while after it they are left to the command, so that git diff --name-only,
+// git log --format=%h, git clone --filter=blob:none, git clone --config core.autocrlf=false,
+// git diff --no-color or git branch --sort=-committerdate run as given.
+var sharedFlags = map[string][]string{
+	"--name-only": nil,
+	"--dirty":     nil,
+	"--format":    statusCommands,
+	"--filter":    statusCommands,
+	"--sort":      slices.Concat(statusCommands, configCommands),
+	"--config":    configCommands,
+	"--no-color":  globalCommands,
+}
+
+// commandWord returns the first word of the command in the arguments left by
+// parseFlags so far, or an empty string while only groups were given. Like
+// parseCommand, it reads a first argument without @ as a legacy group unless it is a
+// global command, or --dirty is given, which needs no group.
+func commandWord(args []string, dirty bool) string {
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "@") {
+			continue
+		}
+		if i == 0 && !dirty && !strings.HasPrefix(arg, "-") && !slices.Contains(globalCommands, arg) {
+			continue
+		}
+		return arg
+	}
+	return ""
+}
+
+// passthroughSeparator ends the gf arguments, the following ones being passed verbatim
+// as the command to run
+const passthroughSeparator = "--"
+
+// ScanFlags extracts the gf flags needed before the command is handled,
+// ignoring errors that the handler reports when parsing the command
+func ScanFlags(args []string) Flags {
+	_, flags, _ := parseFlags(args)
+	return flags
+}
+
+// parseFlags extracts gf flags from the arguments and returns the remaining arguments
+func parseFlags(args []string) ([]string, Flags, error) {
+	var flags Flags
+	remaining := make([]string, 0, len(args))
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+
+		// Everything after -- belongs to the command and is left uninterpreted
+		if arg == passthroughSeparator {
+			remaining = append(remaining, args[i:]...)
+			break
+		}
+
+		// Options of the command that share a name with a gf flag are left to it
+		if commands, shared := sharedFlags[name]; shared {
+			if command := commandWord(remaining, flags.Dirty); command != "" && !slices.Contains(commands, command) {
+				remaining = append(remaining, arg)
+				continue
+			}
+		}
+
+		switch name {
+		case "-v", "--verbose", "-d", "--debug":
+			flags.Verbose = true
+		case "--yes":
+			flags.Yes = true
+		case "--force-unsafe":
+			flags.ForceUnsafe = true
+		case "--notify":
+			flags.Notify = true
+		case "--json":
+			flags.JSON = true
+		case "--with-commit":
+			flags.WithCommit = true
+		case "--summary-only":
+			flags.SummaryOnly = true
+		case "--no-update-check":
+			flags.NoUpdateCheck = true
+		case "--no-discover":
+			flags.NoDiscover = true
+		case "--select":
+			flags.Select = true
+		case "--dirty":
+			flags.Dirty = true
+		case "--last-op":
+			flags.LastOp = true
+		case "--dedupe-output":
+			flags.DedupeOutput = true
+		case "--matrix":
+			flags.Matrix = true
+		case "--pager":
+			flags.Pager = true
+		case "--no-pager":
+			flags.NoPager = true
+		case "--no-color":
+			flags.NoColor = true
+		case "--name-only":
+			flags.NameOnly = true
+		case "--fail-fast":
+			flags.FailFast = true
+		case "--skip-locked":
+			flags.SkipLocked = true
+		case "--git-only":
+			flags.GitOnly = true
+		case "--report-conflicts":
+			flags.PullReport = true
+		case "--interactive-commit":
+			flags.CommitPrompt = true
+		case "--retry":
+			retries := DefaultRetries
+			if hasValue {
+				n, err := strconv.Atoi(value)
+				if err != nil || n <= 0 {
+					return nil, flags, errors.WrapInvalidRetryCount(value)
+				}
+				retries = n
+			}
+			flags.Retries = retries
+		case "--retry-on":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			codes, err := parseRetryCodes(v)
+			if err != nil {
+				return nil, flags, err
+			}
+			flags.RetryOn = codes
+			i = next
+		case "--copy":
+			format := CopyFormatPlain
+			if hasValue {
+				if !slices.Contains(copyFormats, value) {
+					return nil, flags, errors.WrapInvalidCopyFormat(value, copyFormats)
+				}
+				format = value
+			}
+			flags.Copy = format
+		case "--git-jobs":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			jobs, err := strconv.Atoi(v)
+			if err != nil || jobs <= 0 {
+				return nil, flags, errors.WrapInvalidGitJobs(v)
+			}
+			flags.GitJobs = jobs
+			i = next
+		case "--events":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if !slices.Contains(eventsFormats, v) {
+				return nil, flags, errors.WrapInvalidEventsFormat(v, eventsFormats)
+			}
+			flags.Events = v
+			i = next
+		case "--log-level":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if _, ok := logger.ParseLevel(v); !ok {
+				return nil, flags, errors.WrapInvalidLogLevel(v, logger.LevelNames)
+			}
+			flags.LogLevel = v
+			i = next
+		case "--sort":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			// status and config show sort by their own keys, checked once the command is known
+			if !usecases.IsValidStatusSortKey(v) && !usecases.IsValidConfigSortKey(v) {
+				return nil, flags, errors.WrapInvalidSortKey(v, usecases.StatusSortKeys)
+			}
+			flags.SortBy = v
+			i = next
+		case "--group-by":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if !usecases.IsValidStatusGroupKey(v) {
+				return nil, flags, errors.WrapInvalidGroupByKey(v, usecases.StatusGroupKeys)
+			}
+			flags.GroupBy = v
+			i = next
+		case "--repo-order":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			flags.RepoOrder = splitList(v)
+			i = next
+		case "--max-size":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			size, ok := parseByteSize(v)
+			if !ok {
+				return nil, flags, errors.WrapInvalidSize(v)
+			}
+			flags.MaxSize = size
+			i = next
+		case "--env-file":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			flags.EnvFile = v
+			i = next
+		case "--config":
+			// A lone --config, or one followed by a config subcommand, shows the configuration
+			if !hasValue && (i+1 >= len(args) || slices.Contains(configSubcommands, args[i+1])) {
+				remaining = append(remaining, arg)
+				continue
+			}
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			flags.ConfigPath = v
+			i = next
+		case "--report":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			flags.ReportPath = v
+			i = next
+		case "--on-branch":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			flags.OnBranch = v
+			i = next
+		case "--workdir":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			// The directory must stay inside each repository
+			if !filepath.IsLocal(v) {
+				return nil, flags, errors.WrapInvalidWorkDir(v)
+			}
+			flags.WorkDir = filepath.Clean(v)
+			i = next
+		case "--order-output":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if !slices.Contains(outputOrders, v) {
+				return nil, flags, errors.WrapInvalidOutputOrder(v, outputOrders)
+			}
+			flags.OrderOutput = v
+			i = next
+		case "--on-dirty":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if !slices.Contains(usecases.OnDirtyPolicies, v) {
+				return nil, flags, errors.WrapInvalidDirtyPolicy(v, usecases.OnDirtyPolicies)
+			}
+			flags.OnDirty = v
+			i = next
+		case "--filter":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			status, ok := parseStatusFilter(v)
+			if !ok {
+				return nil, flags, errors.WrapInvalidStatusFilter(v, statusNames())
+			}
+			flags.Status = string(status)
+			i = next
+		case "--format":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if !slices.Contains(statusFormats, v) {
+				return nil, flags, errors.WrapInvalidStatusFormat(v, statusFormats)
+			}
+			flags.Format = v
+			i = next
+		case "--log-dir":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			flags.LogDir = v
+			i = next
+		case "--border":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if !styles.IsValidBorderStyle(v) {
+				return nil, flags, errors.WrapInvalidBorderStyle(v, styles.BorderStyleNames)
+			}
+			flags.BorderStyle = v
+			i = next
+		case "--path-style":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, flags, err
+			}
+			if !slices.Contains(styles.PathStyleNames, v) {
+				return nil, flags, errors.WrapInvalidPathStyle(v, styles.PathStyleNames)
+			}
+			flags.PathStyle = v
+			i = next
+		default:
+			remaining = append(remaining, arg)
+		}
+	}
+
+	// --retry-on without --retry retries the default number of times
+	if len(flags.RetryOn) > 0 && flags.Retries == 0 {
+		flags.Retries = DefaultRetries
+	}
+
+	return remaining, flags, nil
+}
+
+// parseRetryCodes parses the comma-separated error codes of --retry-on, accepting the
+// short names of retryCodeAliases
+func parseRetryCodes(value string) ([]string, error) {
+	var codes []string
+	for _, name := range splitList(value) {
+		code := name
+		if alias, ok := retryCodeAliases[name]; ok {
+			code = alias
+		}
+		if !errors.IsValidCode(code) {
+			return nil, errors.WrapInvalidRetryCode(name, errors.Codes())
+		}
+		codes = append(codes, code)
+	}
+	if len(codes) == 0 {
+		return nil, errors.WrapInvalidRetryCode(value, errors.Codes())
+	}
+	return codes, nil
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// byteSizeUnits maps the size suffixes to their multiplier
+var byteSizeUnits = map[string]int64{
+	"":   1,
+	"B":  1,
+	"K":  1 << 10,
+	"KB": 1 << 10,
+	"M":  1 << 20,
+	"MB": 1 << 20,
+	"G":  1 << 30,
+	"GB": 1 << 30,
+}
+
+// parseByteSize parses a size such as 500K, 10MB or 1048576 into a positive number of bytes
+func parseByteSize(value string) (int64, bool) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	digits := strings.TrimRight(value, "KMGB")
+
+	multiplier, ok := byteSizeUnits[value[len(digits):]]
+	if !ok {
+		return 0, false
+	}
+
+	size, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || size <= 0 {
+		return 0, false
+	}
+
+	return size * multiplier, true
+}
+
+// flagValue returns the value of a flag given either as --flag=value or --flag value,
+// along with the index of the last argument consumed
+func flagValue(args []string, i int, name, value string, hasValue bool) (string, int, error) {
+	if hasValue {
+		return value, i, nil
+	}
+
+	if i+1 >= len(args) {
+		return "", i, errors.WrapFlagRequiresValue(name)
+	}
+
+	return args[i+1], i + 1, nil
+}
+
+// parseStatusFilter returns the repository status of a status=<status> filter
+func parseStatusFilter(filter string) (entities.RepositoryStatus, bool) {
+	key, value, found := strings.Cut(filter, "=")
+	if !found || strings.TrimSpace(key) != "status" {
+		return "", false
+	}
+	return entities.ParseRepositoryStatus(value)
+}
+
+// statusNames returns the names of the repository statuses
+func statusNames() []string {
+	names := make([]string, 0, len(entities.RepositoryStatuses))
+	for _, status := range entities.RepositoryStatuses {
+		names = append(names, string(status))
+	}
+	return names
+}
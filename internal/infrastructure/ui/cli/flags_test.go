@@ -0,0 +1,537 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		expectedArgs []string
+		expected     Flags
+	}{
+		{
+			name:         "no flags",
+			args:         []string{"@group", "pull"},
+			expectedArgs: []string{"@group", "pull"},
+			expected:     Flags{},
+		},
+		{
+			name:         "verbose flags are removed",
+			args:         []string{"-v", "@group", "--debug", "pull"},
+			expectedArgs: []string{"@group", "pull"},
+			expected:     Flags{Verbose: true},
+		},
+		{
+			name:         "report flag with separate value",
+			args:         []string{"@group", "pull", "--report", "out.json"},
+			expectedArgs: []string{"@group", "pull"},
+			expected:     Flags{ReportPath: "out.json"},
+		},
+		{
+			name:         "report flag with inline value",
+			args:         []string{"--report=out.json", "@group", "pull"},
+			expectedArgs: []string{"@group", "pull"},
+			expected:     Flags{ReportPath: "out.json"},
+		},
+		{
+			name:         "config flag",
+			args:         []string{"--config", "work.json", "@all", "status"},
+			expectedArgs: []string{"@all", "status"},
+			expected:     Flags{ConfigPath: "work.json"},
+		},
+		{
+			name:         "lone config flag is the config command",
+			args:         []string{"--config"},
+			expectedArgs: []string{"--config"},
+			expected:     Flags{},
+		},
+		{
+			name:         "config flag before a config subcommand is the config command",
+			args:         []string{"--config", "validate"},
+			expectedArgs: []string{"--config", "validate"},
+			expected:     Flags{},
+		},
+		{
+			name:         "config flag with inline path",
+			args:         []string{"--config=validate", "config", "validate"},
+			expectedArgs: []string{"config", "validate"},
+			expected:     Flags{ConfigPath: "validate"},
+		},
+		{
+			name:         "git config option",
+			args:         []string{"@all", "clone", "--config", "core.autocrlf=false", "git@example.com:api.git"},
+			expectedArgs: []string{"@all", "clone", "--config", "core.autocrlf=false", "git@example.com:api.git"},
+			expected:     Flags{},
+		},
+		{
+			name:         "config flag after the config command",
+			args:         []string{"config", "show", "--config", "work.json"},
+			expectedArgs: []string{"config", "show"},
+			expected:     Flags{ConfigPath: "work.json"},
+		},
+		{
+			name:         "border flag",
+			args:         []string{"--border", "none", "status"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{BorderStyle: "none"},
+		},
+		{
+			name:         "events flag",
+			args:         []string{"@all", "fetch", "--events=jsonl"},
+			expectedArgs: []string{"@all", "fetch"},
+			expected:     Flags{Events: EventsFormatJSONLines},
+		},
+		{
+			name:         "yes flag",
+			args:         []string{"@all", "reset", "--hard", "--yes"},
+			expectedArgs: []string{"@all", "reset", "--hard"},
+			expected:     Flags{Yes: true},
+		},
+		{
+			name:         "dedupe output flag",
+			args:         []string{"@api", "--dedupe-output", "log", "-1"},
+			expectedArgs: []string{"@api", "log", "-1"},
+			expected:     Flags{DedupeOutput: true},
+		},
+		{
+			name:         "repo order flag",
+			args:         []string{"@all", "--repo-order", "api, web,,docs", "pull"},
+			expectedArgs: []string{"@all", "pull"},
+			expected:     Flags{RepoOrder: []string{"api", "web", "docs"}},
+		},
+		{
+			name:         "max size flag",
+			args:         []string{"@all", "precommit-check", "--max-size=10M"},
+			expectedArgs: []string{"@all", "precommit-check"},
+			expected:     Flags{MaxSize: 10 << 20},
+		},
+		{
+			name:         "env file flag",
+			args:         []string{"@all", "--env-file", ".env", "status"},
+			expectedArgs: []string{"@all", "status"},
+			expected:     Flags{EnvFile: ".env"},
+		},
+		{
+			name:         "notify flag",
+			args:         []string{"@all", "fetch", "--notify"},
+			expectedArgs: []string{"@all", "fetch"},
+			expected:     Flags{Notify: true},
+		},
+		{
+			name:         "sort flag",
+			args:         []string{"status", "--sort=dirty"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{SortBy: "dirty"},
+		},
+		{
+			name:         "config show sort flag",
+			args:         []string{"config", "show", "--sort", "path"},
+			expectedArgs: []string{"config", "show"},
+			expected:     Flags{SortBy: "path"},
+		},
+		{
+			name:         "git sort option",
+			args:         []string{"@all", "branch", "--sort=-committerdate"},
+			expectedArgs: []string{"@all", "branch", "--sort=-committerdate"},
+			expected:     Flags{},
+		},
+		{
+			name:         "no update check flag",
+			args:         []string{"--no-update-check", "version", "--check"},
+			expectedArgs: []string{"version", "--check"},
+			expected:     Flags{NoUpdateCheck: true},
+		},
+		{
+			name:         "no discover flag",
+			args:         []string{"--no-discover", "status"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{NoDiscover: true},
+		},
+		{
+			name:         "select flag",
+			args:         []string{"@all", "--select", "pull"},
+			expectedArgs: []string{"@all", "pull"},
+			expected:     Flags{Select: true},
+		},
+		{
+			name:         "dirty flag",
+			args:         []string{"--dirty", "pull"},
+			expectedArgs: []string{"pull"},
+			expected:     Flags{Dirty: true},
+		},
+		{
+			name:         "matrix flag",
+			args:         []string{"@all", "--matrix", "make lint"},
+			expectedArgs: []string{"@all", "make lint"},
+			expected:     Flags{Matrix: true},
+		},
+		{
+			name:         "pager flag",
+			args:         []string{"--pager", "status"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{Pager: true},
+		},
+		{
+			name:         "no pager flag",
+			args:         []string{"status", "--no-pager"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{NoPager: true},
+		},
+		{
+			name:         "no color flag",
+			args:         []string{"--no-color", "@all", "status"},
+			expectedArgs: []string{"@all", "status"},
+			expected:     Flags{NoColor: true},
+		},
+		{
+			name:         "git no color option",
+			args:         []string{"@all", "diff", "--no-color"},
+			expectedArgs: []string{"@all", "diff", "--no-color"},
+			expected:     Flags{},
+		},
+		{
+			name:         "last op flag",
+			args:         []string{"status", "--last-op"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{LastOp: true},
+		},
+		{
+			name:         "on branch flag",
+			args:         []string{"@all", "--on-branch", "feature-x", "pull"},
+			expectedArgs: []string{"@all", "pull"},
+			expected:     Flags{OnBranch: "feature-x"},
+		},
+		{
+			name:         "log dir flag",
+			args:         []string{"@all", "pull", "--log-dir=logs"},
+			expectedArgs: []string{"@all", "pull"},
+			expected:     Flags{LogDir: "logs"},
+		},
+		{
+			name:         "group-by flag",
+			args:         []string{"status", "--group-by", "tag"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{GroupBy: "tag"},
+		},
+		{
+			name:         "format flag",
+			args:         []string{"status", "--format", "compact"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{Format: "compact"},
+		},
+		{
+			name:         "log level flag",
+			args:         []string{"--log-level", "info", "status"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{LogLevel: "info"},
+		},
+		{
+			name:         "json status with commit",
+			args:         []string{"@group", "status", "--json", "--with-commit"},
+			expectedArgs: []string{"@group", "status"},
+			expected:     Flags{JSON: true, WithCommit: true},
+		},
+		{
+			name:         "summary only flag",
+			args:         []string{"@group", "fetch", "--summary-only"},
+			expectedArgs: []string{"@group", "fetch"},
+			expected:     Flags{SummaryOnly: true},
+		},
+		{
+			name:         "fail fast flag",
+			args:         []string{"--fail-fast", "@group", "make test"},
+			expectedArgs: []string{"@group", "make test"},
+			expected:     Flags{FailFast: true},
+		},
+		{
+			name:         "arguments after the separator are not parsed",
+			args:         []string{"-v", "@group", "--", "log", "--verbose", "--yes"},
+			expectedArgs: []string{"@group", "--", "log", "--verbose", "--yes"},
+			expected:     Flags{Verbose: true},
+		},
+		{
+			name:         "retry flag uses the default count",
+			args:         []string{"@group", "--retry", "fetch"},
+			expectedArgs: []string{"@group", "fetch"},
+			expected:     Flags{Retries: DefaultRetries},
+		},
+		{
+			name:         "retry flag with count",
+			args:         []string{"@group", "--retry=5", "fetch"},
+			expectedArgs: []string{"@group", "fetch"},
+			expected:     Flags{Retries: 5},
+		},
+		{
+			name:         "retry-on accepts codes and short names",
+			args:         []string{"@group", "--retry-on", "auth,timeout,network_failure", "fetch"},
+			expectedArgs: []string{"@group", "fetch"},
+			expected: Flags{
+				Retries: DefaultRetries,
+				RetryOn: []string{errors.CodeAuthRequired, errors.CodeTimeout, errors.CodeNetworkFailure},
+			},
+		},
+		{
+			name:         "retry-on keeps the retry count",
+			args:         []string{"--retry=1", "--retry-on=locked", "@group", "pull"},
+			expectedArgs: []string{"@group", "pull"},
+			expected:     Flags{Retries: 1, RetryOn: []string{errors.CodeRepositoryLocked}},
+		},
+		{
+			name:         "interactive commit flag",
+			args:         []string{"@group", "--interactive-commit", "commit"},
+			expectedArgs: []string{"@group", "commit"},
+			expected:     Flags{CommitPrompt: true},
+		},
+		{
+			name:         "report conflicts flag",
+			args:         []string{"@group", "pull", "--ff-only", "--report-conflicts"},
+			expectedArgs: []string{"@group", "pull", "--ff-only"},
+			expected:     Flags{PullReport: true},
+		},
+		{
+			name:         "copy flag",
+			args:         []string{"status", "--copy"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{Copy: CopyFormatPlain},
+		},
+		{
+			name:         "styled copy flag",
+			args:         []string{"--copy=styled", "status"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{Copy: CopyFormatStyled},
+		},
+		{
+			name:         "status filter flag",
+			args:         []string{"status", "--filter", "status=Modified"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{Status: "modified"},
+		},
+		{
+			name:         "force unsafe flag",
+			args:         []string{"@all", "pull", "--force-unsafe"},
+			expectedArgs: []string{"@all", "pull"},
+			expected:     Flags{ForceUnsafe: true},
+		},
+		{
+			name:         "workdir flag",
+			args:         []string{"--workdir", "./web/", "@mono", "npm", "ci"},
+			expectedArgs: []string{"@mono", "npm", "ci"},
+			expected:     Flags{WorkDir: "web"},
+		},
+		{
+			name:         "on dirty flag",
+			args:         []string{"@all", "pull", "--on-dirty=stash"},
+			expectedArgs: []string{"@all", "pull"},
+			expected:     Flags{OnDirty: "stash"},
+		},
+		{
+			name:         "path style flag",
+			args:         []string{"--path-style", "relative", "status"},
+			expectedArgs: []string{"status"},
+			expected:     Flags{PathStyle: styles.PathStyleRelative},
+		},
+		{
+			name:         "order output flag",
+			args:         []string{"@all", "--order-output", "name", "pull"},
+			expectedArgs: []string{"@all", "pull"},
+			expected:     Flags{OrderOutput: OutputOrderName},
+		},
+		{
+			name:         "git jobs flag",
+			args:         []string{"--git-jobs", "8", "@group", "fetch"},
+			expectedArgs: []string{"@group", "fetch"},
+			expected:     Flags{GitJobs: 8},
+		},
+		{
+			name:         "git only flag",
+			args:         []string{"@group", "size", "--git-only"},
+			expectedArgs: []string{"@group", "size"},
+			expected:     Flags{GitOnly: true},
+		},
+		{
+			name:         "quoted command containing equals is kept",
+			args:         []string{"@group", "commit -m 'a=b'"},
+			expectedArgs: []string{"@group", "commit -m 'a=b'"},
+			expected:     Flags{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, flags, err := parseFlags(tt.args)
+			if err != nil {
+				t.Fatalf("parseFlags(%v) returned error: %v", tt.args, err)
+			}
+			if !reflect.DeepEqual(args, tt.expectedArgs) {
+				t.Errorf("parseFlags(%v) args = %v, want %v", tt.args, args, tt.expectedArgs)
+			}
+			if !reflect.DeepEqual(flags, tt.expected) {
+				t.Errorf("parseFlags(%v) flags = %+v, want %+v", tt.args, flags, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFlags_MissingValue(t *testing.T) {
+	_, _, err := parseFlags([]string{"@group", "pull", "--report"})
+	if err == nil {
+		t.Fatal("parseFlags() should return an error when a flag value is missing")
+	}
+	if !errors.IsError(err, errors.ErrFlagRequiresValue) {
+		t.Errorf("expected ErrFlagRequiresValue, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidBorderStyle(t *testing.T) {
+	_, _, err := parseFlags([]string{"--border=dotted", "status"})
+	if !errors.IsError(err, errors.ErrInvalidBorderStyle) {
+		t.Errorf("expected ErrInvalidBorderStyle, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidEventsFormat(t *testing.T) {
+	_, _, err := parseFlags([]string{"--events", "xml", "@all", "fetch"})
+	if !errors.IsError(err, errors.ErrInvalidEventsFormat) {
+		t.Errorf("expected ErrInvalidEventsFormat, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidLogLevel(t *testing.T) {
+	_, _, err := parseFlags([]string{"--log-level=loud", "status"})
+	if !errors.IsError(err, errors.ErrInvalidLogLevel) {
+		t.Errorf("expected ErrInvalidLogLevel, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidSortKey(t *testing.T) {
+	_, _, err := parseFlags([]string{"status", "--sort", "size"})
+	if !errors.IsError(err, errors.ErrInvalidSortKey) {
+		t.Errorf("expected ErrInvalidSortKey, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidGroupByKey(t *testing.T) {
+	_, _, err := parseFlags([]string{"status", "--group-by=owner"})
+	if !errors.IsError(err, errors.ErrInvalidGroupByKey) {
+		t.Errorf("expected ErrInvalidGroupByKey, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidStatusFormat(t *testing.T) {
+	_, _, err := parseFlags([]string{"status", "--format=wide"})
+	if !errors.IsError(err, errors.ErrInvalidStatusFormat) {
+		t.Errorf("expected ErrInvalidStatusFormat, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidRetry(t *testing.T) {
+	_, _, err := parseFlags([]string{"--retry=0", "@all", "fetch"})
+	if !errors.IsError(err, errors.ErrInvalidRetryCount) {
+		t.Errorf("expected ErrInvalidRetryCount, got %v", err)
+	}
+
+	_, _, err = parseFlags([]string{"--retry-on", "network,flaky", "@all", "fetch"})
+	if !errors.IsError(err, errors.ErrInvalidRetryCode) {
+		t.Errorf("expected ErrInvalidRetryCode, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidCopyFormat(t *testing.T) {
+	_, _, err := parseFlags([]string{"status", "--copy=html"})
+	if !errors.IsError(err, errors.ErrInvalidCopyFormat) {
+		t.Errorf("expected ErrInvalidCopyFormat, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidStatusFilter(t *testing.T) {
+	for _, value := range []string{"status=dirty", "branch=main", "clean"} {
+		_, _, err := parseFlags([]string{"status", "--filter=" + value})
+		if !errors.IsError(err, errors.ErrInvalidStatusFilter) {
+			t.Errorf("--filter=%s: expected ErrInvalidStatusFilter, got %v", value, err)
+		}
+	}
+}
+
+func TestParseFlags_InvalidWorkDir(t *testing.T) {
+	for _, value := range []string{"/tmp", "../other", "web/../..", ""} {
+		_, _, err := parseFlags([]string{"--workdir=" + value, "@all", "ls"})
+		if !errors.IsError(err, errors.ErrInvalidWorkDir) {
+			t.Errorf("--workdir=%s: expected ErrInvalidWorkDir, got %v", value, err)
+		}
+	}
+}
+
+func TestParseFlags_InvalidDirtyPolicy(t *testing.T) {
+	_, _, err := parseFlags([]string{"--on-dirty", "reset", "@all", "pull"})
+	if !errors.IsError(err, errors.ErrInvalidDirtyPolicy) {
+		t.Errorf("expected ErrInvalidDirtyPolicy, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidPathStyle(t *testing.T) {
+	_, _, err := parseFlags([]string{"--path-style=short", "status"})
+	if !errors.IsError(err, errors.ErrInvalidPathStyle) {
+		t.Errorf("expected ErrInvalidPathStyle, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidOutputOrder(t *testing.T) {
+	_, _, err := parseFlags([]string{"--order-output=size", "@all", "pull"})
+	if !errors.IsError(err, errors.ErrInvalidOutputOrder) {
+		t.Errorf("expected ErrInvalidOutputOrder, got %v", err)
+	}
+}
+
+func TestParseFlags_InvalidGitJobs(t *testing.T) {
+	for _, value := range []string{"0", "-2", "many"} {
+		_, _, err := parseFlags([]string{"--git-jobs=" + value, "@all", "fetch"})
+		if !errors.IsError(err, errors.ErrInvalidGitJobs) {
+			t.Errorf("parseFlags(--git-jobs=%s) expected ErrInvalidGitJobs, got %v", value, err)
+		}
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int64
+		ok       bool
+	}{
+		{value: "1048576", expected: 1 << 20, ok: true},
+		{value: "500K", expected: 500 << 10, ok: true},
+		{value: "10mb", expected: 10 << 20, ok: true},
+		{value: "2G", expected: 2 << 30, ok: true},
+		{value: "12B", expected: 12, ok: true},
+		{value: "MB"},
+		{value: "0"},
+		{value: "-5M"},
+		{value: "5TB"},
+	}
+
+	for _, tt := range tests {
+		size, ok := parseByteSize(tt.value)
+		if ok != tt.ok || size != tt.expected {
+			t.Errorf("parseByteSize(%q) = %d, %t, want %d, %t", tt.value, size, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestParseFlags_InvalidMaxSize(t *testing.T) {
+	_, _, err := parseFlags([]string{"@all", "precommit-check", "--max-size", "big"})
+	if !errors.IsError(err, errors.ErrInvalidSize) {
+		t.Errorf("expected ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestScanFlags(t *testing.T) {
+	flags := ScanFlags([]string{"-v", "@all", "fetch", "--events", "jsonl"})
+	if !flags.Verbose || flags.Events != EventsFormatJSONLines {
+		t.Errorf("ScanFlags() = %+v, want verbose with jsonl events", flags)
+	}
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// isGrepBuiltIn reports whether the arguments following grep are those of the built-in:
+// a pattern and an optional --files-only. git grep given any other option, or several
+// arguments, runs as a git command.
+func isGrepBuiltIn(args []string) bool {
+	patterns := 0
+	for _, arg := range args {
+		if arg != "--files-only" {
+			patterns++
+		}
+	}
+	return patterns == 1
+}
+
+// parseGrepArgs parses the arguments of grep: the pattern and an optional --files-only
+func parseGrepArgs(args []string) (*usecases.GrepInput, error) {
+	input := &usecases.GrepInput{}
+
+	for _, arg := range args {
+		switch {
+		case arg == "--files-only":
+			input.FilesOnly = true
+		case input.Pattern == "":
+			input.Pattern = arg
+		default:
+			return nil, errors.ErrUsageGrep
+		}
+	}
+
+	if input.Pattern == "" {
+		return nil, errors.ErrUsageGrep
+	}
+
+	return input, nil
+}
+
+// formatGreps renders the number of matches of each repository as a table, followed by
+// the matches grouped by repository. Repositories without matches only count in the total.
+func formatGreps(stylesService styles.Service, pattern string, greps []*usecases.RepositoryGrep, filesOnly bool) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🔎 Grep: "+pattern) + "\n\n")
+
+	unit := "Matches"
+	if filesOnly {
+		unit = "Files"
+	}
+	headers := []string{"Repository", unit}
+	rows := make([][]string, 0, len(greps))
+
+	var details strings.Builder
+	total, matching := 0, 0
+	for _, grep := range greps {
+		switch {
+		case grep.Error != "":
+			rows = append(rows, []string{grep.Repository, "❌ Error"})
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", grep.Repository, grep.Error))
+		case len(grep.Matches) > 0:
+			total += len(grep.Matches)
+			matching++
+			rows = append(rows, []string{grep.Repository, strconv.Itoa(len(grep.Matches))})
+			details.WriteString(stylesService.GetSectionStyle().Render("📁 "+grep.Repository) + "\n")
+			for _, match := range grep.Matches {
+				details.WriteString("  " + match + "\n")
+			}
+		}
+	}
+
+	if len(rows) > 0 {
+		result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+		result.WriteString(details.String())
+	}
+
+	result.WriteString(fmt.Sprintf("%d %s in %d of %d repositories\n", total, strings.ToLower(unit), matching, len(greps)))
+	return result.String()
+}
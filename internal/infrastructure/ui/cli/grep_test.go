@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestIsGrepBuiltIn(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"TODO"}, true},
+		{[]string{"TODO", "--files-only"}, true},
+		{[]string{"-Deprecated"}, true},
+		{[]string{}, false},
+		{[]string{"--files-only"}, false},
+		{[]string{"-i", "foo"}, false},
+		{[]string{"-w", "foo"}, false},
+		{[]string{"foo", "--", "*.go"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := isGrepBuiltIn(tt.args); got != tt.want {
+			t.Errorf("isGrepBuiltIn(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestParseGrepArgs(t *testing.T) {
+	input, err := parseGrepArgs([]string{"--files-only", "-Deprecated"})
+	if err != nil {
+		t.Fatalf("parseGrepArgs() error = %v", err)
+	}
+	if input.Pattern != "-Deprecated" || !input.FilesOnly {
+		t.Errorf("parseGrepArgs() = %+v, want pattern -Deprecated with files only", input)
+	}
+
+	for _, args := range [][]string{{}, {"--files-only"}, {"one", "two"}} {
+		if _, err := parseGrepArgs(args); !errors.IsError(err, errors.ErrUsageGrep) {
+			t.Errorf("parseGrepArgs(%v) error = %v, want ErrUsageGrep", args, err)
+		}
+	}
+}
+
+func TestFormatGreps(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	greps := []*usecases.RepositoryGrep{
+		{Repository: "api", Matches: []string{"main.go:12:// TODO(fix)", "util.go:3:// TODO(docs)"}},
+		{Repository: "docs", Matches: []string{}},
+		{Repository: "broken", Matches: []string{}, Error: "fatal: not a git repository"},
+	}
+
+	output := formatGreps(stylesService, "TODO", greps, false)
+
+	for _, want := range []string{"Grep: TODO", "📁 api", "main.go:12:// TODO(fix)", "❌ Error", "fatal: not a git repository", "2 matches in 1 of 3 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatGreps() should contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "📁 docs") {
+		t.Errorf("formatGreps() should omit repositories without matches from the details, got:\n%s", output)
+	}
+
+	if output := formatGreps(stylesService, "TODO", greps[1:2], true); !strings.Contains(output, "0 files in 0 of 1 repositories") {
+		t.Errorf("formatGreps() without matches should only print the total, got:\n%s", output)
+	}
+}
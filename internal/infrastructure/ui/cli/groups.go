@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatGroups renders the groups and their number of repositories as a table,
+// largest group first
+func formatGroups(stylesService styles.Service, groups []*entities.Group) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🏷️ Groups") + "\n\n")
+
+	if len(groups) == 0 {
+		result.WriteString("No groups configured, add one with: gf add group <name> <repos...>\n")
+		return result.String()
+	}
+
+	sorted := make([]*entities.Group, len(groups))
+	copy(sorted, groups)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Count() != sorted[j].Count() {
+			return sorted[i].Count() > sorted[j].Count()
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	headers := []string{"Group", "Repositories"}
+	rows := make([][]string, 0, len(sorted))
+	for _, group := range sorted {
+		rows = append(rows, []string{group.Name, strconv.Itoa(group.Count())})
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	return result.String()
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatGroups(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	groups := []*entities.Group{
+		entities.NewGroup("docs", []string{"handbook"}),
+		entities.NewGroup("backend", []string{"api", "auth", "billing"}),
+		entities.NewGroup("all", []string{"api", "auth", "billing", "handbook"}),
+		entities.NewGroup("apps", []string{"web"}),
+	}
+
+	output := formatGroups(stylesService, groups)
+
+	order := []string{"all", "backend", "apps", "docs"}
+	last := -1
+	for _, name := range order {
+		index := strings.Index(output, name)
+		if index <= last {
+			t.Fatalf("formatGroups() should list groups by size then name %v, got:\n%s", order, output)
+		}
+		last = index
+	}
+}
+
+func TestFormatGroups_Empty(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+
+	output := formatGroups(stylesService, nil)
+
+	if !strings.Contains(output, "No groups configured") {
+		t.Errorf("formatGroups() should explain that no group is configured, got:\n%s", output)
+	}
+}
@@ -1,12 +1,19 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
 	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
 	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
 )
@@ -17,6 +24,28 @@ type Handler struct {
 	statusReportUC   *usecases.StatusReportUseCase
 	manageConfigUC   usecases.ManageConfigUCI
 	stylesService    styles.Service
+	defaultCommands  map[string]string // group name -> default command
+	out              io.Writer
+	errOut           io.Writer
+	in               io.Reader
+	summaryMetrics   []string
+	clipboard        output.ClipboardPort
+	selector         output.SelectorPort
+	copied           *bytes.Buffer // output kept for --copy
+	pagerByDefault   bool
+	progress         OutputSetter
+}
+
+// OutputSetter is implemented by what writes to the terminal besides the handler, such as
+// the progress of executed commands, so that it writes wherever the handler's output goes
+type OutputSetter interface {
+	SetOutput(out io.Writer)
+}
+
+// resultSorter is implemented by the progress that can list the results of the
+// repositories sorted by name
+type resultSorter interface {
+	SetSortByName(sorted bool)
 }
 
 // NewHandler creates a new CLI handler
@@ -34,20 +63,129 @@ func NewHandler(
 	}
 }
 
+// SetOutput sets the writer the handler's output is written to, such as the presenter's
+func (h *Handler) SetOutput(out io.Writer) {
+	h.setOutput(out)
+}
+
+// SetProgress sets the progress of executed commands, which is then written to the
+// handler's output, including when it is paged
+func (h *Handler) SetProgress(progress OutputSetter) {
+	h.progress = progress
+	progress.SetOutput(h.output())
+}
+
+// setOutput changes the writer of the handler's output and of the progress
+func (h *Handler) setOutput(out io.Writer) {
+	h.out = out
+	if h.progress != nil {
+		h.progress.SetOutput(h.output())
+	}
+}
+
+// SetTerminal sets where the handler's prompts read answers from and are written to,
+// like the terminal confirmer and prompter
+func (h *Handler) SetTerminal(in io.Reader, out io.Writer) {
+	h.in = in
+	h.errOut = out
+}
+
+// SetClipboard sets the clipboard the output of commands run with --copy is copied to
+func (h *Handler) SetClipboard(clipboard output.ClipboardPort) {
+	h.clipboard = clipboard
+}
+
+// SetSelector sets the picker the repositories of commands run with --select are narrowed in
+func (h *Handler) SetSelector(selector output.SelectorPort) {
+	h.selector = selector
+}
+
+// SetPagerDefault sets whether the output goes through the pager without --pager
+func (h *Handler) SetPagerDefault(enabled bool) {
+	h.pagerByDefault = enabled
+}
+
+// SetSummaryMetrics sets the metrics of the summaries the handler prints itself
+func (h *Handler) SetSummaryMetrics(metrics []string) {
+	h.summaryMetrics = metrics
+}
+
+// presenter returns a presenter using the handler's styles and summary metrics
+func (h *Handler) presenter() *Presenter {
+	presenter := &Presenter{styles: h.stylesService, out: h.output()}
+	presenter.SetSummaryMetrics(h.summaryMetrics)
+	return presenter
+}
+
+// input returns the reader set with SetTerminal, defaulting to stdin
+func (h *Handler) input() io.Reader {
+	if h.in == nil {
+		return os.Stdin
+	}
+	return h.in
+}
+
+// output returns the writer set with SetOutput, defaulting to stdout
+func (h *Handler) output() io.Writer {
+	if h.out == nil {
+		return os.Stdout
+	}
+	return h.out
+}
+
 // Execute executes a CLI command
 func (h *Handler) Execute(ctx context.Context, args []string) error {
+	// Load group default commands so a lone group token can run its default
+	h.loadDefaultCommands(ctx)
+
 	// Parse command line arguments
 	command, err := h.parseCommand(args[1:])
 	if err != nil {
 		return errors.WrapCommandParsingError(err)
 	}
 
-	// Handle different command types
+	// Override the configured border style when requested on the command line
+	if command.Flags.BorderStyle != "" {
+		h.stylesService.SetBorderStyle(styles.GetBorderStyleFromString(command.Flags.BorderStyle))
+	}
+	if command.Flags.PathStyle != "" {
+		h.stylesService.SetPathDisplay(command.Flags.PathStyle)
+	}
+	if command.Flags.NoColor {
+		styles.DisableColors()
+	}
+
+	// Show what is printed in the pager once the command is handled
+	if h.usePager(command) {
+		defer h.startPager(ctx)()
+	}
+
+	// Keep what is printed to copy it to the clipboard once the command is handled
+	if command.Flags.Copy != "" {
+		out := h.output()
+		h.copied = &bytes.Buffer{}
+		h.out = io.MultiWriter(out, h.copied)
+		defer func() {
+			h.out = out
+			h.copyOutput(ctx, command.Flags.Copy)
+		}()
+	}
+
+	if err := h.dispatch(ctx, command); err != nil || command.Then == "" {
+		return err
+	}
+
+	// Run the built-in chained after the command on the same repositories
+	return h.dispatch(ctx, command.chained())
+}
+
+// dispatch runs the handler of the command type
+func (h *Handler) dispatch(ctx context.Context, command *Command) error {
 	switch command.Type {
 	case "config":
-		return h.handleConfig(ctx, command.Args)
+		return h.handleConfig(ctx, command)
 	case "status":
-		return h.handleStatus(ctx, command.Groups)
+		return h.handleStatus(ctx, command)
 	case "goto":
 		return h.handleGoto(ctx, command.Args)
 	case "add-repository":
@@ -60,6 +198,60 @@ func (h *Handler) Execute(ctx context.Context, args []string) error {
 		return h.handleRemoveGroup(ctx, command.Args)
 	case "execute":
 		return h.handleExecute(ctx, command)
+	case "rerun":
+		return h.handleRerun(ctx, command)
+	case "resolve":
+		return h.handleResolve(ctx, command.Groups)
+	case "diffstat":
+		return h.handleDiffStat(ctx, command.Groups)
+	case "diverged":
+		return h.handleDiverged(ctx, command.Groups)
+	case "remotes":
+		return h.handleRemotes(ctx, command.Groups)
+	case "size":
+		return h.handleSize(ctx, command)
+	case "ls-files":
+		return h.handleFileCount(ctx, command.Groups)
+	case "submodule-status":
+		return h.handleSubmoduleStatus(ctx, command.Groups)
+	case "submodule-update":
+		return h.handleSubmoduleUpdate(ctx, command)
+	case "precommit-check":
+		return h.handlePrecommitCheck(ctx, command)
+	case "tag-release":
+		return h.handleTagRelease(ctx, command)
+	case "remote-prune":
+		return h.handleRemotePrune(ctx, command.Groups)
+	case "fetch-prune-tags":
+		return h.handleFetchPruneTags(ctx, command.Groups)
+	case "branch-cleanup":
+		return h.handleBranchCleanup(ctx, command)
+	case "clean":
+		return h.handleClean(ctx, command)
+	case "reset-to-upstream":
+		return h.handleResetToUpstream(ctx, command)
+	case "rebase-onto":
+		return h.handleRebaseOnto(ctx, command)
+	case "compare-branch":
+		return h.handleCompareBranch(ctx, command)
+	case "worktree-add":
+		return h.handleWorktreeAdd(ctx, command)
+	case "amend":
+		return h.handleAmend(ctx, command)
+	case "grep":
+		return h.handleGrep(ctx, command)
+	case "count":
+		return h.handleCount(ctx, command)
+	case "apply":
+		return h.handleApply(ctx, command)
+	case "switch-remote":
+		return h.handleSwitchRemote(ctx, command)
+	case "authors":
+		return h.handleAuthors(ctx, command)
+	case "groups":
+		return h.handleGroups(ctx)
+	case "export":
+		return h.handleExport(ctx, command.Args)
 	default:
 		return errors.WrapUnknownCommandType(command.Type)
 	}
@@ -71,24 +263,65 @@ type Command struct {
 	Groups   []string
 	Args     []string
 	Parallel bool
+	InOrder  bool
+	Flags    Flags
+	Then     string
+}
+
+// chained returns the built-in command to run after the command, on the same groups
+// and with the same flags
+func (c *Command) chained() *Command {
+	return &Command{
+		Type:     c.Then,
+		Groups:   c.Groups,
+		Parallel: c.Parallel,
+		Flags:    c.Flags,
+	}
+}
+
+// isBuiltInWithArgs reports whether the name is a built-in taking its own arguments
+func isBuiltInWithArgs(name string) bool {
+	switch name {
+	case "tag-release", "branch-cleanup", "reset-to-upstream", "rebase-onto", "compare-branch", "worktree-add", "amend", "count", "switch-remote", "authors":
+		return true
+	}
+	return false
 }
 
 // parseCommand parses command line arguments
 func (h *Handler) parseCommand(args []string) (*Command, error) {
+	cmd, err := h.parseCommandArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// --dirty narrows the repositories a command runs in, which other commands do not have
+	if cmd.Flags.Dirty && cmd.Type != "execute" {
+		return nil, errors.ErrDirtyNeedsCommand
+	}
+
+	return cmd, nil
+}
+
+// parseCommandArgs parses the flags, groups and command of the arguments
+func (h *Handler) parseCommandArgs(args []string) (*Command, error) {
 	if len(args) == 0 {
 		return &Command{Type: "help"}, nil
 	}
 
-	// Filter out verbose/debug flags from arguments
-	filteredArgs := make([]string, 0, len(args))
-	for _, arg := range args {
-		if arg != "-v" && arg != "--verbose" && arg != "-d" && arg != "--debug" {
-			filteredArgs = append(filteredArgs, arg)
-		}
+	// Extract gf flags (verbose/debug, report, ...) from arguments
+	filteredArgs, flags, err := parseFlags(args)
+	if err != nil {
+		return nil, err
 	}
 
 	cmd := &Command{
 		Parallel: true, // Default to parallel execution
+		Flags:    flags,
+	}
+
+	if len(filteredArgs) == 0 {
+		return nil, errors.ErrNoGroupsSpecified
 	}
 
 	// Check for global commands first
@@ -110,6 +343,9 @@ func (h *Handler) parseCommand(args []string) (*Command, error) {
 		if len(filteredArgs) > 1 {
 			cmd.Groups = h.parseGroups(filteredArgs[1:])
 		}
+		if err := validateGroupSelections(cmd.Groups); err != nil {
+			return nil, err
+		}
 		return cmd, nil
 	case "goto":
 		cmd.Type = "goto"
@@ -117,6 +353,26 @@ func (h *Handler) parseCommand(args []string) (*Command, error) {
 			cmd.Args = filteredArgs[1:]
 		}
 		return cmd, nil
+	case "rerun":
+		cmd.Type = "rerun"
+		return cmd, nil
+	case "groups":
+		cmd.Type = "groups"
+		return cmd, nil
+	case "export":
+		cmd.Type = "export"
+		cmd.Args = filteredArgs[1:]
+		return cmd, nil
+	case "resolve":
+		cmd.Type = "resolve"
+		cmd.Groups = h.parseGroups(filteredArgs[1:])
+		if len(cmd.Groups) == 0 {
+			return nil, errors.ErrNoGroupsSpecified
+		}
+		if err := validateGroupSelections(cmd.Groups); err != nil {
+			return nil, err
+		}
+		return cmd, nil
 	case "add":
 		if len(filteredArgs) < 2 {
 			return nil, errors.ErrAddCommandRequiresSubcmd
@@ -159,7 +415,7 @@ func (h *Handler) parseCommand(args []string) (*Command, error) {
 		if strings.HasPrefix(arg, "@") {
 			// Multi-group syntax: @group1 @group2 command
 			groups = append(groups, strings.TrimPrefix(arg, "@"))
-		} else if i == 0 && !strings.HasPrefix(arg, "-") {
+		} else if i == 0 && !flags.Dirty && !strings.HasPrefix(arg, "-") {
 			// Legacy single group syntax: group command
 			groups = append(groups, arg)
 		} else {
@@ -169,16 +425,92 @@ func (h *Handler) parseCommand(args []string) (*Command, error) {
 		i++
 	}
 
+	// --dirty picks among every configured repository when no group is given
+	if len(groups) == 0 && flags.Dirty {
+		groups = append(groups, repositories.AllRepositoriesSelection)
+	}
+
 	if len(groups) == 0 {
 		return nil, errors.ErrNoGroupsSpecified
 	}
+	if err := validateGroupSelections(groups); err != nil {
+		return nil, err
+	}
+
+	// Only list the selected repositories, ignoring any command
+	if flags.NameOnly {
+		cmd.Type = "resolve"
+		cmd.Groups = groups
+		return cmd, nil
+	}
+
+	// These built-ins take their own arguments, which are not a command to run
+	if i < len(filteredArgs) && isBuiltInWithArgs(filteredArgs[i]) {
+		cmd.Type = filteredArgs[i]
+		cmd.Groups = groups
+		cmd.Args = filteredArgs[i+1:]
+		return cmd, nil
+	}
+
+	// clean alone or with --dry-run or --force is the built-in, while other options
+	// such as -fd still run git clean
+	if i < len(filteredArgs) && filteredArgs[i] == "clean" && isCleanBuiltIn(filteredArgs[i+1:]) {
+		cmd.Type = "clean"
+		cmd.Groups = groups
+		cmd.Args = filteredArgs[i+1:]
+		return cmd, nil
+	}
+
+	// apply with a single patch file and optionally --3way is the built-in checking the
+	// patch first, while other options still run git apply
+	if i < len(filteredArgs) && filteredArgs[i] == "apply" && isApplyBuiltIn(filteredArgs[i+1:]) {
+		cmd.Type = "apply"
+		cmd.Groups = groups
+		cmd.Args = filteredArgs[i+1:]
+		return cmd, nil
+	}
+
+	// grep with a single pattern and optionally --files-only is the built-in counting
+	// the matches, while other options or arguments still run git grep
+	if i < len(filteredArgs) && filteredArgs[i] == "grep" && isGrepBuiltIn(filteredArgs[i+1:]) {
+		cmd.Type = "grep"
+		cmd.Groups = groups
+		cmd.Args = filteredArgs[i+1:]
+		return cmd, nil
+	}
 
-	if i >= len(filteredArgs) {
+	// run-in-order runs the command following the repository dependencies
+	if i < len(filteredArgs) && filteredArgs[i] == "run-in-order" {
+		cmd.InOrder = true
+		i++
+	}
+
+	// Arguments after -- are run as given, never as a gf built-in
+	if i < len(filteredArgs) && filteredArgs[i] == passthroughSeparator {
+		if i+1 == len(filteredArgs) {
+			return nil, errors.ErrNoCommandSpecified
+		}
+		cmd.Type = "execute"
+		cmd.Groups = groups
+		cmd.Args = quotePassthroughArgs(filteredArgs[i+1:])
+		return cmd, nil
+	}
+
+	// Parse command arguments, falling back to the group default command
+	var cmdArgs []string
+	if i < len(filteredArgs) {
+		cmdArgs = filteredArgs[i:]
+	} else if defaultCommand := h.defaultCommandFor(groups); defaultCommand != "" {
+		cmdArgs = strings.Fields(defaultCommand)
+	} else {
 		return nil, errors.ErrNoCommandSpecified
 	}
 
-	// Parse command arguments
-	cmdArgs := filteredArgs[i:]
+	// A gf built-in chained after a git command with && runs once the command completes
+	if gitArgs, then := splitChainedBuiltIn(cmdArgs); then != "" {
+		cmd.Then = then
+		cmdArgs = gitArgs
+	}
 
 	// Special handling for built-in commands
 	if len(cmdArgs) == 1 {
@@ -187,9 +519,59 @@ func (h *Handler) parseCommand(args []string) (*Command, error) {
 			cmd.Type = "status"
 			cmd.Groups = groups
 			return cmd, nil
+		case "diffstat":
+			cmd.Type = "diffstat"
+			cmd.Groups = groups
+			return cmd, nil
+		case "diverged":
+			cmd.Type = "diverged"
+			cmd.Groups = groups
+			return cmd, nil
+		case "remotes":
+			cmd.Type = "remotes"
+			cmd.Groups = groups
+			return cmd, nil
+		case "size":
+			cmd.Type = "size"
+			cmd.Groups = groups
+			return cmd, nil
+		case "ls-files":
+			cmd.Type = "ls-files"
+			cmd.Groups = groups
+			return cmd, nil
+		case "precommit-check":
+			cmd.Type = "precommit-check"
+			cmd.Groups = groups
+			return cmd, nil
+		case "remote-prune":
+			cmd.Type = "remote-prune"
+			cmd.Groups = groups
+			return cmd, nil
 		}
 	}
 
+	// remote prune without a remote name prunes the configured remote of each repository
+	if len(cmdArgs) == 2 && cmdArgs[0] == "remote" && cmdArgs[1] == "prune" {
+		cmd.Type = "remote-prune"
+		cmd.Groups = groups
+		return cmd, nil
+	}
+
+	// submodule status and update without options run as built-ins skipping repositories
+	// without submodules, the other submodule commands being run as given
+	if len(cmdArgs) == 2 && cmdArgs[0] == "submodule" && (cmdArgs[1] == "status" || cmdArgs[1] == "update") {
+		cmd.Type = "submodule-" + cmdArgs[1]
+		cmd.Groups = groups
+		return cmd, nil
+	}
+
+	// fetch --prune-tags without a remote name reports the tags deleted in each repository
+	if isFetchPruneTags(cmdArgs) {
+		cmd.Type = "fetch-prune-tags"
+		cmd.Groups = groups
+		return cmd, nil
+	}
+
 	// Regular command execution
 	cmd.Type = "execute"
 	cmd.Groups = groups
@@ -198,6 +580,84 @@ func (h *Handler) parseCommand(args []string) (*Command, error) {
 	return cmd, nil
 }
 
+// loadDefaultCommands loads the default command of each configured group
+func (h *Handler) loadDefaultCommands(ctx context.Context) {
+	if h.manageConfigUC == nil {
+		return
+	}
+
+	groups, err := h.manageConfigUC.GetGroups(ctx)
+	if err != nil {
+		return
+	}
+
+	h.defaultCommands = make(map[string]string)
+	for _, group := range groups {
+		if group.HasDefaultCommand() {
+			h.defaultCommands[group.Name] = group.DefaultCommand
+		}
+	}
+}
+
+// defaultCommandFor returns the default command to run when a single group is given without command
+func (h *Handler) defaultCommandFor(groups []string) string {
+	if len(groups) != 1 {
+		return ""
+	}
+	selection, err := entities.ParseGroupSelection(groups[0])
+	if err != nil {
+		return ""
+	}
+	return h.defaultCommands[selection.Group]
+}
+
+// shellSpecialChars are the characters that make an argument need quoting to reach the
+// command as a single word
+const shellSpecialChars = " \t\n'\"$`&|;<>()*?\\"
+
+// quotePassthroughArgs quotes the arguments given after -- that the command parser would
+// otherwise split or hand to the shell, so that each one reaches the command unchanged
+func quotePassthroughArgs(args []string) []string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, shellSpecialChars) {
+			arg = shellQuote(arg)
+		}
+		quoted[i] = arg
+	}
+	return quoted
+}
+
+// isFetchPruneTags reports whether the command is a fetch with --prune-tags and no other
+// argument than --prune
+func isFetchPruneTags(cmdArgs []string) bool {
+	if len(cmdArgs) < 2 || cmdArgs[0] != "fetch" {
+		return false
+	}
+
+	pruneTags := false
+	for _, arg := range cmdArgs[1:] {
+		switch arg {
+		case "--prune-tags", "-P":
+			pruneTags = true
+		case "--prune", "-p":
+		default:
+			return false
+		}
+	}
+	return pruneTags
+}
+
+// validateGroupSelections checks the [start:end] range of each group that has one
+func validateGroupSelections(groups []string) error {
+	for _, group := range groups {
+		if _, err := entities.ParseGroupSelection(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // parseGroups parses group arguments
 func (h *Handler) parseGroups(args []string) []string {
 	var groups []string
@@ -212,26 +672,43 @@ func (h *Handler) parseGroups(args []string) []string {
 }
 
 // handleConfig handles configuration commands
-func (h *Handler) handleConfig(ctx context.Context, args []string) error {
+func (h *Handler) handleConfig(ctx context.Context, command *Command) error {
+	args := command.Args
+
 	// Handle config subcommands
 	if len(args) > 0 {
 		switch args[0] {
+		case "show":
+			if len(args) > 1 {
+				return errors.WrapUnknownConfigSubcommand(strings.Join(args, " "))
+			}
 		case "validate":
 			return h.manageConfigUC.ValidateConfig(ctx)
 		case "init", "create":
 			return h.manageConfigUC.CreateDefaultConfig(ctx)
 		case "discover":
 			return h.manageConfigUC.DiscoverRepositories(ctx)
+		case "backup":
+			path, err := h.manageConfigUC.BackupConfig(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(h.output(), "✅ Configuration backed up to %s\n", path)
+			return nil
+		case "restore":
+			return h.handleConfigRestore(ctx, args[1:])
 		default:
 			return errors.WrapUnknownConfigSubcommand(args[0])
 		}
 	}
 
-	// Default behavior: show config
+	// Default behavior: show config, resolved as JSON with --json
 	request := &usecases.ShowConfigInput{
 		ShowGroups:       true,
 		ShowRepositories: true,
 		ShowValidation:   false,
+		JSON:             command.Flags.JSON,
+		SortBy:           command.Flags.SortBy,
 	}
 
 	response, err := h.manageConfigUC.ShowConfig(ctx, request)
@@ -239,14 +716,64 @@ func (h *Handler) handleConfig(ctx context.Context, args []string) error {
 		return err
 	}
 
-	fmt.Print(response.FormattedOutput)
+	fmt.Fprint(h.output(), response.FormattedOutput)
+	return nil
+}
+
+// handleConfigRestore restores a configuration backup chosen by number or name,
+// prompting with the list of backups when none is given
+func (h *Handler) handleConfigRestore(ctx context.Context, args []string) error {
+	backups, err := h.manageConfigUC.ListConfigBackups(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(backups) == 0 {
+		return errors.ErrNoBackupsAvailable
+	}
+
+	var choice string
+	if len(args) > 0 {
+		choice = args[0]
+	} else {
+		if choice, err = promptBackup(h.input(), h.errOutput(), backups); err != nil {
+			return err
+		}
+		if choice == "" {
+			fmt.Fprintln(h.output(), "Restore cancelled")
+			return nil
+		}
+	}
+
+	name, err := selectBackup(choice, backups)
+	if err != nil {
+		return err
+	}
+
+	if err := h.manageConfigUC.RestoreConfig(ctx, name); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(h.output(), "✅ Configuration restored from %s\n", name)
 	return nil
 }
 
 // handleStatus handles status commands
-func (h *Handler) handleStatus(ctx context.Context, groups []string) error {
+func (h *Handler) handleStatus(ctx context.Context, command *Command) error {
+	if command.Flags.SortBy != "" && !usecases.IsValidStatusSortKey(command.Flags.SortBy) {
+		return errors.WrapInvalidSortKey(command.Flags.SortBy, usecases.StatusSortKeys)
+	}
+
+	// Commits are only looked up for JSON output, keeping the default lightweight
+	withCommit := command.Flags.JSON && command.Flags.WithCommit
+
 	request := &usecases.StatusReportInput{
-		Groups: groups,
+		Groups:     command.Groups,
+		SortBy:     command.Flags.SortBy,
+		GroupBy:    command.Flags.GroupBy,
+		WithCommit: withCommit,
+		Status:     command.Flags.Status,
+		LastOp:     command.Flags.LastOp,
 	}
 
 	response, err := h.statusReportUC.GetStatus(ctx, request)
@@ -254,7 +781,21 @@ func (h *Handler) handleStatus(ctx context.Context, groups []string) error {
 		return err
 	}
 
-	fmt.Print(response.FormattedOutput)
+	if command.Flags.JSON {
+		formatted, err := formatStatusJSON(response.Repositories, response.Summary, response.LastCommits, withCommit)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(h.output(), formatted)
+		return nil
+	}
+
+	if useCompactStatus(h.stylesService, command.Flags.Format) {
+		fmt.Fprint(h.output(), formatCompactStatus(h.stylesService, response.Repositories, command.Flags.GroupBy == usecases.GroupByTag))
+		return nil
+	}
+
+	fmt.Fprint(h.output(), response.FormattedOutput)
 	return nil
 }
 
@@ -263,99 +804,844 @@ func (h *Handler) handleExecute(ctx context.Context, command *Command) error {
 	// Create command string from args
 	commandStr := strings.Join(command.Args, " ")
 
+	var env map[string]string
+	if command.Flags.EnvFile != "" {
+		var err error
+		if env, err = readEnvFile(command.Flags.EnvFile); err != nil {
+			return err
+		}
+	}
+
 	request := &usecases.ExecuteCommandInput{
 		Groups:       command.Groups,
+		DirtyOnly:    command.Flags.Dirty,
 		CommandStr:   commandStr,
 		Parallel:     command.Parallel,
+		InOrder:      command.InOrder,
+		RepoOrder:    command.Flags.RepoOrder,
+		Env:          env,
+		Notify:       command.Flags.Notify,
+		LogDir:       command.Flags.LogDir,
+		OnBranch:     command.Flags.OnBranch,
 		AllowFailure: false,
+		FailFast:     command.Flags.FailFast,
+		SkipLocked:   command.Flags.SkipLocked,
+		Retries:      command.Flags.Retries,
+		RetryOn:      command.Flags.RetryOn,
+		CommitPrompt: command.Flags.CommitPrompt,
+		GitJobs:      command.Flags.GitJobs,
+		WorkDir:      command.Flags.WorkDir,
+		ForceUnsafe:  command.Flags.ForceUnsafe,
+		OnDirty:      command.Flags.OnDirty,
+		Confirmed:    command.Flags.Yes,
+	}
+
+	// The progress bar lists the results itself, so it is sorted as the summary is
+	if sorter, ok := h.progress.(resultSorter); ok {
+		sorter.SetSortByName(command.Flags.OrderOutput == OutputOrderName)
 	}
 
-	_, err := h.executeCommandUC.Execute(ctx, request)
+	if command.Flags.Select {
+		selected, err := h.selectRepositories(ctx, command.Groups)
+		if err != nil {
+			return err
+		}
+		request.Repositories = selected
+	}
+
+	var pullReport *usecases.PullReport
+	var output *usecases.ExecuteCommandOutput
+	var err error
+	if command.Flags.PullReport {
+		pullReport, err = h.executeCommandUC.PullFastForward(ctx, request)
+		if pullReport != nil {
+			output = pullReport.Output
+		}
+	} else {
+		output, err = h.executeCommandUC.Execute(ctx, request)
+	}
 	if err != nil {
 		return err
 	}
 
-	// The progress bar already handled the output display, so we don't need to print anything else
-	return nil
-}
+	presenter := h.presenter()
 
-// handleAddRepository handles adding a repository
-func (h *Handler) handleAddRepository(ctx context.Context, args []string) error {
-	if len(args) < 2 {
-		return errors.ErrUsageAddRepository
+	// Repositories run in parallel, so their results are collected in completion order
+	if command.Flags.OrderOutput == OutputOrderName {
+		output.Summary.Results = sortedResults(output.Summary.Results)
+		output.FormattedOutput = presenter.PresentExecutionSummary(output.Summary)
 	}
 
-	input := &usecases.AddRepositoryInput{
-		Name: args[0],
-		Path: args[1],
+	// The events are the only output written to stdout with --events jsonl
+	out := h.output()
+	if command.Flags.Events == EventsFormatJSONLines {
+		out = h.errOutput()
 	}
 
-	if err := h.manageConfigUC.AddRepository(ctx, input); err != nil {
-		return errors.WrapRepositoryOperationError(errors.ErrFailedToAddRepository, err)
+	switch {
+	case command.Flags.SummaryOnly:
+		fmt.Fprint(out, presenter.PresentSummaryOnly(output.Summary))
+	case command.Flags.DedupeOutput:
+		fmt.Fprint(out, presenter.PresentDedupedOutput(output.Summary))
+	case command.Flags.Matrix:
+		fmt.Fprint(out, presenter.PresentResultMatrix(output.Summary))
 	}
 
-	fmt.Printf("✅ Repository '%s' added successfully\n", input.Name)
-	return nil
-}
+	// List the repositories that could not fast-forward once all results are shown
+	if pullReport != nil {
+		fmt.Fprint(out, formatPullReport(h.stylesService, pullReport))
+	}
 
-// handleAddGroup handles adding a group
-func (h *Handler) handleAddGroup(ctx context.Context, args []string) error {
-	if len(args) < 2 {
-		return errors.ErrUsageAddGroup
+	// The progress bar displays the results itself, so the formatted summary is copied instead
+	if h.copied != nil && !command.Flags.SummaryOnly && !command.Flags.DedupeOutput && !command.Flags.Matrix {
+		h.copied.WriteString(output.FormattedOutput)
 	}
 
-	input := &usecases.AddGroupInput{
-		Name:         args[0],
-		Repositories: args[1:],
+	if command.Flags.ReportPath != "" {
+		report := &Report{
+			Command: commandStr,
+			Groups:  command.Groups,
+			Summary: output.Summary,
+		}
+		if err := writeReport(command.Flags.ReportPath, report); err != nil {
+			return err
+		}
 	}
 
-	if err := h.manageConfigUC.AddGroup(ctx, input); err != nil {
-		return errors.WrapRepositoryOperationError(errors.ErrFailedToAddGroup, err)
+	// Without per-repository output, failures are only visible through the exit code
+	if command.Flags.SummaryOnly && !output.Success {
+		return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
 	}
 
-	fmt.Printf("✅ Group '%s' added successfully with %d repositories\n", input.Name, len(input.Repositories))
+	// The progress bar already handled the output display, so we don't need to print anything else
 	return nil
 }
 
-// handleRemoveRepository handles removing a repository
-func (h *Handler) handleRemoveRepository(ctx context.Context, args []string) error {
-	if len(args) < 1 {
-		return errors.ErrUsageRemoveRepository
+// handleRerun re-executes the command of a previous report on its failed repositories
+func (h *Handler) handleRerun(ctx context.Context, command *Command) error {
+	if command.Flags.ReportPath == "" {
+		return errors.ErrUsageRerun
 	}
 
-	name := args[0]
+	report, err := readReport(command.Flags.ReportPath)
+	if err != nil {
+		return err
+	}
 
-	if err := h.manageConfigUC.RemoveRepository(ctx, name); err != nil {
-		return errors.WrapRepositoryOperationError(errors.ErrFailedToRemoveRepository, err)
+	failed := report.FailedRepositories()
+	if len(failed) == 0 {
+		fmt.Fprintln(h.output(), "✅ No failed repositories to re-run")
+		return nil
 	}
 
-	fmt.Printf("✅ Repository '%s' removed successfully\n", name)
-	return nil
+	request := &usecases.ExecuteCommandInput{
+		Groups:       report.Groups,
+		Repositories: failed,
+		CommandStr:   report.Command,
+		Parallel:     command.Parallel,
+		AllowFailure: false,
+		Confirmed:    command.Flags.Yes,
+	}
+
+	_, err = h.executeCommandUC.Execute(ctx, request)
+	return err
 }
 
-// handleRemoveGroup handles removing a group
-func (h *Handler) handleRemoveGroup(ctx context.Context, args []string) error {
-	if len(args) < 1 {
-		return errors.ErrUsageRemoveGroup
+// handleResolve prints the repositories selected by the groups, one per line
+func (h *Handler) handleResolve(ctx context.Context, groups []string) error {
+	repos, err := h.manageConfigUC.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
 	}
 
-	name := args[0]
-
-	if err := h.manageConfigUC.RemoveGroup(ctx, name); err != nil {
-		return errors.WrapRepositoryOperationError(errors.ErrFailedToRemoveGroup, err)
+	for _, repo := range repos {
+		fmt.Fprintln(h.output(), repo.Name)
 	}
 
-	fmt.Printf("✅ Group '%s' removed successfully\n", name)
 	return nil
 }
 
-// handleGoto handles the goto command to return repository paths
-func (h *Handler) handleGoto(ctx context.Context, args []string) error {
-	if len(args) < 1 {
-		return errors.ErrUsageGoto
+// handleDiffStat prints the insertions and deletions of each repository in the groups
+func (h *Handler) handleDiffStat(ctx context.Context, groups []string) error {
+	stats, err := h.statusReportUC.GetDiffStats(ctx, groups)
+	if err != nil {
+		return err
 	}
 
-	repoName := args[0]
+	fmt.Fprint(h.output(), formatDiffStats(h.stylesService, stats))
+	return nil
+}
+
+// handleDiverged prints the repositories in the groups that are both ahead and behind
+// their upstream
+func (h *Handler) handleDiverged(ctx context.Context, groups []string) error {
+	diverged, err := h.statusReportUC.GetDivergedRepositories(ctx, groups)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatDivergedRepositories(h.stylesService, diverged))
+	return nil
+}
+
+// handleCompareBranch prints how many commits the current branch of each repository in the
+// groups is ahead and behind of the branch, failing when a repository could not be compared
+func (h *Handler) handleCompareBranch(ctx context.Context, command *Command) error {
+	branch, err := parseCompareBranchArgs(command.Args)
+	if err != nil {
+		return err
+	}
+
+	comparisons, err := h.statusReportUC.CompareBranch(ctx, command.Groups, branch)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatBranchComparisons(h.stylesService, branch, comparisons))
+
+	failed := 0
+	for _, comparison := range comparisons {
+		if comparison.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(comparisons))
+	}
+
+	return nil
+}
+
+// handleRemotes prints the remotes of the repositories in the groups, failing when the
+// remotes of a repository could not be read
+func (h *Handler) handleRemotes(ctx context.Context, groups []string) error {
+	remotes, err := h.statusReportUC.GetRemotes(ctx, groups)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatRemotes(h.stylesService, remotes))
+
+	failed := 0
+	for _, repo := range remotes {
+		if repo.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(remotes))
+	}
+
+	return nil
+}
+
+// handleSize prints the disk usage of each repository in the groups, largest first
+func (h *Handler) handleSize(ctx context.Context, command *Command) error {
+	sizes, err := h.statusReportUC.GetSizes(ctx, command.Groups, command.Flags.GitOnly)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatSizes(h.stylesService, sizes, command.Flags.GitOnly))
+	return nil
+}
+
+// handleFileCount prints the number of tracked files of each repository in the groups
+func (h *Handler) handleFileCount(ctx context.Context, groups []string) error {
+	counts, err := h.statusReportUC.GetTrackedFileCounts(ctx, groups)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatFileCounts(h.stylesService, counts))
+	return nil
+}
+
+// handleSubmoduleStatus prints the number of submodules of each repository in the groups
+// by state
+func (h *Handler) handleSubmoduleStatus(ctx context.Context, groups []string) error {
+	statuses, err := h.statusReportUC.GetSubmoduleStatuses(ctx, groups)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatSubmoduleStatuses(h.stylesService, statuses))
+	return nil
+}
+
+// handleSubmoduleUpdate initializes and recursively updates the submodules of the
+// repositories in the groups
+func (h *Handler) handleSubmoduleUpdate(ctx context.Context, command *Command) error {
+	output, err := h.executeCommandUC.UpdateSubmodules(ctx, command.Groups)
+	if err != nil {
+		return err
+	}
+
+	if command.Flags.SummaryOnly {
+		presenter := h.presenter()
+		fmt.Fprint(h.output(), presenter.PresentSummaryOnly(output.Summary))
+		if !output.Success {
+			return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
+		}
+	}
+
+	return nil
+}
+
+// handlePrecommitCheck prints the untracked files above the size threshold in each repository
+// of the groups, and fails when any is found so that it can guard a push
+func (h *Handler) handlePrecommitCheck(ctx context.Context, command *Command) error {
+	threshold := command.Flags.MaxSize
+	if threshold == 0 {
+		threshold = usecases.DefaultLargeFileThreshold
+	}
+
+	reports, err := h.statusReportUC.GetLargeUntrackedFiles(ctx, command.Groups, threshold)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatLargeFiles(h.stylesService, reports, threshold))
+
+	for _, report := range reports {
+		if len(report.Files) > 0 {
+			return errors.ErrLargeUntrackedFiles
+		}
+	}
+
+	return nil
+}
+
+// handleTagRelease tags a release in each repository of the groups and pushes the tag
+func (h *Handler) handleTagRelease(ctx context.Context, command *Command) error {
+	request, err := parseTagReleaseArgs(command.Args)
+	if err != nil {
+		return err
+	}
+	request.Groups = command.Groups
+
+	output, err := h.executeCommandUC.TagRelease(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if command.Flags.SummaryOnly {
+		presenter := h.presenter()
+		fmt.Fprint(h.output(), presenter.PresentSummaryOnly(output.Summary))
+		if !output.Success {
+			return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
+		}
+	}
+
+	return nil
+}
+
+// handleRemotePrune prunes the stale remote-tracking branches of the repositories in the groups
+// and prints how many were pruned in each, failing when a repository could not be pruned
+func (h *Handler) handleRemotePrune(ctx context.Context, groups []string) error {
+	prunes, err := h.executeCommandUC.PruneRemotes(ctx, groups)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatRemotePrunes(h.stylesService, prunes))
+
+	failed := 0
+	for _, prune := range prunes {
+		if prune.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(prunes))
+	}
+
+	return nil
+}
+
+// handleFetchPruneTags fetches the repositories in the groups with --prune-tags and prints
+// how many tags were deleted in each, failing when a repository could not be fetched
+func (h *Handler) handleFetchPruneTags(ctx context.Context, groups []string) error {
+	prunes, err := h.executeCommandUC.PruneTags(ctx, groups)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatTagPrunes(h.stylesService, prunes))
+
+	failed := 0
+	for _, prune := range prunes {
+		if prune.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(prunes))
+	}
+
+	return nil
+}
+
+// handleCount prints the number of lines matching a pattern in each repository of the
+// groups, failing when a repository could not be searched
+func (h *Handler) handleCount(ctx context.Context, command *Command) error {
+	pattern, err := parseCountArgs(command.Args)
+	if err != nil {
+		return err
+	}
+
+	counts, err := h.executeCommandUC.CountMatches(ctx, command.Groups, pattern)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatCounts(h.stylesService, pattern, counts))
+
+	failed := 0
+	for _, count := range counts {
+		if count.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(counts))
+	}
+
+	return nil
+}
+
+// handleGrep searches the repositories in the groups and prints their matches,
+// failing when a repository could not be searched
+func (h *Handler) handleGrep(ctx context.Context, command *Command) error {
+	request, err := parseGrepArgs(command.Args)
+	if err != nil {
+		return err
+	}
+	request.Groups = command.Groups
+
+	greps, err := h.executeCommandUC.Grep(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatGreps(h.stylesService, request.Pattern, greps, request.FilesOnly))
+
+	failed := 0
+	for _, grep := range greps {
+		if grep.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(greps))
+	}
+
+	return nil
+}
+
+// handleAuthors prints the authors of the repositories in the groups ranked by commits,
+// failing when the log of a repository could not be read
+func (h *Handler) handleAuthors(ctx context.Context, command *Command) error {
+	since, err := parseAuthorsArgs(command.Args)
+	if err != nil {
+		return err
+	}
+
+	report, err := h.statusReportUC.GetAuthors(ctx, command.Groups, since)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatAuthors(h.stylesService, report, since))
+
+	if len(report.Failed) > 0 {
+		return errors.WrapCommandFailed(len(report.Failed), report.Repositories)
+	}
+
+	return nil
+}
+
+// handleBranchCleanup deletes the merged local branches of the repositories in the groups,
+// or only lists them with --dry-run, failing when a repository could not be cleaned up
+func (h *Handler) handleBranchCleanup(ctx context.Context, command *Command) error {
+	dryRun := false
+	for _, arg := range command.Args {
+		if arg != "--dry-run" {
+			return errors.ErrUsageBranchCleanup
+		}
+		dryRun = true
+	}
+
+	cleanups, err := h.executeCommandUC.CleanupBranches(ctx, command.Groups, dryRun)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatBranchCleanups(h.stylesService, cleanups, dryRun))
+
+	failed := 0
+	for _, cleanup := range cleanups {
+		if cleanup.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(cleanups))
+	}
+
+	return nil
+}
+
+// handleClean lists the untracked files of the repositories in the groups, removing them
+// with --force once confirmed, and fails when a repository could not be cleaned
+func (h *Handler) handleClean(ctx context.Context, command *Command) error {
+	request, err := parseCleanArgs(command.Args)
+	if err != nil {
+		return err
+	}
+	request.Groups = command.Groups
+	request.Confirmed = command.Flags.Yes
+
+	cleans, err := h.executeCommandUC.Clean(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatCleans(h.stylesService, cleans, !request.Force))
+
+	failed := 0
+	for _, clean := range cleans {
+		if clean.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(cleans))
+	}
+
+	return nil
+}
+
+// handleSwitchRemote sets the URL of a remote in the repositories in the groups, or only
+// previews the new URLs with --dry-run, failing when a repository could not be switched
+func (h *Handler) handleSwitchRemote(ctx context.Context, command *Command) error {
+	request := &usecases.SwitchRemoteInput{Groups: command.Groups}
+	var positional []string
+	for _, arg := range command.Args {
+		if arg == "--dry-run" {
+			request.DryRun = true
+			continue
+		}
+		positional = append(positional, arg)
+	}
+	if len(positional) != 2 {
+		return errors.ErrUsageSwitchRemote
+	}
+	request.Remote, request.URL = positional[0], positional[1]
+
+	switches, err := h.executeCommandUC.SwitchRemotes(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatRemoteSwitches(h.stylesService, request.Remote, switches, request.DryRun))
+
+	failed := 0
+	for _, remoteSwitch := range switches {
+		if remoteSwitch.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.WrapCommandFailed(failed, len(switches))
+	}
+
+	return nil
+}
+
+// handleResetToUpstream hard-resets the repositories in the groups to their upstream,
+// skipping those with local work unless --force is given
+func (h *Handler) handleResetToUpstream(ctx context.Context, command *Command) error {
+	force := false
+	for _, arg := range command.Args {
+		if arg != "--force" {
+			return errors.ErrUsageResetToUpstream
+		}
+		force = true
+	}
+
+	request := &usecases.ResetToUpstreamInput{
+		Groups:    command.Groups,
+		Force:     force,
+		Confirmed: command.Flags.Yes,
+	}
+
+	output, err := h.executeCommandUC.ResetToUpstream(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if command.Flags.SummaryOnly {
+		presenter := h.presenter()
+		fmt.Fprint(h.output(), presenter.PresentSummaryOnly(output.Summary))
+		if !output.Success {
+			return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
+		}
+	}
+
+	return nil
+}
+
+// handleRebaseOnto fetches the repositories in the groups and rebases them onto the
+// branch, then lists those whose rebase stopped on conflicts
+func (h *Handler) handleRebaseOnto(ctx context.Context, command *Command) error {
+	request, err := parseRebaseOntoArgs(command.Args)
+	if err != nil {
+		return err
+	}
+	request.Groups = command.Groups
+
+	output, err := h.executeCommandUC.RebaseOnto(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if command.Flags.SummaryOnly {
+		presenter := h.presenter()
+		fmt.Fprint(h.output(), presenter.PresentSummaryOnly(output.Summary))
+		if !output.Success {
+			return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
+		}
+		return nil
+	}
+
+	fmt.Fprint(h.output(), formatRebaseReport(h.stylesService, request.Branch, output.Summary))
+
+	return nil
+}
+
+// handleAmend amends the last commit of the repositories in the groups, skipping those
+// whose commit is already pushed unless --force is given
+func (h *Handler) handleAmend(ctx context.Context, command *Command) error {
+	request, err := parseAmendArgs(command.Args)
+	if err != nil {
+		return err
+	}
+	request.Groups = command.Groups
+	request.Confirmed = command.Flags.Yes
+
+	output, err := h.executeCommandUC.Amend(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if command.Flags.SummaryOnly {
+		presenter := h.presenter()
+		fmt.Fprint(h.output(), presenter.PresentSummaryOnly(output.Summary))
+		if !output.Success {
+			return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
+		}
+	}
+
+	return nil
+}
+
+// handleApply applies a patch file to the repositories in the groups, skipping those
+// where it does not apply cleanly
+func (h *Handler) handleApply(ctx context.Context, command *Command) error {
+	request, err := parseApplyArgs(command.Args)
+	if err != nil {
+		return err
+	}
+	request.Groups = command.Groups
+
+	output, err := h.executeCommandUC.ApplyPatch(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if command.Flags.SummaryOnly {
+		presenter := h.presenter()
+		fmt.Fprint(h.output(), presenter.PresentSummaryOnly(output.Summary))
+		if !output.Success {
+			return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
+		}
+	}
+
+	return nil
+}
+
+// handleWorktreeAdd adds a worktree of the branch in each repository of the groups
+func (h *Handler) handleWorktreeAdd(ctx context.Context, command *Command) error {
+	if len(command.Args) != 1 {
+		return errors.ErrUsageWorktreeAdd
+	}
+
+	request := &usecases.WorktreeAddInput{
+		Groups: command.Groups,
+		Branch: command.Args[0],
+	}
+
+	output, err := h.executeCommandUC.AddWorktrees(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), output.FormattedOutput)
+	if !output.Success {
+		return errors.WrapCommandFailed(output.Summary.FailedCount(), output.Summary.TotalCount())
+	}
+
+	return nil
+}
+
+// handleGroups prints the configured groups with their number of repositories
+func (h *Handler) handleGroups(ctx context.Context) error {
+	groups, err := h.manageConfigUC.GetGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(h.output(), formatGroups(h.stylesService, groups))
+	return nil
+}
+
+// handleExport prints the configured repositories in the format of another tool
+func (h *Handler) handleExport(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.ErrUsageExport
+	}
+
+	export, exists := exporters[args[0]]
+	if !exists {
+		return errors.WrapUnsupportedExportFormat(args[0], exportFormats())
+	}
+
+	repos, err := h.manageConfigUC.GetRepositories(ctx)
+	if err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToGetRepositories, err)
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Name < repos[j].Name
+	})
+
+	entries := make([]ExportEntry, 0, len(repos))
+	for _, repo := range repos {
+		// Repositories whose remote cannot be read are still exported, without checkout
+		remoteURL, _ := h.statusReportUC.GetRemoteURL(ctx, repo)
+		entries = append(entries, ExportEntry{
+			Name:      repo.Name,
+			Path:      repo.Path,
+			RemoteURL: remoteURL,
+		})
+	}
+
+	fmt.Fprint(h.output(), export(entries))
+	return nil
+}
+
+// handleAddRepository handles adding a repository
+func (h *Handler) handleAddRepository(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return errors.ErrUsageAddRepository
+	}
+
+	input := &usecases.AddRepositoryInput{
+		Name: args[0],
+		Path: args[1],
+	}
+
+	if err := h.manageConfigUC.AddRepository(ctx, input); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToAddRepository, err)
+	}
+
+	fmt.Fprintf(h.output(), "✅ Repository '%s' added successfully\n", input.Name)
+	return nil
+}
+
+// handleAddGroup handles adding a group
+func (h *Handler) handleAddGroup(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return errors.ErrUsageAddGroup
+	}
+
+	input := &usecases.AddGroupInput{
+		Name:         args[0],
+		Repositories: args[1:],
+	}
+
+	if err := h.manageConfigUC.AddGroup(ctx, input); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToAddGroup, err)
+	}
+
+	fmt.Fprintf(h.output(), "✅ Group '%s' added successfully with %d repositories\n", input.Name, len(input.Repositories))
+	return nil
+}
+
+// handleRemoveRepository handles removing a repository
+func (h *Handler) handleRemoveRepository(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return errors.ErrUsageRemoveRepository
+	}
+
+	name := args[0]
+
+	if err := h.manageConfigUC.RemoveRepository(ctx, name); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToRemoveRepository, err)
+	}
+
+	fmt.Fprintf(h.output(), "✅ Repository '%s' removed successfully\n", name)
+	return nil
+}
+
+// handleRemoveGroup handles removing a group
+func (h *Handler) handleRemoveGroup(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return errors.ErrUsageRemoveGroup
+	}
+
+	name := args[0]
+
+	if err := h.manageConfigUC.RemoveGroup(ctx, name); err != nil {
+		return errors.WrapRepositoryOperationError(errors.ErrFailedToRemoveGroup, err)
+	}
+
+	fmt.Fprintf(h.output(), "✅ Group '%s' removed successfully\n", name)
+	return nil
+}
+
+// handleGoto handles the goto command to return repository paths
+func (h *Handler) handleGoto(ctx context.Context, args []string) error {
+	// --print-path is the default behavior, accepted for shell integrations;
+	// -e matches the argument as a regular expression instead of fuzzily
+	useRegexp := false
+	pathArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg {
+		case "--print-path":
+		case "-e", "--regexp":
+			useRegexp = true
+		default:
+			pathArgs = append(pathArgs, arg)
+		}
+	}
+	args = pathArgs
+
+	if len(args) < 1 {
+		return errors.ErrUsageGoto
+	}
+
+	repoName := args[0]
+
+	// Reject an invalid pattern before loading any repository
+	var pattern *regexp.Regexp
+	if useRegexp {
+		var err error
+		if pattern, err = regexp.Compile(repoName); err != nil {
+			return errors.WrapInvalidRepositoryRegexp(repoName, err)
+		}
+	}
 
 	// Get repositories from config
 	repos, err := h.manageConfigUC.GetRepositories(ctx)
@@ -367,10 +1653,19 @@ func (h *Handler) handleGoto(ctx context.Context, args []string) error {
 		return errors.WrapRepositoryNotFound(repoName)
 	}
 
+	if pattern != nil {
+		repo, err := matchRepositoryRegexp(pattern, repos)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(h.output(), repo.Path)
+		return nil
+	}
+
 	// First try exact match
 	for _, repo := range repos {
 		if repo.Name == repoName {
-			fmt.Print(repo.Path)
+			fmt.Fprint(h.output(), repo.Path)
 			return nil
 		}
 	}
@@ -394,10 +1689,35 @@ func (h *Handler) handleGoto(ctx context.Context, args []string) error {
 	}
 
 	// Just print the path - no styling or additional output
-	fmt.Print(bestMatch.Path)
+	fmt.Fprint(h.output(), bestMatch.Path)
 	return nil
 }
 
+// matchRepositoryRegexp returns the only repository whose name matches the pattern
+func matchRepositoryRegexp(pattern *regexp.Regexp, repos []*entities.Repository) (*entities.Repository, error) {
+	var matches []*entities.Repository
+	for _, repo := range repos {
+		if pattern.MatchString(repo.Name) {
+			matches = append(matches, repo)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, errors.WrapRepositoryNotFound(pattern.String())
+	case 1:
+		return matches[0], nil
+	}
+
+	names := make([]string, len(matches))
+	for i, repo := range matches {
+		names[i] = repo.Name
+	}
+	sort.Strings(names)
+
+	return nil, errors.WrapAmbiguousRepositoryName(pattern.String(), names)
+}
+
 // calculateSimilarity calculates the similarity between two strings
 // Returns a score between 0 and 1, where 1 is identical
 func (h *Handler) calculateSimilarity(a, b string) float64 {
@@ -415,9 +1735,12 @@ func (h *Handler) calculateSimilarity(a, b string) float64 {
 		return 0.0
 	}
 
-	// Check if one string contains the other
-	if strings.Contains(b, a) || strings.Contains(a, b) {
-		return 0.9 // High score for substring matches
+	// Check if one string contains the other, preferring matches of whole tokens
+	substring := 0.0
+	if strings.Contains(b, a) {
+		substring = substringSimilarity(a, b)
+	} else if strings.Contains(a, b) {
+		substring = substringSimilarity(b, a)
 	}
 
 	// Check if they start with the same prefix
@@ -451,10 +1774,59 @@ func (h *Handler) calculateSimilarity(a, b string) float64 {
 
 	// Boost similarity for strings that differ by only a few characters
 	if distance == 1 && maxLen > 3 {
-		similarity = 0.85 // High similarity for single character differences
+		similarity = typoScore
 	}
 
-	return similarity
+	// Substring matches score above typos, so the best of both signals is kept
+	return max(substring, similarity)
+}
+
+// typoScore is the similarity of names differing by a single character
+const typoScore = 0.85
+
+// Scores of substring matches, by how the match lines up with the tokens of the name.
+// All of them rank above typoScore, since the query is an exact part of the name.
+const (
+	tokenMatchScore    = 0.98 // covers whole tokens, like api in api-gateway
+	tokenPrefixScore   = 0.95 // starts a token, like api in apis-v2
+	tokenSuffixScore   = 0.92 // ends a token, like api in openapi-spec
+	embeddedMatchScore = 0.9  // inside a token, like api in rapidapi
+)
+
+// substringSimilarity scores the best occurrence of needle in haystack, where matches
+// starting and ending at token boundaries rank higher than matches inside a token
+func substringSimilarity(needle, haystack string) float64 {
+	best := 0.0
+	for offset := 0; offset <= len(haystack)-len(needle); {
+		index := strings.Index(haystack[offset:], needle)
+		if index < 0 {
+			break
+		}
+		start := offset + index
+		end := start + len(needle)
+
+		startsToken := start == 0 || isTokenSeparator(haystack[start-1]) || isTokenSeparator(needle[0])
+		endsToken := end == len(haystack) || isTokenSeparator(haystack[end]) || isTokenSeparator(needle[len(needle)-1])
+
+		score := embeddedMatchScore
+		switch {
+		case startsToken && endsToken:
+			score = tokenMatchScore
+		case startsToken:
+			score = tokenPrefixScore
+		case endsToken:
+			score = tokenSuffixScore
+		}
+		best = max(best, score)
+
+		offset = start + 1
+	}
+	return best
+}
+
+// isTokenSeparator reports whether the character separates the tokens of a repository name
+func isTokenSeparator(c byte) bool {
+	return c == '-' || c == '_' || c == '/'
 }
 
 // levenshteinDistance calculates the Levenshtein distance between two strings
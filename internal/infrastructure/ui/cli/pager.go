@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// DefaultPager is the pager used when $PAGER is not set. It keeps the colors and exits
+// at once when the output fits on the screen.
+const DefaultPager = "less -FRX"
+
+// usePager reports whether the output of the command goes through the pager: when asked
+// with --pager or by the configuration, and only on a terminal with colors enabled
+func (h *Handler) usePager(command *Command) bool {
+	if command.Flags.NoPager || !(command.Flags.Pager || h.pagerByDefault) {
+		return false
+	}
+	if command.Flags.NoColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	file, ok := h.output().(*os.File)
+	return ok && term.IsTerminal(int(file.Fd()))
+}
+
+// pagerCommand returns the pager command line and its arguments, from $PAGER or the default
+func pagerCommand() []string {
+	if args := strings.Fields(os.Getenv("PAGER")); len(args) > 0 {
+		return args
+	}
+	return strings.Fields(DefaultPager)
+}
+
+// page shows the text in the pager, or writes it to out when the pager cannot run
+func (h *Handler) page(ctx context.Context, text string, out io.Writer) {
+	if text == "" {
+		return
+	}
+
+	args := pagerCommand()
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = out
+	cmd.Stderr = h.errOutput()
+
+	if err := cmd.Run(); err != nil {
+		// The pager did not start or show anything, so the output is printed instead
+		if _, ok := err.(*exec.ExitError); !ok {
+			fmt.Fprint(out, text)
+		}
+	}
+}
+
+// startPager collects the output of the command and returns the function showing it in
+// the pager once the command is handled
+func (h *Handler) startPager(ctx context.Context) func() {
+	out := h.output()
+	paged := &bytes.Buffer{}
+	h.setOutput(paged)
+
+	return func() {
+		h.setOutput(out)
+		h.page(ctx, paged.String(), out)
+	}
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPagerCommand(t *testing.T) {
+	t.Setenv("PAGER", "")
+	if got := pagerCommand(); !reflect.DeepEqual(got, []string{"less", "-FRX"}) {
+		t.Errorf("pagerCommand() = %v, want the default pager", got)
+	}
+
+	t.Setenv("PAGER", "more -d")
+	if got := pagerCommand(); !reflect.DeepEqual(got, []string{"more", "-d"}) {
+		t.Errorf("pagerCommand() = %v, want $PAGER", got)
+	}
+}
+
+func TestHandler_Page(t *testing.T) {
+	tests := []struct {
+		name  string
+		pager string
+	}{
+		{"through the pager", "cat"},
+		{"printed when the pager cannot run", "gf-missing-pager"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PAGER", tt.pager)
+			var out bytes.Buffer
+
+			(&Handler{}).page(context.Background(), "\x1b[1mrepo1\x1b[0m clean\n", &out)
+
+			if out.String() != "\x1b[1mrepo1\x1b[0m clean\n" {
+				t.Errorf("page() wrote %q, want the styled text once", out.String())
+			}
+		})
+	}
+}
+
+func TestHandler_UsePager(t *testing.T) {
+	handler := &Handler{out: &bytes.Buffer{}, pagerByDefault: true}
+
+	if handler.usePager(&Command{Flags: Flags{Pager: true}}) {
+		t.Error("usePager() should be false when the output is not a terminal")
+	}
+
+	handler.out = nil
+	if handler.usePager(&Command{Flags: Flags{NoPager: true}}) {
+		t.Error("usePager() should be false with --no-pager")
+	}
+	if handler.usePager(&Command{Flags: Flags{Pager: true, NoColor: true}}) {
+		t.Error("usePager() should be false with --no-color")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if handler.usePager(&Command{Flags: Flags{Pager: true}}) {
+		t.Error("usePager() should be false when colors are disabled")
+	}
+}
+
+func TestHandler_PagesExecutionProgress(t *testing.T) {
+	t.Setenv("PAGER", "cat")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	progress := &recordingOutput{}
+	executeCommandUC := newExecuteUseCase(ctrl, func() {
+		// The executor reports the progress, including the final results
+		fmt.Fprintln(progress.out, "✓ repo1")
+	})
+
+	var out bytes.Buffer
+	handler := NewHandler(executeCommandUC, nil, nil, styles.NewService("fleet"))
+	handler.SetOutput(&out)
+	handler.SetProgress(progress)
+
+	ctx := context.Background()
+	showPager := handler.startPager(ctx)
+	command := &Command{Type: "execute", Groups: []string{"backend"}, Args: []string{"log"}, Parallel: true}
+	if err := handler.handleExecute(ctx, command); err != nil {
+		t.Fatalf("handleExecute() error = %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("the progress should be kept for the pager while the command runs, got %q", out.String())
+	}
+
+	showPager()
+	if !strings.Contains(out.String(), "✓ repo1") {
+		t.Errorf("the pager should show the progress of the execution, got %q", out.String())
+	}
+	if progress.out != &out {
+		t.Errorf("the progress should be written to the output again once paged, got %v", progress.out)
+	}
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatLargeFiles renders the untracked files above the threshold as a table,
+// with one clean row for each repository without offenders
+func formatLargeFiles(stylesService styles.Service, reports []*usecases.RepositoryLargeFiles, threshold int64) string {
+	var result bytes.Buffer
+
+	title := fmt.Sprintf("🛡️ Pre-commit Check (untracked files over %s)", formatByteSize(threshold))
+	result.WriteString(stylesService.GetTitleStyle().Render(title) + "\n\n")
+
+	headers := []string{"Repository", "File", "Size"}
+	rows := make([][]string, 0, len(reports))
+
+	offenders := 0
+	for _, report := range reports {
+		switch {
+		case report.Error != "":
+			rows = append(rows, []string{report.Repository, "❌ Error", "-"})
+		case len(report.Files) == 0:
+			rows = append(rows, []string{report.Repository, "✅ Clean", "-"})
+		default:
+			for _, file := range report.Files {
+				rows = append(rows, []string{report.Repository, "⚠️ " + file.Path, formatByteSize(file.Size)})
+			}
+			offenders += len(report.Files)
+		}
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+
+	if offenders > 0 {
+		result.WriteString(stylesService.GetErrorStyle().Render(
+			fmt.Sprintf("⚠️ %d large untracked files, add them to .gitignore or commit them on purpose", offenders)) + "\n")
+	}
+
+	return result.String()
+}
+
+// formatByteSize formats a number of bytes with a binary unit, such as 1.5 MB
+func formatByteSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	value := float64(size) / unit
+	for _, suffix := range []string{"KB", "MB", "GB"} {
+		if value < unit || suffix == "GB" {
+			return fmt.Sprintf("%.1f %s", value, suffix)
+		}
+		value /= unit
+	}
+
+	return fmt.Sprintf("%d B", size)
+}
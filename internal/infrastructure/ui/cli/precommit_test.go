@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatLargeFiles(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	reports := []*usecases.RepositoryLargeFiles{
+		{Repository: "api", Files: []repositories.UntrackedFile{{Path: "dist/app.bin", Size: 12 << 20}}},
+		{Repository: "web"},
+		{Repository: "broken", Error: "not a git repository"},
+	}
+
+	output := formatLargeFiles(stylesService, reports, 5<<20)
+
+	for _, want := range []string{"over 5.0 MB", "dist/app.bin", "12.0 MB", "✅ Clean", "❌ Error", "1 large untracked files"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatLargeFiles() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	tests := []struct {
+		size     int64
+		expected string
+	}{
+		{size: 512, expected: "512 B"},
+		{size: 1536, expected: "1.5 KB"},
+		{size: 5 << 20, expected: "5.0 MB"},
+		{size: 3 << 30, expected: "3.0 GB"},
+		{size: 4096 << 30, expected: "4096.0 GB"},
+	}
+
+	for _, tt := range tests {
+		if got := formatByteSize(tt.size); got != tt.expected {
+			t.Errorf("formatByteSize(%d) = %q, want %q", tt.size, got, tt.expected)
+		}
+	}
+}
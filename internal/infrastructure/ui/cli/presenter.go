@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -16,16 +20,37 @@ import (
 
 // Presenter implements the OutputPort interface for CLI
 type Presenter struct {
-	styles styles.Service
+	styles  styles.Service
+	out     io.Writer
+	metrics []string
 }
 
-// NewPresenter creates a new CLI presenter
+// NewPresenter creates a new CLI presenter writing to stdout
 func NewPresenter(styles styles.Service) output.PresenterPort {
+	return NewPresenterWithOutput(styles, os.Stdout)
+}
+
+// NewPresenterWithOutput creates a new CLI presenter writing to the given writer
+func NewPresenterWithOutput(styles styles.Service, out io.Writer) output.PresenterPort {
 	return &Presenter{
 		styles: styles,
+		out:    out,
 	}
 }
 
+// Output returns the writer presented information is written to
+func (p *Presenter) Output() io.Writer {
+	return p.out
+}
+
+// SetSummaryMetrics sets the metrics of the summary statistics, in order, and returns the
+// unknown metric names, which are ignored. Without known metrics the defaults are shown.
+func (p *Presenter) SetSummaryMetrics(metrics []string) []string {
+	known, unknown := splitSummaryMetrics(metrics)
+	p.metrics = known
+	return unknown
+}
+
 // PresentExecutionSummary presents the execution summary
 func (p *Presenter) PresentExecutionSummary(summary *entities.Summary) string {
 	var result bytes.Buffer
@@ -38,21 +63,41 @@ func (p *Presenter) PresentExecutionSummary(summary *entities.Summary) string {
 		headers := []string{"Repository", "Status", "Duration", "Output"}
 		rows := make([][]string, 0, len(summary.Results))
 
+		// Exit codes are only worth a column when some command exited with a non-zero one
+		withExitCodes := slices.ContainsFunc(summary.Results, func(res entities.ExecutionResult) bool {
+			return res.IsFailed() && res.ExitCode > 0
+		})
+		if withExitCodes {
+			headers = slices.Insert(headers, 2, "Exit")
+		}
+
 		for _, res := range summary.Results {
 			status := "✅ Success"
-			if res.IsFailed() {
+			if res.IsSuccess() && res.HasWarning() {
+				status = "⚠️ Warning"
+			} else if res.IsHookFailure() {
+				status = "🪝 Hook Rejected"
+			} else if res.IsFailed() {
 				status = "❌ Failed"
 			} else if res.IsCancelled() {
 				status = "⏹️ Cancelled"
 			} else if res.IsTimeout() {
 				status = "⏱️ Timeout"
+			} else if res.IsSkipped() {
+				status = "⏭️ Skipped"
+			}
+			if res.Attempts > 1 {
+				status += fmt.Sprintf(" (%d attempts)", res.Attempts)
 			}
 
 			output := res.Output
+			if res.HasWarning() {
+				output = res.Warning
+			}
 			if len(output) > 50 {
 				output = output[:47] + "..."
 			}
-			if output == "" && res.IsFailed() {
+			if output == "" && (res.IsFailed() || res.IsSkipped()) {
 				output = res.ErrorMessage
 				if len(output) > 50 {
 					output = output[:47] + "..."
@@ -64,12 +109,16 @@ func (p *Presenter) PresentExecutionSummary(summary *entities.Summary) string {
 				duration = "N/A"
 			}
 
-			rows = append(rows, []string{
+			row := []string{
 				res.Repository,
 				status,
 				duration,
 				output,
-			})
+			}
+			if withExitCodes {
+				row = slices.Insert(row, 2, exitCodeCell(res))
+			}
+			rows = append(rows, row)
 		}
 
 		// Use responsive table for execution results
@@ -77,15 +126,30 @@ func (p *Presenter) PresentExecutionSummary(summary *entities.Summary) string {
 		result.WriteString(tableOutput + "\n")
 	}
 
-	// Summary statistics
-	result.WriteString(p.styles.GetSectionStyle().Render("📊 Statistics:") + "\n")
-	summaryData := [][]string{
-		{"Total Repositories", strconv.Itoa(summary.TotalCount())},
-		{"Successful", strconv.Itoa(summary.SuccessfulCount())},
-		{"Failed", strconv.Itoa(summary.FailedCount())},
-		{"Cancelled", strconv.Itoa(summary.CancelledCount())},
-		{"Duration", summary.GetTotalDuration().String()},
+	result.WriteString(p.presentStatistics(summary))
+
+	return result.String()
+}
+
+// exitCodeCell renders the exit code of a command that ran, "-" for the others
+func exitCodeCell(res entities.ExecutionResult) string {
+	if !(res.IsSuccess() || res.IsFailed()) || res.ExitCode < 0 {
+		return "-"
 	}
+	return strconv.Itoa(res.ExitCode)
+}
+
+// PresentSummaryOnly presents the execution statistics without any per-repository line
+func (p *Presenter) PresentSummaryOnly(summary *entities.Summary) string {
+	return p.presentStatistics(summary)
+}
+
+// presentStatistics presents the execution counts and duration as a table
+func (p *Presenter) presentStatistics(summary *entities.Summary) string {
+	var result bytes.Buffer
+
+	result.WriteString(p.styles.GetSectionStyle().Render("📊 Statistics:") + "\n")
+	summaryData := summaryMetricsData(summary, p.metrics)
 
 	statisticsHeaders := []string{"Metric", "Value"}
 	statisticsTable := p.styles.CreateResponsiveTable(statisticsHeaders, summaryData)
@@ -94,6 +158,127 @@ func (p *Presenter) PresentExecutionSummary(summary *entities.Summary) string {
 	return result.String()
 }
 
+// outputGroup is a command output shared by one or more repositories
+type outputGroup struct {
+	output       string
+	repositories []string
+}
+
+// groupIdenticalOutputs collapses the successful results with byte-identical output.
+// Groups are ordered by their first repository name.
+func groupIdenticalOutputs(results []entities.ExecutionResult) []outputGroup {
+	var groups []outputGroup
+	index := make(map[string]int)
+
+	for _, res := range sortedResults(results) {
+		if !res.IsSuccess() {
+			continue
+		}
+		if i, exists := index[res.Output]; exists {
+			groups[i].repositories = append(groups[i].repositories, res.Repository)
+			continue
+		}
+		index[res.Output] = len(groups)
+		groups = append(groups, outputGroup{output: res.Output, repositories: []string{res.Repository}})
+	}
+
+	return groups
+}
+
+// sortedResults returns a copy of the results ordered by repository name
+func sortedResults(results []entities.ExecutionResult) []entities.ExecutionResult {
+	sorted := make([]entities.ExecutionResult, len(results))
+	copy(sorted, results)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Repository < sorted[j].Repository
+	})
+	return sorted
+}
+
+// PresentDedupedOutput presents the command output of each repository, showing
+// identical successful outputs once with the repositories that shared them.
+// Failed and skipped repositories are always listed on their own.
+func (p *Presenter) PresentDedupedOutput(summary *entities.Summary) string {
+	var result bytes.Buffer
+
+	result.WriteString(p.styles.GetTitleStyle().Render("📦 Command Output") + "\n\n")
+
+	for _, group := range groupIdenticalOutputs(summary.Results) {
+		header := fmt.Sprintf("✅ %s (%d)", strings.Join(group.repositories, ", "), len(group.repositories))
+		result.WriteString(p.styles.GetSuccessStyle().Render(header) + "\n")
+		result.WriteString(formatOutputBody(group.output) + "\n")
+	}
+
+	for _, res := range sortedResults(summary.Results) {
+		switch {
+		case res.IsSuccess():
+			continue
+		case res.IsSkipped():
+			result.WriteString(p.styles.GetLabelStyle().Render(fmt.Sprintf("⏭️ %s: %s", res.Repository, res.ErrorMessage)) + "\n\n")
+		case res.IsHookFailure():
+			result.WriteString(p.styles.GetErrorStyle().Render(fmt.Sprintf("🪝 %s: rejected by a git hook", res.Repository)) + "\n")
+			result.WriteString(formatOutputBody(res.HookOutput) + "\n")
+		default:
+			result.WriteString(p.styles.GetErrorStyle().Render(fmt.Sprintf("❌ %s: %s", res.Repository, res.ErrorMessage)) + "\n")
+			result.WriteString(formatOutputBody(res.ErrorOutput) + "\n")
+		}
+	}
+
+	return result.String()
+}
+
+// matrixMark marks the result column of a repository in the result matrix
+const matrixMark = "●"
+
+// PresentResultMatrix presents one row per repository, ordered by name, with a mark in
+// the column of its result and its exit code, followed by the repositories that failed
+func (p *Presenter) PresentResultMatrix(summary *entities.Summary) string {
+	var result bytes.Buffer
+
+	result.WriteString(p.styles.GetTitleStyle().Render("🧮 Result Matrix") + "\n\n")
+
+	headers := []string{"Repository", "Pass", "Fail", "Timeout", "Not Run", "Exit"}
+	rows := make([][]string, 0, len(summary.Results))
+	var failing []string
+
+	for _, res := range sortedResults(summary.Results) {
+		row := []string{res.Repository, "", "", "", "", ""}
+		switch {
+		case res.IsSuccess():
+			row[1] = matrixMark
+		case res.IsFailed():
+			row[2] = matrixMark
+			failing = append(failing, res.Repository)
+		case res.IsTimeout():
+			row[3] = matrixMark
+			failing = append(failing, res.Repository)
+		default:
+			row[4] = matrixMark
+		}
+		row[5] = exitCodeCell(res)
+		rows = append(rows, row)
+	}
+
+	result.WriteString(p.styles.CreateResponsiveTable(headers, rows) + "\n")
+
+	if len(failing) == 0 {
+		result.WriteString(p.styles.GetSuccessStyle().Render(fmt.Sprintf("✅ All %d repositories passed", len(rows))) + "\n")
+	} else {
+		line := fmt.Sprintf("❌ %d of %d failed: %s", len(failing), len(rows), strings.Join(failing, ", "))
+		result.WriteString(p.styles.GetErrorStyle().Render(line) + "\n")
+	}
+
+	return result.String()
+}
+
+// formatOutputBody returns the output followed by a newline, or a placeholder when empty
+func formatOutputBody(output string) string {
+	if strings.TrimSpace(output) == "" {
+		return "(no output)\n"
+	}
+	return strings.TrimRight(output, "\n") + "\n"
+}
+
 // PresentStatusReport presents the status report
 func (p *Presenter) PresentStatusReport(repos []*entities.Repository) string {
 	var result bytes.Buffer
@@ -106,25 +291,62 @@ func (p *Presenter) PresentStatusReport(repos []*entities.Repository) string {
 		return result.String()
 	}
 
-	// Status table
-	headers := []string{"Repository", "Branch", "Status", "Changes", "Path"}
-	rows := make([][]string, 0, len(repos))
+	result.WriteString(p.statusTable(repos) + "\n")
+	result.WriteString(p.statusSummary(repos))
 
-	totalRepos := len(repos)
-	cleanRepos := 0
-	modifiedRepos := 0
+	return result.String()
+}
+
+// notGitRepositoryLabel is the status label of configured paths that are not git repositories
+const notGitRepositoryLabel = "⏭️ Not a git repository"
+
+// statusLabel returns the label of the status of the repository, naming the merge or
+// rebase in progress rather than a generic warning
+func statusLabel(repo *entities.Repository) string {
+	switch {
+	case repo.IsNotGitRepository():
+		return notGitRepositoryLabel
+	case repo.Status == entities.StatusError:
+		return styles.StatusLabels[entities.StatusError]
+	case repo.InProgress == entities.OperationRebase:
+		return styles.RebasingLabel
+	case repo.HasOperationInProgress():
+		return styles.MergingLabel
+	case repo.HasChanges():
+		return styles.StatusLabels[entities.StatusModified]
+	default:
+		return styles.StatusLabels[entities.StatusClean]
+	}
+}
+
+// statusTable renders the status of each repository as a table
+func (p *Presenter) statusTable(repos []*entities.Repository) string {
+	headers := []string{"Repository", "Branch", "Status", "Changes"}
+	withLastOperations := slices.ContainsFunc(repos, func(repo *entities.Repository) bool {
+		return repo.LastOperation != nil
+	})
+	if withLastOperations {
+		headers = append(headers, "Last Operation")
+	}
+	showPaths := p.styles.ShowsPaths()
+	if showPaths {
+		headers = append(headers, "Path")
+	}
+	withDescriptions := slices.ContainsFunc(repos, func(repo *entities.Repository) bool {
+		return repo.Description != ""
+	})
+	if withDescriptions {
+		headers = append(headers, "Description")
+	}
+	rows := make([][]string, 0, len(repos))
 
 	for _, repo := range repos {
-		status := "✅ Clean"
+		status := statusLabel(repo)
 		changes := "None"
 
-		if repo.Status == "error" {
-			status = "❌ Error"
+		if repo.Status == entities.StatusError {
 			changes = "N/A"
-		} else if repo.HasChanges() {
-			status = "📝 Modified"
-			modifiedRepos++
-
+		} else if repo.HasOperationInProgress() || repo.HasChanges() {
 			var changesParts []string
 			if repo.CreatedFiles > 0 {
 				changesParts = append(changesParts, fmt.Sprintf("+%d", repo.CreatedFiles))
@@ -135,9 +357,9 @@ func (p *Presenter) PresentStatusReport(repos []*entities.Repository) string {
 			if repo.DeletedFiles > 0 {
 				changesParts = append(changesParts, fmt.Sprintf("-%d", repo.DeletedFiles))
 			}
-			changes = strings.Join(changesParts, " ")
-		} else {
-			cleanRepos++
+			if len(changesParts) > 0 {
+				changes = strings.Join(changesParts, " ")
+			}
 		}
 
 		branch := repo.Branch
@@ -145,19 +367,69 @@ func (p *Presenter) PresentStatusReport(repos []*entities.Repository) string {
 			branch = "unknown"
 		}
 
-		// Use full path - let styles service handle truncation for display
-		rows = append(rows, []string{
+		// Format the path with the path style - let styles service handle truncation for display
+		row := []string{
 			repo.Name,
 			branch,
 			status,
 			changes,
-			repo.Path, // Use full path here
-		})
+		}
+		if withLastOperations {
+			row = append(row, lastOperationCell(repo.LastOperation))
+		}
+		if showPaths {
+			row = append(row, p.styles.FormatPath(repo.Path))
+		}
+		if withDescriptions {
+			row = append(row, repo.Description)
+		}
+		rows = append(rows, row)
 	}
 
 	// Use responsive table creation
-	tableOutput := p.styles.CreateResponsiveTable(headers, rows)
-	result.WriteString(tableOutput + "\n")
+	return p.styles.CreateResponsiveTable(headers, rows)
+}
+
+// lastOperationLayout is the layout of the time of the last operations in the status table
+const lastOperationLayout = "2006-01-02 15:04"
+
+// lastOperationCell renders when and which command gf last ran in a repository, noting
+// when it did not succeed
+func lastOperationCell(operation *entities.LastOperation) string {
+	if operation == nil {
+		return "Never"
+	}
+
+	cell := fmt.Sprintf("%s %s", operation.Time.Local().Format(lastOperationLayout), operation.Command)
+	if operation.Status != entities.ExecutionStatusSuccess {
+		cell += fmt.Sprintf(" (%s)", operation.Status)
+	}
+	return cell
+}
+
+// statusSummary renders the clean, modified and in progress counts of the repositories,
+// and how many configured paths are not git repositories
+func (p *Presenter) statusSummary(repos []*entities.Repository) string {
+	var result bytes.Buffer
+
+	totalRepos := len(repos)
+	cleanRepos := 0
+	modifiedRepos := 0
+	inProgressRepos := 0
+	notGitRepos := 0
+	for _, repo := range repos {
+		switch {
+		case repo.IsNotGitRepository():
+			notGitRepos++
+		case repo.Status == entities.StatusError:
+		case repo.HasOperationInProgress():
+			inProgressRepos++
+		case repo.HasChanges():
+			modifiedRepos++
+		default:
+			cleanRepos++
+		}
+	}
 
 	// Summary statistics
 	result.WriteString(p.styles.GetSectionStyle().Render("📊 Summary:") + "\n")
@@ -166,6 +438,12 @@ func (p *Presenter) PresentStatusReport(repos []*entities.Repository) string {
 		{"Clean Repositories", strconv.Itoa(cleanRepos)},
 		{"Modified Repositories", strconv.Itoa(modifiedRepos)},
 	}
+	if inProgressRepos > 0 {
+		summaryData = append(summaryData, []string{"Merge/Rebase In Progress", strconv.Itoa(inProgressRepos)})
+	}
+	if notGitRepos > 0 {
+		summaryData = append(summaryData, []string{"Not a Git Repository", strconv.Itoa(notGitRepos)})
+	}
 
 	summaryHeaders := []string{"Metric", "Count"}
 	summaryTable := p.styles.CreateResponsiveTable(summaryHeaders, summaryData)
@@ -190,7 +468,7 @@ func (p *Presenter) PresentConfigInfo(groups []*entities.Group, repos []*entitie
 
 		for _, repo := range repos {
 			status := "✅ Valid"
-			if repo.Status == "error" {
+			if repo.Status == entities.StatusError {
 				status = "❌ Invalid"
 			}
 
@@ -217,6 +495,9 @@ func (p *Presenter) PresentConfigInfo(groups []*entities.Group, repos []*entitie
 		for _, group := range groups {
 			status := "✅ Valid"
 			repoNames := strings.Join(group.Repositories, ", ")
+			if group.IsComposed() {
+				repoNames = group.Composition.String()
+			}
 
 			if len(repoNames) > 50 {
 				repoNames = repoNames[:47] + "..."
@@ -258,27 +539,74 @@ func (p *Presenter) PresentStatus(ctx context.Context, repos []*entities.Reposit
 	return statusReport, nil
 }
 
-// PresentConfig presents configuration information
+// PresentStatusByTag presents repository status information in one section per tag,
+// followed by the summary of all repositories
+func (p *Presenter) PresentStatusByTag(ctx context.Context, repos []*entities.Repository) (string, error) {
+	var result bytes.Buffer
+
+	result.WriteString(p.styles.GetTitleStyle().Render("📊 Repository Status Report - By Tag") + "\n\n")
+
+	if len(repos) == 0 {
+		result.WriteString(p.styles.GetErrorStyle().Render("No repositories found") + "\n")
+		return result.String(), nil
+	}
+
+	tags, sections := entities.GroupByTag(repos)
+	for _, tag := range tags {
+		result.WriteString(p.styles.GetSectionStyle().Render(fmt.Sprintf("🏷️ %s (%d)", tag, len(sections[tag]))) + "\n")
+		result.WriteString(p.statusTable(sections[tag]) + "\n\n")
+	}
+
+	result.WriteString(p.statusSummary(repos))
+	return result.String(), nil
+}
+
+// hasRepositoryDescriptions reports whether any configured repository has a description
+func hasRepositoryDescriptions(cfg *repositories.Config) bool {
+	for _, repoConfig := range cfg.Repositories {
+		if repoConfig.Description != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// PresentConfig presents configuration information, given as a listing with its
+// repositories and groups in order or as a configuration shown in the default order
 func (p *Presenter) PresentConfig(ctx context.Context, config interface{}) (string, error) {
 	var result bytes.Buffer
 
 	// Title
 	result.WriteString(p.styles.GetTitleStyle().Render("⚙️ Configuration Information") + "\n\n")
 
-	// Try to cast to *repositories.Config
-	if cfg, ok := config.(*repositories.Config); ok {
+	listing, ok := config.(*repositories.ConfigListing)
+	if cfg, isConfig := config.(*repositories.Config); isConfig {
+		listing, ok = repositories.NewConfigListing(cfg), true
+	}
+
+	if ok {
+		cfg := listing.Config
 		// Display repositories
 		if len(cfg.Repositories) > 0 {
 			result.WriteString(p.styles.GetSectionStyle().Render("📚 Repositories:") + "\n")
 
 			headers := []string{"Name", "Path", "Status"}
+			withDescriptions := hasRepositoryDescriptions(cfg)
+			if withDescriptions {
+				headers = append(headers, "Description")
+			}
 			rows := make([][]string, 0, len(cfg.Repositories))
 
-			for name, repoConfig := range cfg.Repositories {
+			for _, name := range listing.Repositories {
+				repoConfig := cfg.Repositories[name]
 				status := "✅ Valid"
 				// TODO: Add actual validation logic
 
-				rows = append(rows, []string{name, repoConfig.Path, status})
+				row := []string{name, repoConfig.Path, status}
+				if withDescriptions {
+					row = append(row, repoConfig.Description)
+				}
+				rows = append(rows, row)
 			}
 
 			repoTableOutput := p.styles.CreateResponsiveTable(headers, rows)
@@ -292,9 +620,13 @@ func (p *Presenter) PresentConfig(ctx context.Context, config interface{}) (stri
 			headers := []string{"Group", "Repositories", "Status"}
 			rows := make([][]string, 0, len(cfg.Groups))
 
-			for name, group := range cfg.Groups {
+			for _, name := range listing.Groups {
+				group := cfg.Groups[name]
 				status := "✅ Valid"
 				repoNames := strings.Join(group.Repositories, ", ")
+				if group.IsComposed() {
+					repoNames = group.Composition.String()
+				}
 
 				if len(repoNames) > 50 {
 					repoNames = repoNames[:47] + "..."
@@ -308,7 +640,7 @@ func (p *Presenter) PresentConfig(ctx context.Context, config interface{}) (stri
 		}
 
 		// Display theme and other settings
-		if cfg.Theme != "" || cfg.Version != "" {
+		if cfg.Theme != "" || cfg.BorderStyle != "" || cfg.Version != 0 {
 			result.WriteString(p.styles.GetSectionStyle().Render("⚙️ Settings:") + "\n")
 
 			headers := []string{"Setting", "Value"}
@@ -317,8 +649,11 @@ func (p *Presenter) PresentConfig(ctx context.Context, config interface{}) (stri
 			if cfg.Theme != "" {
 				rows = append(rows, []string{"Theme", cfg.Theme})
 			}
-			if cfg.Version != "" {
-				rows = append(rows, []string{"Version", cfg.Version})
+			if cfg.BorderStyle != "" {
+				rows = append(rows, []string{"Border Style", cfg.BorderStyle})
+			}
+			if cfg.Version != 0 {
+				rows = append(rows, []string{"Version", strconv.Itoa(cfg.Version)})
 			}
 
 			settingsTableOutput := p.styles.CreateResponsiveTable(headers, rows)
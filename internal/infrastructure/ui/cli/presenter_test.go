@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
 	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
 )
 
@@ -93,6 +98,33 @@ func TestPresenter_PresentStatusReport(t *testing.T) {
 	}
 }
 
+func TestPresenter_PresentStatusReport_InProgress(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	repos := []*entities.Repository{
+		{
+			Name:          "repo1",
+			Path:          "/path/to/repo1",
+			Status:        entities.StatusWarning,
+			IsValid:       true,
+			Branch:        "main",
+			ModifiedFiles: 2,
+			InProgress:    entities.OperationRebase,
+		},
+	}
+
+	output := presenter.PresentStatusReport(repos)
+
+	if !contains(output, "Rebasing") {
+		t.Error("PresentStatusReport() should show the rebase in progress")
+	}
+
+	if !contains(output, "~2") {
+		t.Error("PresentStatusReport() should still show the pending changes")
+	}
+}
+
 func TestPresenter_PresentConfigInfo(t *testing.T) {
 	stylesService := styles.NewService("fleet")
 	presenter := NewPresenter(stylesService).(*Presenter)
@@ -123,6 +155,19 @@ func TestPresenter_PresentConfigInfo(t *testing.T) {
 	}
 }
 
+func TestPresenter_Output(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+
+	if out := NewPresenter(stylesService).Output(); out != os.Stdout {
+		t.Errorf("Output() = %v, want os.Stdout by default", out)
+	}
+
+	var buf bytes.Buffer
+	if out := NewPresenterWithOutput(stylesService, &buf).Output(); out != &buf {
+		t.Errorf("Output() = %v, want the injected writer", out)
+	}
+}
+
 func TestPresenter_PresentStatus(t *testing.T) {
 	stylesService := styles.NewService("fleet")
 	presenter := NewPresenter(stylesService).(*Presenter)
@@ -143,6 +188,110 @@ func TestPresenter_PresentStatus(t *testing.T) {
 	}
 }
 
+func TestPresenter_PresentStatus_PathStyle(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	repos := []*entities.Repository{
+		{Name: "repo1", Path: "/path/to/repo1", Status: entities.StatusClean},
+	}
+
+	output, err := presenter.PresentStatus(context.Background(), repos, "")
+	if err != nil {
+		t.Fatalf("PresentStatus() error = %v", err)
+	}
+	if !strings.Contains(output, "PATH") {
+		t.Errorf("PresentStatus() should show the path column by default, got:\n%s", output)
+	}
+
+	stylesService.SetPathDisplay(styles.PathStyleNone)
+	output, err = presenter.PresentStatus(context.Background(), repos, "")
+	if err != nil {
+		t.Fatalf("PresentStatus() error = %v", err)
+	}
+	if strings.Contains(output, "PATH") || strings.Contains(output, "/path/to/repo1") {
+		t.Errorf("PresentStatus() should hide the paths with the none style, got:\n%s", output)
+	}
+}
+
+func TestPresenter_PresentStatus_Descriptions(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	repos := []*entities.Repository{
+		{Name: "repo1", Path: "/path/to/repo1", Status: entities.StatusClean},
+	}
+
+	output, err := presenter.PresentStatus(context.Background(), repos, "")
+	if err != nil {
+		t.Fatalf("PresentStatus() error = %v", err)
+	}
+	if strings.Contains(output, "DESCRIPTION") {
+		t.Errorf("PresentStatus() should omit the description column when no repository has one, got:\n%s", output)
+	}
+
+	repos = append(repos, &entities.Repository{Name: "legacy", Path: "/path/to/legacy", Status: entities.StatusClean, Description: "read-only"})
+
+	output, err = presenter.PresentStatus(context.Background(), repos, "")
+	if err != nil {
+		t.Fatalf("PresentStatus() error = %v", err)
+	}
+	if !strings.Contains(output, "DESCRIPTION") || !strings.Contains(output, "read-only") {
+		t.Errorf("PresentStatus() should show the repository descriptions, got:\n%s", output)
+	}
+}
+
+func TestStatusLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		repo     *entities.Repository
+		expected string
+	}{
+		{"clean", &entities.Repository{Status: entities.StatusClean}, "✅ Clean"},
+		{"modified", &entities.Repository{Status: entities.StatusModified, ModifiedFiles: 2}, "📝 Modified"},
+		{"error", &entities.Repository{Status: entities.StatusError}, "❌ Error"},
+		{"not a git repository", &entities.Repository{Status: entities.StatusError, ErrorMessage: entities.NotAGitRepositoryMessage}, "⏭️ Not a git repository"},
+		{"merge", &entities.Repository{Status: entities.StatusWarning, InProgress: entities.OperationMerge}, "⚠️ Merging"},
+		{"rebase", &entities.Repository{Status: entities.StatusWarning, InProgress: entities.OperationRebase}, "⚠️ Rebasing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if label := statusLabel(tt.repo); label != tt.expected {
+				t.Errorf("statusLabel() = %q, want %q", label, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPresenter_PresentStatusByTag(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	repos := []*entities.Repository{
+		{Name: "api", Path: "/path/to/api", Status: entities.StatusClean, Tags: []string{"backend", "go"}},
+		{Name: "docs", Path: "/path/to/docs", Status: entities.StatusClean},
+	}
+
+	output, err := presenter.PresentStatusByTag(context.Background(), repos)
+	if err != nil {
+		t.Fatalf("PresentStatusByTag() error = %v", err)
+	}
+
+	backend := strings.Index(output, "backend (1)")
+	goSection := strings.Index(output, "go (1)")
+	untagged := strings.Index(output, "untagged (1)")
+	if backend < 0 || goSection < backend || untagged < goSection {
+		t.Errorf("PresentStatusByTag() should list the backend, go and untagged sections in order, got:\n%s", output)
+	}
+	if strings.Count(output, "/path/to/api") != 2 {
+		t.Errorf("PresentStatusByTag() should show api under each of its tags, got:\n%s", output)
+	}
+	if !strings.Contains(output, "Total Repositories") {
+		t.Errorf("PresentStatusByTag() should end with the summary, got:\n%s", output)
+	}
+}
+
 func TestPresenter_PresentConfig(t *testing.T) {
 	stylesService := styles.NewService("fleet")
 	presenter := NewPresenter(stylesService).(*Presenter)
@@ -166,6 +315,90 @@ func TestPresenter_PresentConfig(t *testing.T) {
 	}
 }
 
+func TestPresenter_PresentConfig_Descriptions(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	config := &repositories.Config{
+		Repositories: map[string]*repositories.RepositoryConfig{
+			"api":    {Path: "/path/to/api"},
+			"legacy": {Path: "/path/to/legacy", Description: "legacy, read-only"},
+		},
+	}
+
+	output, err := presenter.PresentConfig(context.Background(), config)
+	if err != nil {
+		t.Fatalf("PresentConfig() error = %v", err)
+	}
+	if !strings.Contains(output, "DESCRIPTION") || !strings.Contains(output, "legacy, read-only") {
+		t.Errorf("PresentConfig() should show the repository descriptions, got:\n%s", output)
+	}
+
+	delete(config.Repositories, "legacy")
+	output, err = presenter.PresentConfig(context.Background(), config)
+	if err != nil {
+		t.Fatalf("PresentConfig() error = %v", err)
+	}
+	if strings.Contains(output, "DESCRIPTION") {
+		t.Errorf("PresentConfig() should omit the description column when no repository has one, got:\n%s", output)
+	}
+}
+
+func TestPresenter_PresentConfig_Listing(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	config := &repositories.Config{
+		Repositories: map[string]*repositories.RepositoryConfig{
+			"api": {Path: "/path/to/api"},
+			"web": {Path: "/path/to/web"},
+		},
+	}
+	listing := &repositories.ConfigListing{Config: config, Repositories: []string{"web", "api"}}
+
+	output, err := presenter.PresentConfig(context.Background(), listing)
+	if err != nil {
+		t.Fatalf("PresentConfig() error = %v", err)
+	}
+	web, api := strings.Index(output, "/path/to/web"), strings.Index(output, "/path/to/api")
+	if web < 0 || api < 0 || web > api {
+		t.Errorf("PresentConfig() should list the repositories in the listing order, got:\n%s", output)
+	}
+
+	for range 10 {
+		again, _ := presenter.PresentConfig(context.Background(), listing)
+		if again != output {
+			t.Fatalf("PresentConfig() should render the same listing identically, got:\n%s\nthen:\n%s", output, again)
+		}
+	}
+}
+
+func TestPresenter_PresentConfig_ComposedGroup(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	config := &repositories.Config{
+		Repositories: map[string]*repositories.RepositoryConfig{
+			"api": {Path: "/path/to/api"},
+		},
+		Groups: map[string]*entities.Group{
+			"backend": entities.NewGroup("backend", []string{"api"}),
+			"hot": {
+				Name:        "hot",
+				Composition: &entities.GroupComposition{Operation: entities.CompositionIntersect, Groups: []string{"backend", "critical"}},
+			},
+		},
+	}
+
+	output, err := presenter.PresentConfig(context.Background(), config)
+	if err != nil {
+		t.Fatalf("PresentConfig() error = %v", err)
+	}
+	if strings.Count(output, "intersect(backend, critical)") != 1 {
+		t.Errorf("PresentConfig() should show the composition of a composed group once, got:\n%s", output)
+	}
+}
+
 func TestPresenter_PresentSummary(t *testing.T) {
 	stylesService := styles.NewService("fleet")
 	presenter := NewPresenter(stylesService).(*Presenter)
@@ -266,3 +499,204 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestPresenter_PresentExecutionSummary_HookFailure(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	summary := entities.NewSummary()
+	result := entities.NewExecutionResult("repo1", "git commit -m fix")
+	result.MarkAsFailed("lint failed", 1, "exit status 1")
+	result.FailureCategory = entities.FailureCategoryHook
+	summary.AddResult(*result)
+
+	output := presenter.PresentExecutionSummary(summary)
+
+	if !contains(output, "Hook Rejected") {
+		t.Error("PresentExecutionSummary() should mark failures caused by hooks")
+	}
+	if !contains(output, "Rejected by Hooks") {
+		t.Error("PresentExecutionSummary() should count failures caused by hooks")
+	}
+}
+
+func TestPresenter_PresentExecutionSummary_ExitCodes(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	summary := entities.NewSummary()
+	success := entities.NewExecutionResult("repo1", "make test")
+	success.MarkAsSuccess("ok", 0)
+	summary.AddResult(*success)
+
+	if output := presenter.PresentExecutionSummary(summary); contains(output, "EXIT") {
+		t.Errorf("PresentExecutionSummary() should omit the exit column when every command succeeded, got:\n%s", output)
+	}
+
+	failed := entities.NewExecutionResult("repo2", "make test")
+	failed.MarkAsFailed("", 137, "signal: killed")
+	summary.AddResult(*failed)
+
+	if output := presenter.PresentExecutionSummary(summary); !contains(output, "EXIT") || !contains(output, "137") {
+		t.Errorf("PresentExecutionSummary() should show the exit codes, got:\n%s", output)
+	}
+}
+
+func TestPresenter_PresentExecutionSummary_Warning(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	summary := entities.NewSummary()
+	result := entities.NewExecutionResult("repo1", "git pull --autostash")
+	result.MarkAsSuccess("Updating 1a2b3c4..5d6e7f8", 0)
+	result.Warning = "local changes conflicted"
+	summary.AddResult(*result)
+
+	output := presenter.PresentExecutionSummary(summary)
+
+	if !contains(output, "Warning") || !contains(output, "local changes conflicted") {
+		t.Error("PresentExecutionSummary() should show the warning of a successful result")
+	}
+	if !contains(output, "Warnings") {
+		t.Error("PresentExecutionSummary() should count the results with warnings")
+	}
+}
+
+func TestGroupIdenticalOutputs(t *testing.T) {
+	newResult := func(repo, output string, failed bool) entities.ExecutionResult {
+		result := entities.NewExecutionResult(repo, "git log -1")
+		if failed {
+			result.MarkAsFailed(output, 1, "exit status 1")
+		} else {
+			result.MarkAsSuccess(output, 0)
+		}
+		return *result
+	}
+
+	results := []entities.ExecutionResult{
+		newResult("web", "abc123 shared\n", false),
+		newResult("api", "abc123 shared\n", false),
+		newResult("docs", "def456 other\n", false),
+		newResult("cli", "abc123 shared\n", true),
+	}
+
+	groups := groupIdenticalOutputs(results)
+
+	if len(groups) != 2 {
+		t.Fatalf("groupIdenticalOutputs() returned %d groups, want 2", len(groups))
+	}
+
+	if got := groups[0].repositories; len(got) != 2 || got[0] != "api" || got[1] != "web" {
+		t.Errorf("first group repositories = %v, want [api web]", got)
+	}
+
+	if got := groups[1].repositories; len(got) != 1 || got[0] != "docs" {
+		t.Errorf("second group repositories = %v, want [docs]", got)
+	}
+}
+
+func TestPresenter_PresentDedupedOutput(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	summary := entities.NewSummary()
+	for _, name := range []string{"repo1", "repo2"} {
+		result := entities.NewExecutionResult(name, "git log -1")
+		result.MarkAsSuccess("abc123 shared commit", 0)
+		summary.AddResult(*result)
+	}
+	failed := entities.NewExecutionResult("repo3", "git log -1")
+	failed.MarkAsFailed("fatal: bad revision", 128, "exit status 128")
+	summary.AddResult(*failed)
+
+	output := presenter.PresentDedupedOutput(summary)
+
+	if !contains(output, "repo1, repo2 (2)") {
+		t.Error("PresentDedupedOutput() should list the repositories sharing an output together")
+	}
+
+	if !contains(output, "repo3: exit status 128") || !contains(output, "fatal: bad revision") {
+		t.Error("PresentDedupedOutput() should list failed repositories on their own")
+	}
+}
+
+func TestPresenter_PresentResultMatrix(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	summary := entities.NewSummary()
+	failed := entities.NewExecutionResult("web", "make lint")
+	failed.MarkAsFailed("lint errors", 2, "exit status 2")
+	summary.AddResult(*failed)
+	passed := entities.NewExecutionResult("api", "make lint")
+	passed.MarkAsSuccess("ok", 0)
+	summary.AddResult(*passed)
+	skipped := entities.NewExecutionResult("docs", "make lint")
+	skipped.MarkAsSkipped("blocked")
+	summary.AddResult(*skipped)
+
+	output := presenter.PresentResultMatrix(summary)
+
+	if !contains(output, "Result Matrix") || !contains(output, "EXIT") {
+		t.Errorf("PresentResultMatrix() should show the matrix table, got:\n%s", output)
+	}
+	if strings.Index(output, "api") > strings.Index(output, "docs") || strings.Index(output, "docs") > strings.Index(output, "web") {
+		t.Errorf("PresentResultMatrix() should order repositories by name, got:\n%s", output)
+	}
+	if !contains(output, "1 of 3 failed: web") {
+		t.Errorf("PresentResultMatrix() should list the failing repositories, got:\n%s", output)
+	}
+
+	summary = entities.NewSummary()
+	summary.AddResult(*passed)
+	if output := presenter.PresentResultMatrix(summary); !contains(output, "All 1 repositories passed") {
+		t.Errorf("PresentResultMatrix() should tell when every repository passed, got:\n%s", output)
+	}
+}
+
+func TestPresenter_PresentSummaryOnly(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+	presenter := NewPresenter(stylesService).(*Presenter)
+
+	summary := entities.NewSummary()
+	success := entities.NewExecutionResult("repo1", "git pull")
+	success.MarkAsSuccess("Already up to date.", 0)
+	summary.AddResult(*success)
+	failed := entities.NewExecutionResult("repo2", "git pull")
+	failed.MarkAsFailed("fatal: not a git repository", 128, "exit status 128")
+	summary.AddResult(*failed)
+
+	output := presenter.PresentSummaryOnly(summary)
+
+	if !contains(output, "Statistics") || !contains(output, "Total Repositories") {
+		t.Error("PresentSummaryOnly() should show the statistics table")
+	}
+
+	for _, perRepo := range []string{"repo1", "repo2", "Already up to date.", "fatal: not a git repository"} {
+		if contains(output, perRepo) {
+			t.Errorf("PresentSummaryOnly() should not show per-repository output, found %q", perRepo)
+		}
+	}
+}
+
+func TestLastOperationCell(t *testing.T) {
+	at := time.Date(2026, 3, 2, 14, 5, 0, 0, time.Local)
+
+	tests := []struct {
+		name      string
+		operation *entities.LastOperation
+		expected  string
+	}{
+		{"never", nil, "Never"},
+		{"success", &entities.LastOperation{Command: "git pull", Time: at, Status: entities.ExecutionStatusSuccess}, "2026-03-02 14:05 git pull"},
+		{"failed", &entities.LastOperation{Command: "git push", Time: at, Status: entities.ExecutionStatusFailed}, "2026-03-02 14:05 git push (failed)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if cell := lastOperationCell(tt.operation); cell != tt.expected {
+				t.Errorf("lastOperationCell() = %q, want %q", cell, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/input"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// TerminalPrompter asks the user to type a line on the terminal
+type TerminalPrompter struct {
+	in  io.Reader
+	out io.Writer
+}
+
+// NewTerminalPrompter creates a prompter reading lines from in and writing prompts to out
+func NewTerminalPrompter(in io.Reader, out io.Writer) input.PromptPort {
+	return &TerminalPrompter{
+		in:  in,
+		out: out,
+	}
+}
+
+// Prompt prints the prompt and returns the line typed, trimmed. It fails without
+// prompting when the input is a file that is not a terminal, as nobody can answer.
+func (p *TerminalPrompter) Prompt(ctx context.Context, prompt string) (string, error) {
+	if file, ok := p.in.(*os.File); ok && !term.IsTerminal(int(file.Fd())) {
+		return "", errors.ErrNoTerminal
+	}
+
+	fmt.Fprintf(p.out, "%s: ", prompt)
+
+	answer, err := bufio.NewReader(p.in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	return strings.TrimSpace(answer), nil
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestTerminalPrompter_Prompt(t *testing.T) {
+	var out bytes.Buffer
+	prompter := NewTerminalPrompter(strings.NewReader("  Fix the build  \n"), &out)
+
+	answer, err := prompter.Prompt(context.Background(), "Commit message")
+	if err != nil {
+		t.Fatalf("Prompt() error = %v", err)
+	}
+	if answer != "Fix the build" {
+		t.Errorf("Prompt() = %q, want %q", answer, "Fix the build")
+	}
+	if out.String() != "Commit message: " {
+		t.Errorf("Prompt() printed %q, want the prompt", out.String())
+	}
+}
+
+func TestTerminalPrompter_Prompt_ClosedInput(t *testing.T) {
+	prompter := NewTerminalPrompter(strings.NewReader(""), &bytes.Buffer{})
+
+	answer, err := prompter.Prompt(context.Background(), "Commit message")
+	if err != nil || answer != "" {
+		t.Errorf("Prompt() = %q, %v, want an empty answer", answer, err)
+	}
+}
+
+func TestTerminalPrompter_Prompt_NotATerminal(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "input")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var out bytes.Buffer
+	prompter := NewTerminalPrompter(file, &out)
+
+	if _, err := prompter.Prompt(context.Background(), "Commit message"); !errors.IsError(err, errors.ErrNoTerminal) {
+		t.Errorf("Prompt() error = %v, want %v", err, errors.ErrNoTerminal)
+	}
+	if out.Len() != 0 {
+		t.Errorf("Prompt() should not print the prompt without a terminal, got %q", out.String())
+	}
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatPullReport renders the counts of a fast-forward-only pull, followed by a table of
+// the diverged repositories that need a manual merge or rebase
+func formatPullReport(stylesService styles.Service, report *usecases.PullReport) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🔀 Fast-forward Pull") + "\n\n")
+	result.WriteString(fmt.Sprintf("✅ %d updated, 💤 %d already current, ⚠️ %d diverged, ❌ %d failed\n",
+		len(report.Updated), len(report.Current), len(report.Diverged), len(report.Failed)))
+
+	if len(report.Diverged) == 0 {
+		result.WriteString(stylesService.GetSuccessStyle().Render("✨ No diverged repositories") + "\n")
+		return result.String()
+	}
+
+	headers := []string{"Repository", "Ahead", "Behind"}
+	rows := make([][]string, 0, len(report.Diverged))
+	for _, diverged := range report.Diverged {
+		if diverged.Error != "" {
+			rows = append(rows, []string{diverged.Repository, "❌ Error", "-"})
+			continue
+		}
+		rows = append(rows, []string{diverged.Repository, strconv.Itoa(diverged.Ahead), strconv.Itoa(diverged.Behind)})
+	}
+
+	result.WriteString("\n" + stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(stylesService.GetErrorStyle().Render(
+		fmt.Sprintf("⚠️ %d diverged repositories could not fast-forward, merge or rebase them by hand", len(report.Diverged))) + "\n")
+
+	return result.String()
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatPullReport(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	report := &usecases.PullReport{
+		Updated:  []string{"api"},
+		Current:  []string{"web", "docs"},
+		Diverged: []*usecases.DivergedRepository{{Repository: "legacy", Ahead: 2, Behind: 5}},
+	}
+
+	output := formatPullReport(stylesService, report)
+
+	for _, want := range []string{"1 updated", "2 already current", "1 diverged", "legacy", "AHEAD", "merge or rebase"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatPullReport() should contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "docs") {
+		t.Errorf("formatPullReport() should only list the diverged repositories, got:\n%s", output)
+	}
+
+	output = formatPullReport(stylesService, &usecases.PullReport{Updated: []string{"api"}})
+	if !strings.Contains(output, "No diverged repositories") {
+		t.Errorf("formatPullReport() should tell when no repository diverged, got:\n%s", output)
+	}
+}
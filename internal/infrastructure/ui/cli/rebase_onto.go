@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// parseRebaseOntoArgs parses the arguments of the rebase-onto built-in: the branch and
+// an optional --abort-on-conflict
+func parseRebaseOntoArgs(args []string) (*usecases.RebaseOntoInput, error) {
+	input := &usecases.RebaseOntoInput{}
+
+	for _, arg := range args {
+		switch {
+		case arg == "--abort-on-conflict":
+			input.AbortOnConflict = true
+		case input.Branch == "" && !strings.HasPrefix(arg, "-"):
+			input.Branch = arg
+		default:
+			return nil, errors.ErrUsageRebaseOnto
+		}
+	}
+
+	if input.Branch == "" {
+		return nil, errors.ErrUsageRebaseOnto
+	}
+
+	return input, nil
+}
+
+// formatRebaseReport renders the rebase counts followed by the repositories whose rebase
+// stopped on conflicts, which the progress display reported as failures
+func formatRebaseReport(stylesService styles.Service, branch string, summary *entities.Summary) string {
+	var result bytes.Buffer
+	var conflicted []entities.ExecutionResult
+	for _, res := range sortedResults(summary.Results) {
+		if res.HasWarning() {
+			conflicted = append(conflicted, res)
+		}
+	}
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🔀 Rebase onto "+branch) + "\n\n")
+	result.WriteString(fmt.Sprintf("✅ %d rebased, ⚠️ %d conflicted, ❌ %d failed\n",
+		summary.SuccessfulCount()-len(conflicted), len(conflicted), summary.FailedCount()))
+
+	if len(conflicted) == 0 {
+		result.WriteString(stylesService.GetSuccessStyle().Render("✨ No conflicts") + "\n")
+		return result.String()
+	}
+
+	result.WriteString("\n")
+	for _, res := range conflicted {
+		result.WriteString(stylesService.GetErrorStyle().Render(fmt.Sprintf("⚠️ %s: %s", res.Repository, res.Warning)) + "\n")
+	}
+
+	return result.String()
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseRebaseOntoArgs(t *testing.T) {
+	input, err := parseRebaseOntoArgs([]string{"origin/main", "--abort-on-conflict"})
+	if err != nil {
+		t.Fatalf("parseRebaseOntoArgs() error = %v", err)
+	}
+	if input.Branch != "origin/main" || !input.AbortOnConflict {
+		t.Errorf("parseRebaseOntoArgs() = %+v, want origin/main with --abort-on-conflict", input)
+	}
+
+	for _, args := range [][]string{{}, {"--abort-on-conflict"}, {"main", "develop"}, {"main", "--onto"}} {
+		if _, err := parseRebaseOntoArgs(args); !errors.IsError(err, errors.ErrUsageRebaseOnto) {
+			t.Errorf("parseRebaseOntoArgs(%v) error = %v, want ErrUsageRebaseOnto", args, err)
+		}
+	}
+}
+
+func TestFormatRebaseReport(t *testing.T) {
+	stylesService := styles.NewService("fleet")
+
+	summary := entities.NewSummary()
+	rebased := entities.NewExecutionResult("api", "git fetch && git rebase 'main'")
+	rebased.MarkAsSuccess("", 0)
+	summary.AddResult(*rebased)
+
+	if output := formatRebaseReport(stylesService, "main", summary); !strings.Contains(output, "1 rebased, ⚠️ 0 conflicted") || !strings.Contains(output, "No conflicts") {
+		t.Errorf("formatRebaseReport() = %q, want the counts without conflicts", output)
+	}
+
+	conflicted := entities.NewExecutionResult("web", "git fetch && git rebase 'main'")
+	conflicted.MarkAsFailed("", 1, "exit status 1")
+	conflicted.MarkAsWarning(usecases.RebaseConflictWarning)
+	summary.AddResult(*conflicted)
+
+	output := formatRebaseReport(stylesService, "main", summary)
+	if !strings.Contains(output, "1 rebased, ⚠️ 1 conflicted") || !strings.Contains(output, "web: "+usecases.RebaseConflictWarning) {
+		t.Errorf("formatRebaseReport() = %q, want the conflicted repository listed", output)
+	}
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatRemotePrunes renders the remote-tracking branches pruned in each repository as a table
+func formatRemotePrunes(stylesService styles.Service, prunes []*usecases.RepositoryPrune) string {
+	return formatPrunes(stylesService, "🧹 Remote Prune", "stale remote-tracking branches", prunes)
+}
+
+// formatTagPrunes renders the local tags pruned in each repository as a table
+func formatTagPrunes(stylesService styles.Service, prunes []*usecases.RepositoryPrune) string {
+	return formatPrunes(stylesService, "🏷️ Fetch --prune-tags", "stale tags", prunes)
+}
+
+// formatPrunes renders the refs pruned in each repository as a table, followed by the
+// pruned refs and the total, in which what names the refs
+func formatPrunes(stylesService styles.Service, title, what string, prunes []*usecases.RepositoryPrune) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render(title) + "\n\n")
+
+	headers := []string{"Repository", "Remote", "Result"}
+	rows := make([][]string, 0, len(prunes))
+
+	// The pruned refs are listed below the table, where long names are not truncated
+	var details strings.Builder
+	total := 0
+	for _, prune := range prunes {
+		switch {
+		case prune.Error != "":
+			rows = append(rows, []string{prune.Repository, prune.Remote, "❌ Error"})
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", prune.Repository, prune.Error))
+		case prune.IsClean():
+			rows = append(rows, []string{prune.Repository, prune.Remote, "✨ Clean"})
+		default:
+			total += len(prune.Pruned)
+			rows = append(rows, []string{prune.Repository, prune.Remote, fmt.Sprintf("🧹 %d pruned", len(prune.Pruned))})
+			details.WriteString(fmt.Sprintf("🧹 %s: %s\n", prune.Repository, strings.Join(prune.Pruned, ", ")))
+		}
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(details.String())
+	result.WriteString(fmt.Sprintf("%d %s pruned in %d repositories\n", total, what, len(prunes)))
+	return result.String()
+}
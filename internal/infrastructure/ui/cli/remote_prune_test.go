@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatRemotePrunes(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	prunes := []*usecases.RepositoryPrune{
+		{Repository: "api", Remote: "origin", Pruned: []string{"origin/feature-a", "origin/feature-b"}},
+		{Repository: "web", Remote: "upstream"},
+		{Repository: "broken", Remote: "origin", Error: "failed to prune remote"},
+	}
+
+	output := formatRemotePrunes(stylesService, prunes)
+
+	for _, want := range []string{"api", "🧹 2 pruned", "origin/feature-a, origin/feature-b", "upstream", "✨ Clean", "❌ Error", "2 stale remote-tracking branches pruned in 3 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatRemotePrunes() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestFormatTagPrunes(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	prunes := []*usecases.RepositoryPrune{
+		{Repository: "api", Remote: "origin", Pruned: []string{"v1.0"}},
+		{Repository: "web", Remote: "origin"},
+	}
+
+	output := formatTagPrunes(stylesService, prunes)
+
+	for _, want := range []string{"Fetch --prune-tags", "🧹 1 pruned", "v1.0", "✨ Clean", "1 stale tags pruned in 2 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatTagPrunes() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
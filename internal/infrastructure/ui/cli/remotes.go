@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatRemotes renders the remotes of each repository as a table with one row per
+// remote, flagging the repositories without remotes and those whose main remote is on
+// another host than the one of the majority. The flags are repeated below the table,
+// where they are not truncated.
+func formatRemotes(stylesService styles.Service, remotes []*usecases.RepositoryRemotes) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🌐 Remotes") + "\n\n")
+
+	headers := []string{"Repository", "Remote", "URL", "Note"}
+	rows := make([][]string, 0, len(remotes))
+
+	var details bytes.Buffer
+	flagged := 0
+	for _, repo := range remotes {
+		switch {
+		case repo.Error != "" && len(repo.Remotes) == 0:
+			rows = append(rows, []string{repo.Repository, "-", "-", "❌ Error"})
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", repo.Repository, repo.Error))
+			continue
+		case len(repo.Remotes) == 0:
+			flagged++
+			rows = append(rows, []string{repo.Repository, "-", "-", "⚠️ No remotes"})
+			details.WriteString(fmt.Sprintf("⚠️ %s: no remotes\n", repo.Repository))
+			continue
+		case repo.OtherHost:
+			flagged++
+			details.WriteString(fmt.Sprintf("⚠️ %s: main remote on %s, unlike most repositories\n", repo.Repository, repo.Host))
+		}
+
+		for i, remote := range repo.Remotes {
+			note := ""
+			if i == 0 && repo.OtherHost {
+				note = "⚠️ Other host"
+			}
+			rows = append(rows, []string{repo.Repository, remote.Name, remote.URL, note})
+		}
+		if repo.Error != "" {
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", repo.Repository, repo.Error))
+		}
+	}
+
+	if len(rows) > 0 {
+		result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	}
+	result.WriteString(details.String())
+
+	if flagged > 0 {
+		result.WriteString(fmt.Sprintf("⚠️ %d of %d repositories without remotes or on another host\n", flagged, len(remotes)))
+	} else {
+		result.WriteString(fmt.Sprintf("✅ %d repositories\n", len(remotes)))
+	}
+	return result.String()
+}
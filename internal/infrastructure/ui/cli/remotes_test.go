@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatRemotes(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	remotes := []*usecases.RepositoryRemotes{
+		{Repository: "api", Remotes: []usecases.RemoteURL{{Name: "origin", URL: "git@github.com:acme/api.git"}}, Host: "github.com"},
+		{Repository: "legacy", Remotes: []usecases.RemoteURL{{Name: "origin", URL: "git@old.example.com:acme/legacy.git"}}, Host: "old.example.com", OtherHost: true},
+		{Repository: "scratch", Remotes: []usecases.RemoteURL{}},
+		{Repository: "broken", Remotes: []usecases.RemoteURL{}, Error: "not a git repository"},
+	}
+
+	output := formatRemotes(stylesService, remotes)
+
+	for _, want := range []string{"Remotes", "git@github.com:acme", "legacy: main remote on old.example.com", "scratch: no remotes", "❌ Error", "not a git repository", "2 of 4 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatRemotes() should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	if output := formatRemotes(stylesService, remotes[:1]); !strings.Contains(output, "✅ 1 repositories") {
+		t.Errorf("formatRemotes() without flagged repositories should only count them, got:\n%s", output)
+	}
+}
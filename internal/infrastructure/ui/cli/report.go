@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// Report represents the execution report written with --report
+type Report struct {
+	Command string            `json:"command"`
+	Groups  []string          `json:"groups"`
+	Summary *entities.Summary `json:"summary"`
+}
+
+// FailedRepositories returns the names of the repositories that did not succeed,
+// leaving out the ones that were skipped
+func (r *Report) FailedRepositories() []string {
+	var failed []string
+	if r.Summary == nil {
+		return failed
+	}
+
+	for _, result := range r.Summary.Results {
+		if !result.IsSuccess() && !result.IsSkipped() {
+			failed = append(failed, result.Repository)
+		}
+	}
+
+	return failed
+}
+
+// writeReport writes an execution report as JSON to the given path
+func writeReport(path string, report *Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WrapPathError(errors.ErrFailedToWriteReport, path, err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return errors.WrapPathError(errors.ErrFailedToWriteReport, path, err)
+	}
+
+	return nil
+}
+
+// readReport reads and validates an execution report from the given path
+func readReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapPathError(errors.ErrFailedToReadReport, path, err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, errors.WrapPathError(errors.ErrFailedToParseReport, path, err)
+	}
+
+	if report.Command == "" || len(report.Groups) == 0 || report.Summary == nil {
+		return nil, errors.WrapPathError(errors.ErrInvalidReport, path, nil)
+	}
+
+	return &report, nil
+}
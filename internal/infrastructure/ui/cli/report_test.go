@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func newTestReport() *Report {
+	summary := entities.NewSummary()
+
+	success := entities.NewExecutionResult("repo1", "pull")
+	success.MarkAsSuccess("Already up to date.", 0)
+	summary.AddResult(*success)
+
+	failed := entities.NewExecutionResult("repo2", "pull")
+	failed.MarkAsFailed("fatal: error", 1, "exit status 1")
+	summary.AddResult(*failed)
+
+	timeout := entities.NewExecutionResult("repo3", "pull")
+	timeout.MarkAsTimeout()
+	summary.AddResult(*timeout)
+
+	summary.Finalize()
+
+	return &Report{
+		Command: "pull",
+		Groups:  []string{"all"},
+		Summary: summary,
+	}
+}
+
+func TestReport_FailedRepositories(t *testing.T) {
+	report := newTestReport()
+
+	failed := report.FailedRepositories()
+	expected := []string{"repo2", "repo3"}
+	if !reflect.DeepEqual(failed, expected) {
+		t.Errorf("FailedRepositories() = %v, want %v", failed, expected)
+	}
+
+	empty := &Report{}
+	if len(empty.FailedRepositories()) != 0 {
+		t.Error("FailedRepositories() should be empty without a summary")
+	}
+}
+
+func TestWriteAndReadReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	report := newTestReport()
+
+	if err := writeReport(path, report); err != nil {
+		t.Fatalf("writeReport() returned error: %v", err)
+	}
+
+	loaded, err := readReport(path)
+	if err != nil {
+		t.Fatalf("readReport() returned error: %v", err)
+	}
+
+	if loaded.Command != report.Command {
+		t.Errorf("Command = %q, want %q", loaded.Command, report.Command)
+	}
+	if !reflect.DeepEqual(loaded.Groups, report.Groups) {
+		t.Errorf("Groups = %v, want %v", loaded.Groups, report.Groups)
+	}
+	if !reflect.DeepEqual(loaded.FailedRepositories(), report.FailedRepositories()) {
+		t.Errorf("FailedRepositories() = %v, want %v", loaded.FailedRepositories(), report.FailedRepositories())
+	}
+}
+
+func TestReadReport_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	malformed := filepath.Join(dir, "malformed.json")
+	if err := os.WriteFile(malformed, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	incomplete := filepath.Join(dir, "incomplete.json")
+	if err := os.WriteFile(incomplete, []byte(`{"command": "pull"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected error
+	}{
+		{"missing file", filepath.Join(dir, "missing.json"), errors.ErrFailedToReadReport},
+		{"malformed file", malformed, errors.ErrFailedToParseReport},
+		{"incomplete report", incomplete, errors.ErrInvalidReport},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readReport(tt.path)
+			if !errors.IsError(err, tt.expected) {
+				t.Errorf("readReport() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
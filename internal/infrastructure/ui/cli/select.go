@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// selectRepositories resolves the groups and lets the user narrow their repositories in
+// the picker, returning the names of the repositories to run the command in
+func (h *Handler) selectRepositories(ctx context.Context, groups []string) ([]string, error) {
+	if h.selector == nil {
+		return nil, errors.ErrSelectNeedsTerminal
+	}
+
+	repos, err := h.manageConfigUC.GetRepositoriesForGroups(ctx, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := h.selector.SelectRepositories(ctx, repos)
+	if err != nil {
+		return nil, err
+	}
+	if len(selected) == 0 {
+		return nil, errors.ErrNoRepositoriesSelected
+	}
+	return selected, nil
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/ports/output"
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestHandler_SelectRepositories(t *testing.T) {
+	repos := []*entities.Repository{{Name: "api", Path: "/src/api"}, {Name: "web", Path: "/src/web"}}
+
+	tests := []struct {
+		name     string
+		selected []string
+		err      error
+		expected error
+	}{
+		{name: "narrowed", selected: []string{"web"}},
+		{name: "nothing kept", selected: nil, expected: errors.ErrNoRepositoriesSelected},
+		{name: "not a terminal", err: errors.ErrSelectNeedsTerminal, expected: errors.ErrSelectNeedsTerminal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockManageConfigUC := usecases.NewMockManageConfigUCI(ctrl)
+			mockManageConfigUC.EXPECT().GetRepositoriesForGroups(gomock.Any(), []string{"all"}).Return(repos, nil)
+			mockSelector := output.NewMockSelectorPort(ctrl)
+			mockSelector.EXPECT().SelectRepositories(gomock.Any(), repos).Return(tt.selected, tt.err)
+
+			handler := &Handler{manageConfigUC: mockManageConfigUC}
+			handler.SetSelector(mockSelector)
+
+			selected, err := handler.selectRepositories(context.Background(), []string{"all"})
+			if !errors.IsError(err, tt.expected) {
+				t.Fatalf("selectRepositories() error = %v, want %v", err, tt.expected)
+			}
+			if tt.expected == nil && !reflect.DeepEqual(selected, tt.selected) {
+				t.Errorf("selectRepositories() = %v, want %v", selected, tt.selected)
+			}
+		})
+	}
+}
+
+func TestHandler_SelectRepositories_WithoutSelector(t *testing.T) {
+	handler := &Handler{}
+
+	if _, err := handler.selectRepositories(context.Background(), []string{"all"}); !errors.IsError(err, errors.ErrSelectNeedsTerminal) {
+		t.Errorf("selectRepositories() error = %v, want %v", err, errors.ErrSelectNeedsTerminal)
+	}
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// defaultShellFunctionName is the name of the generated navigation function
+const defaultShellFunctionName = "gfcd"
+
+// supportedShells lists the shells shell-init can generate a function for
+var supportedShells = []string{"bash", "zsh", "fish"}
+
+// shellFunctionNamePattern restricts function names to what every supported shell accepts
+var shellFunctionNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+const posixShellInitTemplate = `# git-fleet shell integration
+# Add to your shell configuration: eval "$(gf shell-init %[2]s)"
+%[1]s() {
+    local dir
+    dir="$(command gf goto --print-path "$@")" && cd "$dir"
+}
+`
+
+const fishShellInitTemplate = `# git-fleet shell integration
+# Add to your fish configuration: gf shell-init fish | source
+function %[1]s
+    set -l dir (command gf goto --print-path $argv)
+    and cd $dir
+end
+`
+
+// shellInitScript returns the shell function wiring goto into cd for the given shell
+func shellInitScript(shell, functionName string) (string, error) {
+	if functionName == "" {
+		functionName = defaultShellFunctionName
+	}
+
+	if !shellFunctionNamePattern.MatchString(functionName) {
+		return "", errors.WrapInvalidFunctionName(functionName)
+	}
+
+	switch shell {
+	case "bash", "zsh":
+		return fmt.Sprintf(posixShellInitTemplate, functionName, shell), nil
+	case "fish":
+		return fmt.Sprintf(fishShellInitTemplate, functionName), nil
+	default:
+		return "", errors.WrapUnsupportedShell(shell, supportedShells)
+	}
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestShellInitScript(t *testing.T) {
+	tests := []struct {
+		name         string
+		shell        string
+		functionName string
+		contains     []string
+	}{
+		{
+			name:     "bash default name",
+			shell:    "bash",
+			contains: []string{"gfcd() {", "gf goto --print-path \"$@\"", "cd \"$dir\""},
+		},
+		{
+			name:     "zsh default name",
+			shell:    "zsh",
+			contains: []string{"gfcd() {", "gf shell-init zsh"},
+		},
+		{
+			name:         "fish custom name",
+			shell:        "fish",
+			functionName: "jump",
+			contains:     []string{"function jump", "gf goto --print-path $argv", "and cd $dir", "end"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := shellInitScript(tt.shell, tt.functionName)
+			if err != nil {
+				t.Fatalf("shellInitScript() returned error: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(script, want) {
+					t.Errorf("shellInitScript() should contain %q, got:\n%s", want, script)
+				}
+			}
+		})
+	}
+}
+
+func TestShellInitScript_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		shell        string
+		functionName string
+		expected     error
+	}{
+		{"unsupported shell", "powershell", "", errors.ErrUnsupportedShell},
+		{"function name with spaces", "bash", "my cd", errors.ErrInvalidFunctionName},
+		{"function name with command substitution", "zsh", "$(rm)", errors.ErrInvalidFunctionName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := shellInitScript(tt.shell, tt.functionName)
+			if !errors.IsError(err, tt.expected) {
+				t.Errorf("shellInitScript() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
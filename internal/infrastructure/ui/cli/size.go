@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"bytes"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatSizes renders the disk usage of each repository as a table with a total row.
+// With gitOnly only the git directory column is shown.
+func formatSizes(stylesService styles.Service, sizes []*usecases.RepositorySize, gitOnly bool) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("💾 Disk Usage") + "\n\n")
+
+	headers := []string{"Repository", "Working Tree", ".git", "Total"}
+	if gitOnly {
+		headers = []string{"Repository", ".git"}
+	}
+	rows := make([][]string, 0, len(sizes)+1)
+
+	var total repositories.DiskUsage
+	for _, size := range sizes {
+		if size.Error != "" {
+			row := []string{size.Repository, "❌ Error"}
+			if !gitOnly {
+				row = append(row, "-", "-")
+			}
+			rows = append(rows, row)
+			continue
+		}
+
+		total.WorkTree += size.Usage.WorkTree
+		total.GitDir += size.Usage.GitDir
+		rows = append(rows, sizeRow(size.Repository, size.Usage, gitOnly))
+	}
+
+	rows = append(rows, sizeRow("Total", total, gitOnly))
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	return result.String()
+}
+
+// sizeRow builds a table row from the disk usage of a repository
+func sizeRow(name string, usage repositories.DiskUsage, gitOnly bool) []string {
+	if gitOnly {
+		return []string{name, formatByteSize(usage.GitDir)}
+	}
+
+	return []string{
+		name,
+		formatByteSize(usage.WorkTree),
+		formatByteSize(usage.GitDir),
+		formatByteSize(usage.Total()),
+	}
+}
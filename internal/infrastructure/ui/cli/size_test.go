@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatSizes(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	sizes := []*usecases.RepositorySize{
+		{Repository: "api", Usage: repositories.DiskUsage{WorkTree: 2048, GitDir: 3 * 1024 * 1024}},
+		{Repository: "web", Usage: repositories.DiskUsage{WorkTree: 512, GitDir: 1024}},
+		{Repository: "broken", Error: "not a git repository"},
+	}
+
+	output := formatSizes(stylesService, sizes, false)
+
+	for _, want := range []string{"WORKING TREE", "api", "web", "broken", "❌ Error", "2.0 KB", "3.0 MB", "512 B", "Total"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatSizes() should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	gitOnly := formatSizes(stylesService, sizes, true)
+	if strings.Contains(gitOnly, "WORKING TREE") {
+		t.Errorf("formatSizes() with gitOnly should not show the working tree, got:\n%s", gitOnly)
+	}
+	if !strings.Contains(gitOnly, "3.0 MB") {
+		t.Errorf("formatSizes() with gitOnly should show the .git sizes, got:\n%s", gitOnly)
+	}
+}
+
+func TestSizeRow(t *testing.T) {
+	usage := repositories.DiskUsage{WorkTree: 1024, GitDir: 2048}
+
+	row := sizeRow("api", usage, false)
+	expected := []string{"api", "1.0 KB", "2.0 KB", "3.0 KB"}
+	if strings.Join(row, "|") != strings.Join(expected, "|") {
+		t.Errorf("sizeRow() = %v, want %v", row, expected)
+	}
+
+	row = sizeRow("api", usage, true)
+	expected = []string{"api", "2.0 KB"}
+	if strings.Join(row, "|") != strings.Join(expected, "|") {
+		t.Errorf("sizeRow() with gitOnly = %v, want %v", row, expected)
+	}
+}
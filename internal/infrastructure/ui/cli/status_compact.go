@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// useCompactStatus reports whether status is shown in the compact format: when --format
+// asks for it, or without --format when the terminal is too narrow for the table
+func useCompactStatus(stylesService styles.Service, format string) bool {
+	if format != "" {
+		return format == StatusFormatCompact
+	}
+	return stylesService.UseCompactLayout()
+}
+
+// formatCompactStatus renders one repository per line as its name, branch and state,
+// aligned in columns no wider than needed. With byTag, repositories are listed under
+// their tags.
+func formatCompactStatus(stylesService styles.Service, repos []*entities.Repository, byTag bool) string {
+	if len(repos) == 0 {
+		return stylesService.GetErrorStyle().Render("No repositories found") + "\n"
+	}
+
+	if !byTag {
+		return compactStatusLines(stylesService, repos)
+	}
+
+	var result bytes.Buffer
+	tags, sections := entities.GroupByTag(repos)
+	for _, tag := range tags {
+		result.WriteString(stylesService.GetSectionStyle().Render(fmt.Sprintf("%s (%d)", tag, len(sections[tag]))) + "\n")
+		result.WriteString(compactStatusLines(stylesService, sections[tag]))
+	}
+	return result.String()
+}
+
+// compactStatusLines renders the compact status line of each repository
+func compactStatusLines(stylesService styles.Service, repos []*entities.Repository) string {
+	nameWidth, branchWidth := 0, 0
+	for _, repo := range repos {
+		nameWidth = max(nameWidth, len(repo.Name))
+		branchWidth = max(branchWidth, len(compactBranch(repo)))
+	}
+
+	var result strings.Builder
+	for _, repo := range repos {
+		result.WriteString(fmt.Sprintf("%-*s  %-*s  %s\n", nameWidth, repo.Name, branchWidth, compactBranch(repo), compactState(stylesService, repo)))
+	}
+	return result.String()
+}
+
+// compactBranch returns the branch of the repository, or ? when it is unknown
+func compactBranch(repo *entities.Repository) string {
+	if repo.Branch == "" {
+		return "?"
+	}
+	return repo.Branch
+}
+
+// compactState returns the short state marker of the repository, colored like the
+// statuses of the table but without their padding
+func compactState(stylesService styles.Service, repo *entities.Repository) string {
+	marker, status := "✓clean", entities.StatusClean
+	switch {
+	case repo.IsNotGitRepository():
+		marker, status = "✗not-git", entities.StatusError
+	case repo.Status == entities.StatusError:
+		marker, status = "✗error", entities.StatusError
+	case repo.HasOperationInProgress():
+		marker, status = "◆"+repo.InProgress, entities.StatusWarning
+	case repo.HasChanges():
+		marker, status = "●dirty", entities.StatusModified
+	}
+
+	color := stylesService.GetStatusColors()[status]
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(marker)
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestUseCompactStatus(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+
+	t.Setenv("COLUMNS", "40")
+	if !useCompactStatus(stylesService, "") {
+		t.Error("useCompactStatus() should pick the compact format on a 40 column terminal")
+	}
+	if useCompactStatus(stylesService, StatusFormatTable) {
+		t.Error("useCompactStatus() should keep the table when --format table is given")
+	}
+
+	t.Setenv("COLUMNS", "120")
+	if useCompactStatus(stylesService, "") {
+		t.Error("useCompactStatus() should keep the table on a 120 column terminal")
+	}
+	if !useCompactStatus(stylesService, StatusFormatCompact) {
+		t.Error("useCompactStatus() should pick the compact format when --format compact is given")
+	}
+}
+
+func TestFormatCompactStatus(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	repos := []*entities.Repository{
+		{Name: "api", Branch: "main", ModifiedFiles: 2, Tags: []string{"backend"}},
+		{Name: "frontend", Branch: "feature/login", Tags: []string{"web"}},
+		{Name: "broken", Status: entities.StatusError},
+	}
+
+	output := formatCompactStatus(stylesService, repos, false)
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("formatCompactStatus() should print one line per repository, got:\n%s", output)
+	}
+	for i, want := range []string{"api       main           ●dirty", "frontend  feature/login  ✓clean", "broken    ?              ✗error"} {
+		if !strings.Contains(lines[i], want) {
+			t.Errorf("formatCompactStatus() line %d = %q, want it to contain %q", i, lines[i], want)
+		}
+	}
+
+	byTag := formatCompactStatus(stylesService, repos, true)
+	for _, want := range []string{"backend (1)", "web (1)", "untagged (1)"} {
+		if !strings.Contains(byTag, want) {
+			t.Errorf("formatCompactStatus() by tag should contain %q, got:\n%s", want, byTag)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+)
+
+// gitDateLayout is the layout of the author dates printed by git log --pretty=%ai
+const gitDateLayout = "2006-01-02 15:04:05 -0700"
+
+// statusJSON is the document printed by status --json
+type statusJSON struct {
+	Repositories []any                   `json:"repositories"`
+	Summary      *usecases.StatusSummary `json:"summary"`
+}
+
+// statusJSONRepository is the status of one repository in status --json
+type statusJSONRepository struct {
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	Status        string `json:"status"`
+	Branch        string `json:"branch"`
+	CreatedFiles  int    `json:"created_files"`
+	ModifiedFiles int    `json:"modified_files"`
+	DeletedFiles  int    `json:"deleted_files"`
+	Ahead         int    `json:"ahead"`
+	Behind        int    `json:"behind"`
+	InProgress    string `json:"in_progress,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// statusJSONRepositoryWithCommit adds the last commit, null when there is none
+type statusJSONRepositoryWithCommit struct {
+	statusJSONRepository
+	LastCommit *statusJSONCommit `json:"last_commit"`
+}
+
+// statusJSONCommit is the last commit of a repository in status --json
+type statusJSONCommit struct {
+	Hash    string `json:"hash"`
+	Author  string `json:"author"`
+	Date    string `json:"date"`
+	Subject string `json:"subject"`
+}
+
+// formatStatusJSON renders the repository statuses as an indented JSON document,
+// adding each repository's last commit when withCommit is set
+func formatStatusJSON(repos []*entities.Repository, summary *usecases.StatusSummary, lastCommits map[string]*repositories.CommitInfo, withCommit bool) (string, error) {
+	doc := statusJSON{
+		Repositories: make([]any, 0, len(repos)),
+		Summary:      summary,
+	}
+
+	for _, repo := range repos {
+		entry := statusJSONRepository{
+			Name:          repo.Name,
+			Path:          repo.Path,
+			Status:        string(repo.Status),
+			Branch:        repo.Branch,
+			CreatedFiles:  repo.CreatedFiles,
+			ModifiedFiles: repo.ModifiedFiles,
+			DeletedFiles:  repo.DeletedFiles,
+			Ahead:         repo.Ahead,
+			Behind:        repo.Behind,
+			InProgress:    repo.InProgress,
+			Error:         repo.ErrorMessage,
+		}
+
+		if !withCommit {
+			doc.Repositories = append(doc.Repositories, entry)
+			continue
+		}
+
+		doc.Repositories = append(doc.Repositories, statusJSONRepositoryWithCommit{
+			statusJSONRepository: entry,
+			LastCommit:           newStatusJSONCommit(lastCommits[repo.Name]),
+		})
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data) + "\n", nil
+}
+
+// newStatusJSONCommit converts a commit, normalizing its date to RFC 3339 when possible
+func newStatusJSONCommit(commit *repositories.CommitInfo) *statusJSONCommit {
+	if commit == nil {
+		return nil
+	}
+
+	date := commit.Timestamp
+	if parsed, err := time.Parse(gitDateLayout, commit.Timestamp); err == nil {
+		date = parsed.Format(time.RFC3339)
+	}
+
+	return &statusJSONCommit{
+		Hash:    commit.Hash,
+		Author:  commit.Author,
+		Date:    date,
+		Subject: commit.Message,
+	}
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+)
+
+func TestFormatStatusJSON(t *testing.T) {
+	repos := []*entities.Repository{
+		{Name: "api", Path: "/src/api", Status: entities.StatusModified, Branch: "main", ModifiedFiles: 2, Ahead: 1},
+		{Name: "empty", Path: "/src/empty", Status: entities.StatusClean, Branch: "main"},
+	}
+	summary := &usecases.StatusSummary{TotalRepositories: 2, CleanRepositories: 1, ModifiedRepositories: 1}
+	lastCommits := map[string]*repositories.CommitInfo{
+		"api": {
+			Hash:      "0123abcd",
+			Author:    "Jane Doe",
+			Message:   "Fix login",
+			Timestamp: "2024-03-01 10:20:30 +0100",
+		},
+		"empty": nil,
+	}
+
+	t.Run("with commit", func(t *testing.T) {
+		output, err := formatStatusJSON(repos, summary, lastCommits, true)
+		if err != nil {
+			t.Fatalf("formatStatusJSON() returned error: %v", err)
+		}
+
+		var doc struct {
+			Repositories []map[string]any `json:"repositories"`
+			Summary      map[string]any   `json:"summary"`
+		}
+		if err := json.Unmarshal([]byte(output), &doc); err != nil {
+			t.Fatalf("output is not valid JSON: %v\n%s", err, output)
+		}
+
+		if len(doc.Repositories) != 2 {
+			t.Fatalf("expected 2 repositories, got %d", len(doc.Repositories))
+		}
+		if doc.Repositories[0]["modified_files"] != float64(2) {
+			t.Errorf("expected change counts, got %v", doc.Repositories[0])
+		}
+
+		commit, ok := doc.Repositories[0]["last_commit"].(map[string]any)
+		if !ok {
+			t.Fatalf("expected a last_commit object, got %v", doc.Repositories[0]["last_commit"])
+		}
+		expected := map[string]any{
+			"hash":    "0123abcd",
+			"author":  "Jane Doe",
+			"date":    "2024-03-01T10:20:30+01:00",
+			"subject": "Fix login",
+		}
+		for key, value := range expected {
+			if commit[key] != value {
+				t.Errorf("last_commit.%s = %v, want %v", key, commit[key], value)
+			}
+		}
+
+		value, present := doc.Repositories[1]["last_commit"]
+		if !present || value != nil {
+			t.Errorf("expected a null last_commit for a repository without commits, got %v (present: %v)", value, present)
+		}
+
+		if doc.Summary["total_repositories"] != float64(2) {
+			t.Errorf("expected the summary, got %v", doc.Summary)
+		}
+	})
+
+	t.Run("without commit", func(t *testing.T) {
+		output, err := formatStatusJSON(repos, summary, nil, false)
+		if err != nil {
+			t.Fatalf("formatStatusJSON() returned error: %v", err)
+		}
+		if strings.Contains(output, "last_commit") {
+			t.Errorf("last_commit should only be present with --with-commit, got:\n%s", output)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatSubmoduleStatuses renders the number of submodules of each repository by state
+// as a table. Repositories without submodules show none.
+func formatSubmoduleStatuses(stylesService styles.Service, statuses []*usecases.RepositorySubmodules) string {
+	var result bytes.Buffer
+
+	result.WriteString(stylesService.GetTitleStyle().Render("🧩 Submodules") + "\n\n")
+
+	headers := []string{"Repository", "Submodules", "Up to date", "Out of date", "Uninitialized", "Conflicts"}
+	rows := make([][]string, 0, len(statuses))
+
+	for _, status := range statuses {
+		switch {
+		case status.Error != "":
+			rows = append(rows, []string{status.Repository, "❌ Error", "-", "-", "-", "-"})
+		case len(status.Submodules) == 0:
+			rows = append(rows, []string{status.Repository, "none", "-", "-", "-", "-"})
+		default:
+			rows = append(rows, []string{
+				status.Repository,
+				strconv.Itoa(len(status.Submodules)),
+				strconv.Itoa(status.Count(repositories.SubmoduleStateCurrent)),
+				strconv.Itoa(status.Count(repositories.SubmoduleStateOutOfDate)),
+				strconv.Itoa(status.Count(repositories.SubmoduleStateUninitialized)),
+				strconv.Itoa(status.Count(repositories.SubmoduleStateConflicted)),
+			})
+		}
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	return result.String()
+}
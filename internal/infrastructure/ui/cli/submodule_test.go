@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/domain/repositories"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatSubmoduleStatuses(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	statuses := []*usecases.RepositorySubmodules{
+		{Repository: "api", Submodules: []repositories.Submodule{
+			{Path: "vendor/proto", State: repositories.SubmoduleStateCurrent},
+			{Path: "vendor/ui", State: repositories.SubmoduleStateUninitialized},
+		}},
+		{Repository: "broken", Error: "not a git repository"},
+		{Repository: "web"},
+	}
+
+	output := formatSubmoduleStatuses(stylesService, statuses)
+
+	for _, want := range []string{"api", "UNINITIALIZED", "broken", "❌ Error", "web", "none"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatSubmoduleStatuses() should contain %q, got:\n%s", want, output)
+		}
+	}
+}
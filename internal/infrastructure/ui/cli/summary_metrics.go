@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+// Metrics accepted in the summary_metrics configuration
+const (
+	MetricTotal        = "total"
+	MetricSuccess      = "success"
+	MetricFailed       = "failed"
+	MetricCancelled    = "cancelled"
+	MetricSkipped      = "skipped"
+	MetricDuration     = "duration"
+	MetricSlowest      = "slowest"
+	MetricHookRejected = "hook_rejected"
+	MetricWarnings     = "warnings"
+	MetricNotGit       = "not_git"
+)
+
+// DefaultSummaryMetrics are the metrics shown when none are configured
+var DefaultSummaryMetrics = []string{
+	MetricTotal,
+	MetricSuccess,
+	MetricFailed,
+	MetricCancelled,
+	MetricSkipped,
+	MetricNotGit,
+	MetricDuration,
+	MetricHookRejected,
+	MetricWarnings,
+}
+
+// summaryMetricRows returns the statistics row of each metric, and whether it is shown
+var summaryMetricRows = map[string]func(summary *entities.Summary) ([]string, bool){
+	MetricTotal: func(summary *entities.Summary) ([]string, bool) {
+		return []string{"Total Repositories", strconv.Itoa(summary.TotalCount())}, true
+	},
+	MetricSuccess: func(summary *entities.Summary) ([]string, bool) {
+		return []string{"Successful", strconv.Itoa(summary.SuccessfulCount())}, true
+	},
+	MetricFailed: func(summary *entities.Summary) ([]string, bool) {
+		return []string{"Failed", strconv.Itoa(summary.FailedCount())}, true
+	},
+	MetricCancelled: func(summary *entities.Summary) ([]string, bool) {
+		return []string{"Cancelled", strconv.Itoa(summary.CancelledCount())}, true
+	},
+	MetricSkipped: func(summary *entities.Summary) ([]string, bool) {
+		return []string{"Skipped", strconv.Itoa(summary.SkippedCount())}, true
+	},
+	MetricDuration: func(summary *entities.Summary) ([]string, bool) {
+		return []string{"Duration", summary.GetTotalDuration().String()}, true
+	},
+	MetricSlowest: func(summary *entities.Summary) ([]string, bool) {
+		slowest := "N/A"
+		if result := summary.Slowest(); result != nil {
+			slowest = fmt.Sprintf("%s (%s)", result.Repository, result.Duration)
+		}
+		return []string{"Slowest", slowest}, true
+	},
+	// Paths that are not git repositories, hook rejections and warnings are only shown
+	// when some occurred
+	MetricNotGit: func(summary *entities.Summary) ([]string, bool) {
+		notGit := summary.NotGitRepositoryCount()
+		return []string{"Not a Git Repository", strconv.Itoa(notGit)}, notGit > 0
+	},
+	MetricHookRejected: func(summary *entities.Summary) ([]string, bool) {
+		hookFailures := summary.HookFailureCount()
+		return []string{"Rejected by Hooks", strconv.Itoa(hookFailures)}, hookFailures > 0
+	},
+	MetricWarnings: func(summary *entities.Summary) ([]string, bool) {
+		warnings := summary.WarningCount()
+		return []string{"Warnings", strconv.Itoa(warnings)}, warnings > 0
+	},
+}
+
+// splitSummaryMetrics separates the supported metrics from the unknown ones, keeping their order
+func splitSummaryMetrics(metrics []string) (known, unknown []string) {
+	for _, metric := range metrics {
+		if _, ok := summaryMetricRows[metric]; ok {
+			known = append(known, metric)
+		} else {
+			unknown = append(unknown, metric)
+		}
+	}
+	return known, unknown
+}
+
+// summaryMetricsData returns the statistics rows of the metrics for the summary
+func summaryMetricsData(summary *entities.Summary, metrics []string) [][]string {
+	if len(metrics) == 0 {
+		metrics = DefaultSummaryMetrics
+	}
+
+	data := make([][]string, 0, len(metrics))
+	for _, metric := range metrics {
+		if row, show := summaryMetricRows[metric](summary); show {
+			data = append(data, row)
+		}
+	}
+	return data
+}
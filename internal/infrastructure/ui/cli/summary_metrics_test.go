@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func newMetricsSummary() *entities.Summary {
+	summary := entities.NewSummary()
+	for name, duration := range map[string]time.Duration{"api": time.Second, "web": 3 * time.Second} {
+		result := entities.NewExecutionResult(name, "git pull")
+		result.MarkAsSuccess("", 0)
+		result.Duration = duration
+		summary.AddResult(*result)
+	}
+	return summary
+}
+
+func TestSummaryMetricsData(t *testing.T) {
+	summary := newMetricsSummary()
+
+	defaults := summaryMetricsData(summary, nil)
+	var labels []string
+	for _, row := range defaults {
+		labels = append(labels, row[0])
+	}
+	if strings.Join(labels, ",") != "Total Repositories,Successful,Failed,Cancelled,Skipped,Duration" {
+		t.Errorf("summaryMetricsData() default rows = %v", labels)
+	}
+
+	custom := summaryMetricsData(summary, []string{MetricSlowest, MetricFailed})
+	if len(custom) != 2 || custom[0][0] != "Slowest" || custom[0][1] != "web (3s)" || custom[1][0] != "Failed" {
+		t.Errorf("summaryMetricsData() custom rows = %v, want Slowest then Failed", custom)
+	}
+}
+
+func TestPresenter_SetSummaryMetrics(t *testing.T) {
+	presenter := NewPresenter(styles.NewService("fleet")).(*Presenter)
+
+	unknown := presenter.SetSummaryMetrics([]string{"duration", "p99", "total"})
+	if len(unknown) != 1 || unknown[0] != "p99" {
+		t.Errorf("SetSummaryMetrics() unknown = %v, want [p99]", unknown)
+	}
+
+	output := presenter.PresentSummaryOnly(newMetricsSummary())
+	duration := strings.Index(output, "Duration")
+	total := strings.Index(output, "Total Repositories")
+	if duration < 0 || total < duration || strings.Contains(output, "Successful") {
+		t.Errorf("PresentSummaryOnly() should only show duration then total, got:\n%s", output)
+	}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+// formatRemoteSwitches renders the URL changes of the remote in each repository as a
+// table, or the changes that would be made in a dry run
+func formatRemoteSwitches(stylesService styles.Service, remote string, switches []*usecases.RepositoryRemoteSwitch, dryRun bool) string {
+	var result bytes.Buffer
+
+	title := "🔀 Switch Remote " + remote
+	if dryRun {
+		title += " (dry run)"
+	}
+	result.WriteString(stylesService.GetTitleStyle().Render(title) + "\n\n")
+
+	headers := []string{"Repository", "Result"}
+	rows := make([][]string, 0, len(switches))
+
+	// The URLs are listed below the table, where long ones are not truncated
+	var details strings.Builder
+	total := 0
+	for _, remoteSwitch := range switches {
+		switch {
+		case remoteSwitch.Error != "":
+			rows = append(rows, []string{remoteSwitch.Repository, "❌ Error"})
+			details.WriteString(fmt.Sprintf("❌ %s: %s\n", remoteSwitch.Repository, remoteSwitch.Error))
+		case remoteSwitch.Skipped != "":
+			rows = append(rows, []string{remoteSwitch.Repository, "⏭️ " + remoteSwitch.Skipped})
+		case remoteSwitch.IsUnchanged():
+			rows = append(rows, []string{remoteSwitch.Repository, "✨ Unchanged"})
+		case dryRun:
+			total++
+			rows = append(rows, []string{remoteSwitch.Repository, "🔍 To switch"})
+			details.WriteString(fmt.Sprintf("🔍 %s: %s → %s\n", remoteSwitch.Repository, remoteSwitch.OldURL, remoteSwitch.NewURL))
+		default:
+			total++
+			rows = append(rows, []string{remoteSwitch.Repository, "🔀 Switched"})
+			details.WriteString(fmt.Sprintf("🔀 %s: %s → %s\n", remoteSwitch.Repository, remoteSwitch.OldURL, remoteSwitch.NewURL))
+		}
+	}
+
+	result.WriteString(stylesService.CreateResponsiveTable(headers, rows) + "\n")
+	result.WriteString(details.String())
+
+	verb := "switched"
+	if dryRun {
+		verb = "would be switched"
+	}
+	result.WriteString(fmt.Sprintf("%d remotes %s in %d repositories\n", total, verb, len(switches)))
+	return result.String()
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+)
+
+func TestFormatRemoteSwitches(t *testing.T) {
+	stylesService := styles.NewService(styles.ThemeFleetName)
+	switches := []*usecases.RepositoryRemoteSwitch{
+		{Repository: "api", OldURL: "git@old.example.com:team/api.git", NewURL: "git@new.example.com:team/api.git"},
+		{Repository: "docs", OldURL: "git@new.example.com:team/docs.git", NewURL: "git@new.example.com:team/docs.git"},
+		{Repository: "scratch", Skipped: "no such remote origin"},
+		{Repository: "broken", Error: "failed to get remotes"},
+	}
+
+	output := formatRemoteSwitches(stylesService, "origin", switches, false)
+	for _, want := range []string{"Switch Remote origin", "🔀 Switched", "git@old.example.com:team/api.git → git@new.example.com:team/api.git", "✨ Unchanged", "⏭️ no such remote origin", "❌ Error", "1 remotes switched in 4 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatRemoteSwitches() should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output = formatRemoteSwitches(stylesService, "origin", switches, true)
+	for _, want := range []string{"dry run", "🔍 To switch", "1 remotes would be switched in 4 repositories"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("formatRemoteSwitches() in a dry run should contain %q, got:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/qskkk/git-fleet/v2/internal/application/usecases"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// parseTagReleaseArgs parses the arguments of tag-release: the version, an optional
+// -m/--message and --no-push
+func parseTagReleaseArgs(args []string) (*usecases.TagReleaseInput, error) {
+	input := &usecases.TagReleaseInput{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+
+		switch {
+		case arg == "--no-push":
+			input.NoPush = true
+		case name == "-m" || name == "--message":
+			v, next, err := flagValue(args, i, name, value, hasValue)
+			if err != nil {
+				return nil, err
+			}
+			input.Message = v
+			i = next
+		case input.Version == "" && !strings.HasPrefix(arg, "-"):
+			input.Version = arg
+		default:
+			return nil, errors.ErrUsageTagRelease
+		}
+	}
+
+	if input.Version == "" {
+		return nil, errors.ErrUsageTagRelease
+	}
+
+	return input, nil
+}
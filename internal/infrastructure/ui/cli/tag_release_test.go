@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestParseTagReleaseArgs(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		expectedVersion string
+		expectedMessage string
+		expectedNoPush  bool
+		expectedErr     error
+	}{
+		{name: "version only", args: []string{"v1.2.0"}, expectedVersion: "v1.2.0"},
+		{name: "message and no push", args: []string{"v1.2.0", "-m", "Spring release", "--no-push"}, expectedVersion: "v1.2.0", expectedMessage: "Spring release", expectedNoPush: true},
+		{name: "message with equals", args: []string{"--message=Hotfix", "v1.2.1"}, expectedVersion: "v1.2.1", expectedMessage: "Hotfix"},
+		{name: "missing version", args: []string{"--no-push"}, expectedErr: errors.ErrUsageTagRelease},
+		{name: "two versions", args: []string{"v1", "v2"}, expectedErr: errors.ErrUsageTagRelease},
+		{name: "unknown option", args: []string{"v1", "--force"}, expectedErr: errors.ErrUsageTagRelease},
+		{name: "message without value", args: []string{"v1", "-m"}, expectedErr: errors.ErrFlagRequiresValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := parseTagReleaseArgs(tt.args)
+			if tt.expectedErr != nil {
+				if !errors.IsError(err, tt.expectedErr) {
+					t.Errorf("parseTagReleaseArgs() error = %v, want %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseTagReleaseArgs() unexpected error: %v", err)
+			}
+
+			if input.Version != tt.expectedVersion || input.Message != tt.expectedMessage || input.NoPush != tt.expectedNoPush {
+				t.Errorf("parseTagReleaseArgs() = %+v", input)
+			}
+		})
+	}
+}
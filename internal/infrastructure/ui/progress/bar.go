@@ -190,8 +190,13 @@ func (pb *ProgressBar) renderComplete() string {
 				execDuration = fmt.Sprintf(" (%v)", result.Duration.Round(time.Millisecond))
 			}
 
-			if result.IsSuccess() {
+			if result.IsSuccess() && result.HasWarning() {
+				b.WriteString(fmt.Sprintf("  ⚠️ %s: %s%s\n", repo, result.Warning, execDuration))
+			} else if result.IsSuccess() {
 				b.WriteString(fmt.Sprintf("  %s %s%s\n", checkMark.Render(), repo, execDuration))
+			} else if result.IsHookFailure() {
+				b.WriteString(fmt.Sprintf("  %s %s: rejected by a git hook%s\n", errorMark.Render(), repo, execDuration))
+				b.WriteString(indentHookOutput(result.HookOutput))
 			} else if result.IsFailed() {
 				b.WriteString(fmt.Sprintf("  %s %s: %s%s\n", errorMark.Render(), repo, result.ErrorMessage, execDuration))
 			}
@@ -201,6 +206,19 @@ func (pb *ProgressBar) renderComplete() string {
 	return b.String()
 }
 
+// indentHookOutput indents the output of a rejecting hook below its repository
+func indentHookOutput(output string) string {
+	if strings.TrimSpace(output) == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		b.WriteString("      " + line + "\n")
+	}
+	return b.String()
+}
+
 // Clear returns empty string to clear the screen
 func (pb *ProgressBar) Clear() string {
 	return ""
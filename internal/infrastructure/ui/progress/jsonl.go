@@ -0,0 +1,132 @@
+package progress
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+// Event types written by the JSON lines reporter
+const (
+	EventStart            = "start"
+	EventRepositoryStart  = "repository_start"
+	EventRepositoryFinish = "repository_finish"
+	EventFinish           = "finish"
+)
+
+// Event is a single execution event written as one JSON line
+type Event struct {
+	Type         string    `json:"type"`
+	Time         time.Time `json:"time"`
+	Command      string    `json:"command,omitempty"`
+	Repositories []string  `json:"repositories,omitempty"`
+	Repository   string    `json:"repository,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	ExitCode     *int      `json:"exit_code,omitempty"`
+	DurationMs   int64     `json:"duration_ms,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	Code         string    `json:"code,omitempty"`
+	Successful   *int      `json:"successful,omitempty"`
+	Failed       *int      `json:"failed,omitempty"`
+}
+
+// JSONLinesReporter reports execution progress as newline-delimited JSON events
+type JSONLinesReporter struct {
+	encoder    *json.Encoder
+	mutex      sync.Mutex
+	command    string
+	startTime  time.Time
+	successful int
+	failed     int
+}
+
+// NewJSONLinesReporter creates a reporter writing JSON lines to the given writer
+func NewJSONLinesReporter(w io.Writer) *JSONLinesReporter {
+	return &JSONLinesReporter{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// StartProgress writes the start event with the selected repositories
+func (r *JSONLinesReporter) StartProgress(repositories []string, command string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.command = command
+	r.startTime = time.Now()
+	r.successful = 0
+	r.failed = 0
+
+	r.write(Event{
+		Type:         EventStart,
+		Time:         r.startTime,
+		Command:      command,
+		Repositories: repositories,
+	})
+}
+
+// MarkRepositoryAsStarting writes an event when a repository starts executing
+func (r *JSONLinesReporter) MarkRepositoryAsStarting(repoName string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.write(Event{
+		Type:       EventRepositoryStart,
+		Time:       time.Now(),
+		Command:    r.command,
+		Repository: repoName,
+	})
+}
+
+// UpdateProgress writes an event when a repository finishes executing
+func (r *JSONLinesReporter) UpdateProgress(result *entities.ExecutionResult) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if result.IsSuccess() {
+		r.successful++
+	} else {
+		r.failed++
+	}
+
+	exitCode := result.ExitCode
+	event := Event{
+		Type:       EventRepositoryFinish,
+		Time:       time.Now(),
+		Command:    result.Command,
+		Repository: result.Repository,
+		Status:     string(result.Status),
+		ExitCode:   &exitCode,
+		DurationMs: result.Duration.Milliseconds(),
+	}
+	if !result.IsSuccess() {
+		event.Error = result.ErrorMessage
+		event.Code = result.ErrorCode
+	}
+
+	r.write(event)
+}
+
+// FinishProgress writes the final event with the execution counts
+func (r *JSONLinesReporter) FinishProgress() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	successful, failed := r.successful, r.failed
+	r.write(Event{
+		Type:       EventFinish,
+		Time:       time.Now(),
+		Command:    r.command,
+		DurationMs: time.Since(r.startTime).Milliseconds(),
+		Successful: &successful,
+		Failed:     &failed,
+	})
+}
+
+// write encodes an event as a single line, ignoring write errors like the terminal reporter
+func (r *JSONLinesReporter) write(event Event) {
+	_ = r.encoder.Encode(event)
+}
@@ -0,0 +1,73 @@
+package progress
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+func TestJSONLinesReporter(t *testing.T) {
+	var out bytes.Buffer
+	reporter := NewJSONLinesReporter(&out)
+
+	reporter.StartProgress([]string{"repo1", "repo2"}, "git fetch")
+	reporter.MarkRepositoryAsStarting("repo1")
+	reporter.MarkRepositoryAsStarting("repo2")
+
+	success := entities.NewExecutionResult("repo1", "git fetch")
+	success.MarkAsSuccess("", 0)
+	success.Duration = 1500 * time.Millisecond
+	reporter.UpdateProgress(success)
+
+	failed := entities.NewExecutionResult("repo2", "git fetch")
+	failed.MarkAsFailed("fatal: unable to access", 128, "exit status 128")
+	failed.ErrorCode = "network_failure"
+	reporter.UpdateProgress(failed)
+
+	reporter.FinishProgress()
+
+	var events []Event
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("line is not valid JSON: %q: %v", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+
+	expectedTypes := []string{EventStart, EventRepositoryStart, EventRepositoryStart, EventRepositoryFinish, EventRepositoryFinish, EventFinish}
+	if len(events) != len(expectedTypes) {
+		t.Fatalf("expected %d events, got %d", len(expectedTypes), len(events))
+	}
+	for i, eventType := range expectedTypes {
+		if events[i].Type != eventType {
+			t.Errorf("event %d type = %q, want %q", i, events[i].Type, eventType)
+		}
+	}
+
+	if len(events[0].Repositories) != 2 || events[0].Command != "git fetch" {
+		t.Errorf("unexpected start event: %+v", events[0])
+	}
+
+	if events[3].Status != string(entities.ExecutionStatusSuccess) || events[3].DurationMs != 1500 || events[3].Error != "" {
+		t.Errorf("unexpected success event: %+v", events[3])
+	}
+
+	if events[4].Status != string(entities.ExecutionStatusFailed) || events[4].ExitCode == nil || *events[4].ExitCode != 128 || events[4].Error != "exit status 128" || events[4].Code != "network_failure" {
+		t.Errorf("unexpected failure event: %+v", events[4])
+	}
+
+	finish := events[5]
+	if finish.Successful == nil || *finish.Successful != 1 || finish.Failed == nil || *finish.Failed != 1 {
+		t.Errorf("unexpected finish event: %+v", finish)
+	}
+}
+
+func TestJSONLinesReporter_ImplementsProgressReporter(t *testing.T) {
+	var _ ProgressReporter = NewJSONLinesReporter(&bytes.Buffer{})
+}
@@ -2,7 +2,9 @@ package progress
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"sync"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
@@ -16,16 +18,45 @@ type ProgressService struct {
 	mutex        sync.Mutex
 	lastOutput   string
 	StyleService styles.Service
+	out          io.Writer
+	sortByName   bool
 }
 
 // NewProgressService creates a new progress service
 func NewProgressService(styleService styles.Service) *ProgressService {
 	return &ProgressService{
-		enabled:      isTerminal(),
+		enabled:      isTerminal(os.Stdout),
 		StyleService: styleService,
 	}
 }
 
+// SetOutput sets the writer the progress is written to, stdout by default. The progress is
+// only redrawn as the repositories complete on a terminal: elsewhere, such as in a pager,
+// only the final results are written.
+func (ps *ProgressService) SetOutput(out io.Writer) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	ps.out = out
+}
+
+// output returns the writer set with SetOutput, defaulting to stdout
+func (ps *ProgressService) output() io.Writer {
+	if ps.out == nil {
+		return os.Stdout
+	}
+	return ps.out
+}
+
+// SetSortByName sets whether the repositories are listed sorted by name rather than in
+// the order they are run
+func (ps *ProgressService) SetSortByName(sorted bool) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	ps.sortByName = sorted
+}
+
 // StartProgress initializes and starts the progress bar
 func (ps *ProgressService) StartProgress(repositories []string, command string) {
 	if !ps.enabled {
@@ -35,6 +66,11 @@ func (ps *ProgressService) StartProgress(repositories []string, command string)
 	ps.mutex.Lock()
 	defer ps.mutex.Unlock()
 
+	if ps.sortByName {
+		repositories = append([]string(nil), repositories...)
+		sort.Strings(repositories)
+	}
+
 	ps.progressBar = NewProgressBar(ps.StyleService, repositories, command)
 	ps.renderAndDisplay()
 }
@@ -61,10 +97,11 @@ func (ps *ProgressService) FinishProgress() {
 	ps.mutex.Lock()
 	defer ps.mutex.Unlock()
 
-	// Clear previous output and show final result
+	// Clear previous output and show final result, which is left on screen
 	ps.clearPreviousOutput()
-	fmt.Print(ps.progressBar.Render())
-	fmt.Println()
+	fmt.Fprint(ps.output(), ps.progressBar.Render())
+	fmt.Fprintln(ps.output())
+	ps.lastOutput = ""
 }
 
 // MarkRepositoryAsStarting marks a repository as starting execution
@@ -82,7 +119,7 @@ func (ps *ProgressService) MarkRepositoryAsStarting(repoName string) {
 
 // renderAndDisplay renders the progress bar and displays it, clearing previous output
 func (ps *ProgressService) renderAndDisplay() {
-	if ps.progressBar == nil {
+	if ps.progressBar == nil || !isTerminal(ps.output()) {
 		return
 	}
 
@@ -94,7 +131,7 @@ func (ps *ProgressService) renderAndDisplay() {
 	ps.lastOutput = output
 
 	// Display the new output
-	fmt.Print(output)
+	fmt.Fprint(ps.output(), output)
 }
 
 // clearPreviousOutput clears the previous output by moving cursor up and clearing lines
@@ -113,25 +150,29 @@ func (ps *ProgressService) clearPreviousOutput() {
 
 	// Move cursor up and clear each line
 	for i := 0; i < lines; i++ {
-		fmt.Print("\033[1A\033[2K") // Move up one line and clear it
+		fmt.Fprint(ps.output(), "\033[1A\033[2K") // Move up one line and clear it
 	}
 }
 
 // renderProgressBar renders the current state of the progress bar
 func (ps *ProgressService) renderProgressBar() {
 	if ps.progressBar != nil {
-		fmt.Print(ps.progressBar.Render())
+		fmt.Fprint(ps.output(), ps.progressBar.Render())
 	}
 }
 
 // clearScreen clears the terminal screen and moves cursor to top
 func (ps *ProgressService) clearScreen() {
-	fmt.Print("\033[2J\033[H")
+	fmt.Fprint(ps.output(), "\033[2J\033[H")
 }
 
 // isTerminal checks if the output is a terminal
-func isTerminal() bool {
-	stat, err := os.Stdout.Stat()
+func isTerminal(out io.Writer) bool {
+	file, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	stat, err := file.Stat()
 	if err != nil {
 		return false
 	}
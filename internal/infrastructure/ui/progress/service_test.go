@@ -1,7 +1,10 @@
 package progress
 
 import (
+	"bytes"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
@@ -101,6 +104,54 @@ func TestProgressService_UpdateProgress(t *testing.T) {
 	}
 }
 
+func TestProgressService_SetOutput(t *testing.T) {
+	service := &ProgressService{enabled: true, StyleService: createIntegrationStylesService()}
+	var out bytes.Buffer
+	service.SetOutput(&out)
+
+	service.StartProgress([]string{"repo1"}, "git status")
+	service.MarkRepositoryAsStarting("repo1")
+	if out.Len() != 0 {
+		t.Errorf("the progress should not be redrawn outside a terminal, got %q", out.String())
+	}
+
+	result := entities.NewExecutionResult("repo1", "git status")
+	result.MarkAsSuccess("On branch main", 0)
+	service.UpdateProgress(result)
+	service.FinishProgress()
+
+	if !strings.Contains(out.String(), "✓ repo1") {
+		t.Errorf("FinishProgress() should write the results to the output, got %q", out.String())
+	}
+	if strings.Contains(out.String(), "\033[1A") {
+		t.Errorf("FinishProgress() should not move the cursor outside a terminal, got %q", out.String())
+	}
+}
+
+func TestProgressService_SetSortByName(t *testing.T) {
+	service := &ProgressService{enabled: true, StyleService: createIntegrationStylesService()}
+	var out bytes.Buffer
+	service.SetOutput(&out)
+	service.SetSortByName(true)
+
+	repositories := []string{"web", "api"}
+	service.StartProgress(repositories, "git pull")
+	for _, repo := range repositories {
+		result := entities.NewExecutionResult(repo, "git pull")
+		result.MarkAsSuccess("", 0)
+		service.UpdateProgress(result)
+	}
+	service.FinishProgress()
+
+	api, web := strings.Index(out.String(), "✓ api"), strings.Index(out.String(), "✓ web")
+	if api < 0 || web < 0 || api > web {
+		t.Errorf("FinishProgress() should list the results sorted by name, got %q", out.String())
+	}
+	if repositories[0] != "web" {
+		t.Errorf("StartProgress() should not reorder the given repositories, got %v", repositories)
+	}
+}
+
 func TestProgressService_UpdateProgressWithoutStart(t *testing.T) {
 	service := &ProgressService{enabled: true, StyleService: createIntegrationStylesService()}
 
@@ -425,7 +476,7 @@ func TestProgressService_ClearScreen(t *testing.T) {
 
 func TestIsTerminal(t *testing.T) {
 	// This test is environment dependent, but we can at least verify it doesn't panic
-	result := isTerminal()
+	result := isTerminal(os.Stdout)
 
 	// Result can be true or false depending on environment, just verify it's a boolean
 	if result != true && result != false {
@@ -11,7 +11,11 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/muesli/termenv"
 	"golang.org/x/term"
+
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/themes"
 )
 
 // Theme configuration
@@ -24,9 +28,11 @@ const (
 )
 
 const (
-	ThemeDarkName  = "dark"
-	ThemeLightName = "light"
-	ThemeFleetName = "fleet"
+	ThemeDarkName  = themes.Dark
+	ThemeLightName = themes.Light
+	ThemeFleetName = themes.Fleet
+	ThemeAutoName  = themes.Auto
+	ThemeTimeName  = themes.Time
 )
 
 // Dark Theme Color Constants (Catppuccin Mocha)
@@ -122,6 +128,39 @@ const (
 	FleetColorTerminalBorder = "39" // Cyan terminal color
 )
 
+// BorderStyle configuration
+type BorderStyle int
+
+const (
+	BorderStyleDefault BorderStyle = iota // Each table keeps its own border
+	BorderStyleNormal
+	BorderStyleRounded
+	BorderStyleThick
+	BorderStyleNone
+)
+
+const (
+	BorderStyleNormalName  = themes.BorderNormal
+	BorderStyleRoundedName = themes.BorderRounded
+	BorderStyleThickName   = themes.BorderThick
+	BorderStyleNoneName    = themes.BorderNone
+)
+
+// BorderStyleNames lists the supported border style names
+var BorderStyleNames = themes.BorderStyleNames
+
+// Path styles of the repository paths in tables: abbreviated with ~ for the home
+// directory, relative to the current directory, in full or hidden
+const (
+	PathStyleAbbrev   = "abbrev"
+	PathStyleRelative = "relative"
+	PathStyleFull     = "full"
+	PathStyleNone     = "none"
+)
+
+// PathStyleNames lists the supported path styles
+var PathStyleNames = []string{PathStyleAbbrev, PathStyleRelative, PathStyleFull, PathStyleNone}
+
 var CurrentTheme = ThemeFleet // Default to fleet theme
 
 func GetThemeFromString(themeStr string) Theme {
@@ -132,11 +171,81 @@ func GetThemeFromString(themeStr string) Theme {
 		return ThemeLight
 	case ThemeFleetName:
 		return ThemeFleet
+	case ThemeAutoName:
+		return DetectTheme()
 	default:
 		return ThemeDark // Default to dark theme
 	}
 }
 
+// DisableColors renders every style as plain text, without colors or attributes
+func DisableColors() {
+	lipgloss.SetColorProfile(termenv.Ascii)
+}
+
+// isTerminal reports whether stdout is a terminal
+var isTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// hasDarkBackground queries the terminal background with OSC 11, falling back
+// to COLORFGBG, and reports a dark background when neither answers
+var hasDarkBackground = lipgloss.HasDarkBackground
+
+// DetectTheme picks the theme matching the terminal background: light on a light
+// background, dark otherwise or when stdout is not a terminal
+func DetectTheme() Theme {
+	if !isTerminal() || hasDarkBackground() {
+		return ThemeDark
+	}
+	return ThemeLight
+}
+
+// GetScheduledTheme returns the theme of the time theme at the given hour: light from
+// the dayStart hour until the dayEnd hour, which may be on the next day, and the night
+// theme, dark unless set, the rest of the day. ok is false when the hours are not those
+// of a day, the fleet theme being returned then.
+func GetScheduledTheme(hour, dayStart, dayEnd int, nightTheme string) (theme Theme, ok bool) {
+	if dayStart < 0 || dayStart > 23 || dayEnd < 0 || dayEnd > 24 || dayStart == dayEnd {
+		return ThemeFleet, false
+	}
+
+	daytime := hour >= dayStart && hour < dayEnd
+	if dayEnd < dayStart {
+		daytime = hour >= dayStart || hour < dayEnd
+	}
+
+	switch {
+	case daytime:
+		return ThemeLight, true
+	case nightTheme == "":
+		return ThemeDark, true
+	default:
+		return GetThemeFromString(nightTheme), true
+	}
+}
+
+// GetBorderStyleFromString returns the border style matching the given name
+func GetBorderStyleFromString(borderStyleStr string) BorderStyle {
+	switch strings.ToLower(borderStyleStr) {
+	case BorderStyleNormalName:
+		return BorderStyleNormal
+	case BorderStyleRoundedName:
+		return BorderStyleRounded
+	case BorderStyleThickName:
+		return BorderStyleThick
+	case BorderStyleNoneName:
+		return BorderStyleNone
+	default:
+		return BorderStyleDefault
+	}
+}
+
+// IsValidBorderStyle reports whether the given name is a supported border style
+func IsValidBorderStyle(borderStyleStr string) bool {
+	return themes.IsValidBorderStyle(borderStyleStr)
+}
+
 // Service provides styling functionality
 type Service interface {
 	GetTitleStyle() lipgloss.Style
@@ -155,16 +264,26 @@ type Service interface {
 	TruncateString(str string, maxWidth int) string
 	CalculateColumnWidths(headers []string, data [][]string, terminalWidth int) []int
 	CreateResponsiveTable(headers []string, data [][]string) string
+	UseCompactLayout() bool
 
 	// Theme and color methods
 	SetTheme(theme Theme)
 	GetTheme() Theme
-	GetStatusColors() map[string]string
-	GetDimStatusColors() map[string]string
+	GetStatusColors() map[entities.RepositoryStatus]string
+	GetDimStatusColors() map[entities.RepositoryStatus]string
 	GetBorderColor() string
 	GetTextColor() string
 	GetLightTextColor() string
 
+	// Border methods
+	SetBorderStyle(borderStyle BorderStyle)
+	GetBorderStyle() BorderStyle
+
+	// Repository path display
+	SetPathDisplay(pathStyle string)
+	FormatPath(path string) string
+	ShowsPaths() bool
+
 	// Current repository highlighting
 	IsCurrentRepository(repoPath string) bool
 	GetHighlightColor() string
@@ -184,6 +303,8 @@ type StylesService struct {
 	labelStyle     lipgloss.Style
 	tableStyle     lipgloss.Style
 	theme          Theme
+	borderStyle    BorderStyle
+	pathDisplay    string
 }
 
 // getThemeColors returns the appropriate colors for the given theme
@@ -311,6 +432,14 @@ func (s *StylesService) GetTerminalWidth() int {
 	return width
 }
 
+// CompactLayoutWidth is the terminal width below which one-line layouts replace tables
+const CompactLayoutWidth = 60
+
+// UseCompactLayout reports whether the terminal is too narrow for multi-column tables
+func (s *StylesService) UseCompactLayout() bool {
+	return s.GetTerminalWidth() < CompactLayoutWidth
+}
+
 // TruncateString truncates a string to fit within a given width
 func (s *StylesService) TruncateString(str string, maxWidth int) string {
 	if len(str) <= maxWidth {
@@ -454,7 +583,7 @@ func (s *StylesService) CreateResponsiveTable(headers []string, data [][]string)
 
 	// Create table with colors and styling like the original
 	t := table.New().
-		Border(lipgloss.NormalBorder()).
+		Border(s.getBorder(lipgloss.NormalBorder())).
 		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color(borderColor))).
 		Headers(capitalizedHeaders...).
 		Width(tableWidth).
@@ -506,8 +635,10 @@ func (s *StylesService) CreateResponsiveTable(headers []string, data [][]string)
 					currentStatusColors = dimStatusColors
 				}
 
-				if color, exists := currentStatusColors[cellValue]; exists {
-					return lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+				if status, ok := labelStatus(cellValue); ok {
+					if color, exists := currentStatusColors[status]; exists {
+						return lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+					}
 				}
 			}
 
@@ -518,9 +649,46 @@ func (s *StylesService) CreateResponsiveTable(headers []string, data [][]string)
 			return lipgloss.NewStyle().Foreground(lipgloss.Color(textColor))
 		})
 
+	// Keep only blank column separators so the output is easy to copy and paste
+	if s.borderStyle == BorderStyleNone {
+		t = t.BorderTop(false).
+			BorderBottom(false).
+			BorderLeft(false).
+			BorderRight(false).
+			BorderHeader(false)
+	}
+
 	return t.String()
 }
 
+// SetBorderStyle sets the border style used for tables and rebuilds all styles
+func (s *StylesService) SetBorderStyle(borderStyle BorderStyle) {
+	s.borderStyle = borderStyle
+	s.rebuildStyles()
+}
+
+// GetBorderStyle returns the current border style
+func (s *StylesService) GetBorderStyle() BorderStyle {
+	return s.borderStyle
+}
+
+// getBorder returns the lipgloss border matching the current border style, or the given
+// default border of the table when no style is set
+func (s *StylesService) getBorder(defaultBorder lipgloss.Border) lipgloss.Border {
+	switch s.borderStyle {
+	case BorderStyleNormal:
+		return lipgloss.NormalBorder()
+	case BorderStyleRounded:
+		return lipgloss.RoundedBorder()
+	case BorderStyleThick:
+		return lipgloss.ThickBorder()
+	case BorderStyleNone:
+		return lipgloss.HiddenBorder()
+	default:
+		return defaultBorder
+	}
+}
+
 // SetTheme sets the current theme and rebuilds all styles
 func (s *StylesService) SetTheme(theme Theme) {
 	s.theme = theme
@@ -573,7 +741,7 @@ func (s *StylesService) rebuildStyles() {
 		Padding(0, 1)
 
 	s.tableStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(s.getBorder(lipgloss.RoundedBorder())).
 		BorderForeground(lipgloss.Color(borderColor)).
 		Padding(1, 2)
 }
@@ -583,97 +751,103 @@ func (s *StylesService) GetTheme() Theme {
 	return s.theme
 }
 
+// StatusLabels are the labels the statuses of repositories are displayed with
+var StatusLabels = map[entities.RepositoryStatus]string{
+	entities.StatusClean:    "✅ Clean",
+	entities.StatusModified: "📝 Modified",
+	entities.StatusError:    "❌ Error",
+	entities.StatusWarning:  "⚠️ Warning",
+	entities.StatusCreated:  "Created",
+	entities.StatusDeleted:  "Deleted",
+	entities.StatusUnknown:  "Unknown",
+}
+
+// Labels of the warning status naming the operation in progress
+const (
+	MergingLabel  = "⚠️ Merging"
+	RebasingLabel = "⚠️ Rebasing"
+)
+
+// labelStatus returns the status displayed by a table cell, when it is a status label
+func labelStatus(label string) (entities.RepositoryStatus, bool) {
+	if label == MergingLabel || label == RebasingLabel {
+		return entities.StatusWarning, true
+	}
+	for status, statusLabel := range StatusLabels {
+		if label == statusLabel {
+			return status, true
+		}
+	}
+	return "", false
+}
+
 // GetStatusColors returns status colors for the current theme
-func (s *StylesService) GetStatusColors() map[string]string {
+func (s *StylesService) GetStatusColors() map[entities.RepositoryStatus]string {
 	if s.theme == ThemeLight {
-		return map[string]string{
-			"✅ Clean":    LightColorGrassGreen,
-			"📝 Modified": LightColorElectricYellow,
-			"❌ Error":    LightColorFireRed,
-			"⚠️ Warning": LightColorFlyingPink,
-			"➕ Created":  LightColorWaterCyan,
-			"➖ Deleted":  LightColorPoisonPurple,
-			"Clean":      LightColorGrassGreen,
-			"Modified":   LightColorElectricYellow,
-			"Error":      LightColorFireRed,
-			"Warning":    LightColorFlyingPink,
+		return map[entities.RepositoryStatus]string{
+			entities.StatusClean:    LightColorGrassGreen,
+			entities.StatusModified: LightColorElectricYellow,
+			entities.StatusError:    LightColorFireRed,
+			entities.StatusWarning:  LightColorFlyingPink,
+			entities.StatusCreated:  LightColorWaterCyan,
+			entities.StatusDeleted:  LightColorPoisonPurple,
 		}
 	}
 
 	if s.theme == ThemeFleet {
-		return map[string]string{
-			"✅ Clean":    FleetColorSuccess,
-			"📝 Modified": FleetColorWarning,
-			"❌ Error":    FleetColorError,
-			"⚠️ Warning": FleetColorWarning,
-			"➕ Created":  FleetColorInfo,
-			"➖ Deleted":  FleetColorError,
-			"Clean":      FleetColorSuccess,
-			"Modified":   FleetColorWarning,
-			"Error":      FleetColorError,
-			"Warning":    FleetColorWarning,
+		return map[entities.RepositoryStatus]string{
+			entities.StatusClean:    FleetColorSuccess,
+			entities.StatusModified: FleetColorWarning,
+			entities.StatusError:    FleetColorError,
+			entities.StatusWarning:  FleetColorWarning,
+			entities.StatusCreated:  FleetColorInfo,
+			entities.StatusDeleted:  FleetColorError,
 		}
 	}
 
 	// Dark theme (default)
-	return map[string]string{
-		"✅ Clean":    DarkColorGrassGreen,
-		"📝 Modified": DarkColorElectricYellow,
-		"❌ Error":    DarkColorFireRed,
-		"⚠️ Warning": DarkColorFlyingPink,
-		"➕ Created":  DarkColorWaterCyan,
-		"➖ Deleted":  DarkColorPoisonPurple,
-		"Clean":      DarkColorGrassGreen,
-		"Modified":   DarkColorElectricYellow,
-		"Error":      DarkColorFireRed,
-		"Warning":    DarkColorFlyingPink,
+	return map[entities.RepositoryStatus]string{
+		entities.StatusClean:    DarkColorGrassGreen,
+		entities.StatusModified: DarkColorElectricYellow,
+		entities.StatusError:    DarkColorFireRed,
+		entities.StatusWarning:  DarkColorFlyingPink,
+		entities.StatusCreated:  DarkColorWaterCyan,
+		entities.StatusDeleted:  DarkColorPoisonPurple,
 	}
 }
 
 // GetDimStatusColors returns dimmed status colors for the current theme
-func (s *StylesService) GetDimStatusColors() map[string]string {
+func (s *StylesService) GetDimStatusColors() map[entities.RepositoryStatus]string {
 	if s.theme == ThemeLight {
-		return map[string]string{
-			"✅ Clean":    LightColorDimGreen,
-			"📝 Modified": LightColorPeach,
-			"❌ Error":    LightColorDimRed,
-			"⚠️ Warning": LightColorDimPink,
-			"➕ Created":  LightColorDimCyan,
-			"➖ Deleted":  LightColorDimPurple,
-			"Clean":      LightColorDimGreen,
-			"Modified":   LightColorPeach,
-			"Error":      LightColorDimRed,
-			"Warning":    LightColorDimPink,
+		return map[entities.RepositoryStatus]string{
+			entities.StatusClean:    LightColorDimGreen,
+			entities.StatusModified: LightColorPeach,
+			entities.StatusError:    LightColorDimRed,
+			entities.StatusWarning:  LightColorDimPink,
+			entities.StatusCreated:  LightColorDimCyan,
+			entities.StatusDeleted:  LightColorDimPurple,
 		}
 	}
 
 	if s.theme == ThemeFleet {
-		return map[string]string{
-			"✅ Clean":    FleetColorDimSuccess,
-			"📝 Modified": FleetColorDimWarning,
-			"❌ Error":    FleetColorDimError,
-			"⚠️ Warning": FleetColorDimWarning,
-			"➕ Created":  FleetColorDimInfo,
-			"➖ Deleted":  FleetColorDimError,
-			"Clean":      FleetColorDimSuccess,
-			"Modified":   FleetColorDimWarning,
-			"Error":      FleetColorDimError,
-			"Warning":    FleetColorDimWarning,
+		return map[entities.RepositoryStatus]string{
+			entities.StatusClean:    FleetColorDimSuccess,
+			entities.StatusModified: FleetColorDimWarning,
+			entities.StatusError:    FleetColorDimError,
+			entities.StatusWarning:  FleetColorDimWarning,
+			entities.StatusCreated:  FleetColorDimInfo,
+			entities.StatusDeleted:  FleetColorDimError,
 		}
 	}
 
 	// Dark theme (default)
-	return map[string]string{
-		"✅ Clean":    DarkColorDimGreen,
-		"📝 Modified": DarkColorPeach,
-		"❌ Error":    DarkColorDimRed,
-		"⚠️ Warning": DarkColorDimPink,
-		"➕ Created":  DarkColorDimCyan,
-		"➖ Deleted":  DarkColorDimPurple,
-		"Clean":      DarkColorDimGreen,
-		"Modified":   DarkColorPeach,
-		"Error":      DarkColorDimRed,
-		"Warning":    DarkColorDimPink,
+	return map[entities.RepositoryStatus]string{
+		entities.StatusClean:    DarkColorDimGreen,
+		entities.StatusModified: DarkColorPeach,
+		entities.StatusError:    DarkColorDimRed,
+		entities.StatusWarning:  DarkColorDimPink,
+		entities.StatusCreated:  DarkColorDimCyan,
+		entities.StatusDeleted:  DarkColorDimPurple,
 	}
 }
 
@@ -710,6 +884,59 @@ func (s *StylesService) GetLightTextColor() string {
 	return DarkColorLightGray
 }
 
+// SetPathDisplay sets the path style used by FormatPath, abbrev when empty
+func (s *StylesService) SetPathDisplay(pathStyle string) {
+	s.pathDisplay = pathStyle
+}
+
+// ShowsPaths reports whether repository paths are shown, which they are unless the
+// path style is none
+func (s *StylesService) ShowsPaths() bool {
+	return s.pathDisplay != PathStyleNone
+}
+
+// FormatPath returns the path as displayed with the path style, before any truncation.
+// Paths outside the home directory, or outside the current directory with the relative
+// style, are shown in full.
+func (s *StylesService) FormatPath(path string) string {
+	switch s.pathDisplay {
+	case PathStyleFull:
+		return path
+	case PathStyleNone:
+		return ""
+	case PathStyleRelative:
+		currentDir := s.GetCurrentWorkingDir()
+		if currentDir == "" {
+			return path
+		}
+		if relative, err := filepath.Rel(currentDir, path); err == nil && filepath.IsLocal(relative) {
+			return relative
+		}
+		return path
+	default:
+		home, err := os.UserHomeDir()
+		if err != nil || home == "" {
+			return path
+		}
+		if relative, err := filepath.Rel(home, path); err == nil && filepath.IsLocal(relative) {
+			return filepath.Join("~", relative)
+		}
+		return path
+	}
+}
+
+// expandHome returns the path with a leading ~ replaced by the home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
+
 // GetCurrentWorkingDir returns the current working directory
 func (s *StylesService) GetCurrentWorkingDir() string {
 	if wd, err := os.Getwd(); err == nil {
@@ -725,8 +952,8 @@ func (s *StylesService) IsCurrentRepository(repoPath string) bool {
 		return false
 	}
 
-	// Compare absolute paths
-	if absRepoPath, err := filepath.Abs(repoPath); err == nil {
+	// Compare absolute paths, paths in tables being possibly abbreviated or relative
+	if absRepoPath, err := filepath.Abs(expandHome(repoPath)); err == nil {
 		return absRepoPath == currentDir
 	}
 
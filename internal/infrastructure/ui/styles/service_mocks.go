@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	lipgloss "github.com/charmbracelet/lipgloss"
+	entities "github.com/qskkk/git-fleet/v2/internal/domain/entities"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -68,6 +69,20 @@ func (mr *MockServiceMockRecorder) CreateResponsiveTable(headers, data any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResponsiveTable", reflect.TypeOf((*MockService)(nil).CreateResponsiveTable), headers, data)
 }
 
+// FormatPath mocks base method.
+func (m *MockService) FormatPath(path string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FormatPath", path)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// FormatPath indicates an expected call of FormatPath.
+func (mr *MockServiceMockRecorder) FormatPath(path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatPath", reflect.TypeOf((*MockService)(nil).FormatPath), path)
+}
+
 // GetBorderColor mocks base method.
 func (m *MockService) GetBorderColor() string {
 	m.ctrl.T.Helper()
@@ -82,11 +97,25 @@ func (mr *MockServiceMockRecorder) GetBorderColor() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBorderColor", reflect.TypeOf((*MockService)(nil).GetBorderColor))
 }
 
+// GetBorderStyle mocks base method.
+func (m *MockService) GetBorderStyle() BorderStyle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBorderStyle")
+	ret0, _ := ret[0].(BorderStyle)
+	return ret0
+}
+
+// GetBorderStyle indicates an expected call of GetBorderStyle.
+func (mr *MockServiceMockRecorder) GetBorderStyle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBorderStyle", reflect.TypeOf((*MockService)(nil).GetBorderStyle))
+}
+
 // GetDimStatusColors mocks base method.
-func (m *MockService) GetDimStatusColors() map[string]string {
+func (m *MockService) GetDimStatusColors() map[entities.RepositoryStatus]string {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDimStatusColors")
-	ret0, _ := ret[0].(map[string]string)
+	ret0, _ := ret[0].(map[entities.RepositoryStatus]string)
 	return ret0
 }
 
@@ -237,10 +266,10 @@ func (mr *MockServiceMockRecorder) GetSectionStyle() *gomock.Call {
 }
 
 // GetStatusColors mocks base method.
-func (m *MockService) GetStatusColors() map[string]string {
+func (m *MockService) GetStatusColors() map[entities.RepositoryStatus]string {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStatusColors")
-	ret0, _ := ret[0].(map[string]string)
+	ret0, _ := ret[0].(map[entities.RepositoryStatus]string)
 	return ret0
 }
 
@@ -348,6 +377,30 @@ func (mr *MockServiceMockRecorder) IsCurrentRepository(repoPath any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCurrentRepository", reflect.TypeOf((*MockService)(nil).IsCurrentRepository), repoPath)
 }
 
+// SetBorderStyle mocks base method.
+func (m *MockService) SetBorderStyle(borderStyle BorderStyle) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBorderStyle", borderStyle)
+}
+
+// SetBorderStyle indicates an expected call of SetBorderStyle.
+func (mr *MockServiceMockRecorder) SetBorderStyle(borderStyle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBorderStyle", reflect.TypeOf((*MockService)(nil).SetBorderStyle), borderStyle)
+}
+
+// SetPathDisplay mocks base method.
+func (m *MockService) SetPathDisplay(pathStyle string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPathDisplay", pathStyle)
+}
+
+// SetPathDisplay indicates an expected call of SetPathDisplay.
+func (mr *MockServiceMockRecorder) SetPathDisplay(pathStyle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPathDisplay", reflect.TypeOf((*MockService)(nil).SetPathDisplay), pathStyle)
+}
+
 // SetTheme mocks base method.
 func (m *MockService) SetTheme(theme Theme) {
 	m.ctrl.T.Helper()
@@ -360,6 +413,20 @@ func (mr *MockServiceMockRecorder) SetTheme(theme any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTheme", reflect.TypeOf((*MockService)(nil).SetTheme), theme)
 }
 
+// ShowsPaths mocks base method.
+func (m *MockService) ShowsPaths() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowsPaths")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ShowsPaths indicates an expected call of ShowsPaths.
+func (mr *MockServiceMockRecorder) ShowsPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowsPaths", reflect.TypeOf((*MockService)(nil).ShowsPaths))
+}
+
 // TruncateString mocks base method.
 func (m *MockService) TruncateString(str string, maxWidth int) string {
 	m.ctrl.T.Helper()
@@ -373,3 +440,17 @@ func (mr *MockServiceMockRecorder) TruncateString(str, maxWidth any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateString", reflect.TypeOf((*MockService)(nil).TruncateString), str, maxWidth)
 }
+
+// UseCompactLayout mocks base method.
+func (m *MockService) UseCompactLayout() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseCompactLayout")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// UseCompactLayout indicates an expected call of UseCompactLayout.
+func (mr *MockServiceMockRecorder) UseCompactLayout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseCompactLayout", reflect.TypeOf((*MockService)(nil).UseCompactLayout))
+}
@@ -1,8 +1,14 @@
 package styles
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
 )
 
 func TestTheme_Constants(t *testing.T) {
@@ -336,7 +342,7 @@ func TestStylesService_GetStatusColors(t *testing.T) {
 	}
 
 	// Verify Fleet theme has specific colors
-	expectedKeys := []string{"✅ Clean", "📝 Modified", "❌ Error", "⚠️ Warning", "Clean", "Modified", "Error", "Warning"}
+	expectedKeys := []entities.RepositoryStatus{entities.StatusClean, entities.StatusModified, entities.StatusError, entities.StatusWarning}
 	for _, key := range expectedKeys {
 		if _, exists := fleetColors[key]; !exists {
 			t.Errorf("Fleet theme should have color for key: %s", key)
@@ -344,6 +350,25 @@ func TestStylesService_GetStatusColors(t *testing.T) {
 	}
 }
 
+func TestLabelStatus(t *testing.T) {
+	tests := map[string]entities.RepositoryStatus{
+		StatusLabels[entities.StatusClean]:    entities.StatusClean,
+		StatusLabels[entities.StatusModified]: entities.StatusModified,
+		StatusLabels[entities.StatusError]:    entities.StatusError,
+		MergingLabel:                          entities.StatusWarning,
+		RebasingLabel:                         entities.StatusWarning,
+	}
+	for label, expected := range tests {
+		if status, ok := labelStatus(label); !ok || status != expected {
+			t.Errorf("labelStatus(%q) = %q, %v, want %q", label, status, ok, expected)
+		}
+	}
+
+	if _, ok := labelStatus("Clean"); ok {
+		t.Error("labelStatus() should not read plain status names as labels")
+	}
+}
+
 func TestStylesService_GetDimStatusColors(t *testing.T) {
 	service := NewService(ThemeFleetName).(*StylesService)
 
@@ -621,3 +646,239 @@ func TestGetThemeFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestDetectTheme(t *testing.T) {
+	originalIsTerminal, originalHasDarkBackground := isTerminal, hasDarkBackground
+	defer func() {
+		isTerminal, hasDarkBackground = originalIsTerminal, originalHasDarkBackground
+	}()
+
+	tests := []struct {
+		name     string
+		terminal bool
+		dark     bool
+		want     Theme
+	}{
+		{"light background", true, false, ThemeLight},
+		{"dark background", true, true, ThemeDark},
+		{"not a terminal", false, false, ThemeDark},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isTerminal = func() bool { return tt.terminal }
+			hasDarkBackground = func() bool { return tt.dark }
+
+			if got := DetectTheme(); got != tt.want {
+				t.Errorf("DetectTheme() = %v, want %v", got, tt.want)
+			}
+			if got := GetThemeFromString(ThemeAutoName); got != tt.want {
+				t.Errorf("GetThemeFromString(%q) = %v, want %v", ThemeAutoName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetScheduledTheme(t *testing.T) {
+	tests := []struct {
+		name       string
+		hour       int
+		dayStart   int
+		dayEnd     int
+		nightTheme string
+		want       Theme
+		wantOK     bool
+	}{
+		{"day", 12, 8, 19, "", ThemeLight, true},
+		{"day starts", 8, 8, 19, "", ThemeLight, true},
+		{"day ends", 19, 8, 19, "", ThemeDark, true},
+		{"night theme", 2, 8, 19, ThemeFleetName, ThemeFleet, true},
+		{"day past midnight", 1, 20, 4, "", ThemeLight, true},
+		{"night between", 12, 20, 4, "", ThemeDark, true},
+		{"invalid hours", 12, 8, 30, "", ThemeFleet, false},
+		{"empty day", 12, 8, 8, "", ThemeFleet, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GetScheduledTheme(tt.hour, tt.dayStart, tt.dayEnd, tt.nightTheme)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("GetScheduledTheme(%d, %d, %d, %q) = %v, %v, want %v, %v", tt.hour, tt.dayStart, tt.dayEnd, tt.nightTheme, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGetBorderStyleFromString(t *testing.T) {
+	tests := []struct {
+		name           string
+		borderStyleStr string
+		want           BorderStyle
+	}{
+		{"normal", "normal", BorderStyleNormal},
+		{"rounded", "rounded", BorderStyleRounded},
+		{"thick uppercase", "THICK", BorderStyleThick},
+		{"none", "none", BorderStyleNone},
+		{"empty keeps the default borders", "", BorderStyleDefault},
+		{"unknown keeps the default borders", "dotted", BorderStyleDefault},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetBorderStyleFromString(tt.borderStyleStr); got != tt.want {
+				t.Errorf("GetBorderStyleFromString(%q) = %v, want %v", tt.borderStyleStr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStylesService_TableStyle_BorderStyles(t *testing.T) {
+	tests := []struct {
+		name        string
+		borderStyle BorderStyle
+		want        lipgloss.Border
+	}{
+		{"default", BorderStyleDefault, lipgloss.RoundedBorder()},
+		{"normal", BorderStyleNormal, lipgloss.NormalBorder()},
+		{"thick", BorderStyleThick, lipgloss.ThickBorder()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(ThemeFleetName)
+			service.SetBorderStyle(tt.borderStyle)
+
+			if got := service.GetTableStyle().GetBorderStyle(); got != tt.want {
+				t.Errorf("GetTableStyle() border = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidBorderStyle(t *testing.T) {
+	for _, name := range BorderStyleNames {
+		if !IsValidBorderStyle(name) {
+			t.Errorf("IsValidBorderStyle(%q) should be true", name)
+		}
+	}
+
+	if IsValidBorderStyle("dotted") {
+		t.Error("IsValidBorderStyle(\"dotted\") should be false")
+	}
+}
+
+func TestStylesService_FormatPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	workDir := filepath.Join(home, "work")
+	if err := os.Mkdir(workDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(workDir)
+
+	repoPath := filepath.Join(workDir, "api")
+	tests := []struct {
+		pathStyle string
+		path      string
+		want      string
+	}{
+		{"", repoPath, filepath.Join("~", "work", "api")},
+		{PathStyleAbbrev, home, "~"},
+		{PathStyleAbbrev, "/opt/repos/api", "/opt/repos/api"},
+		{PathStyleRelative, repoPath, "api"},
+		{PathStyleRelative, workDir, "."},
+		{PathStyleRelative, "/opt/repos/api", "/opt/repos/api"},
+		{PathStyleFull, repoPath, repoPath},
+		{PathStyleNone, repoPath, ""},
+	}
+
+	for _, tt := range tests {
+		service := NewService(ThemeFleetName)
+		service.SetPathDisplay(tt.pathStyle)
+
+		if got := service.FormatPath(tt.path); got != tt.want {
+			t.Errorf("FormatPath(%q) with %q style = %q, want %q", tt.path, tt.pathStyle, got, tt.want)
+		}
+		if shows := service.ShowsPaths(); shows != (tt.pathStyle != PathStyleNone) {
+			t.Errorf("ShowsPaths() with %q style = %v", tt.pathStyle, shows)
+		}
+	}
+
+	service := NewService(ThemeFleetName)
+	if !service.IsCurrentRepository(filepath.Join("~", "work")) {
+		t.Error("IsCurrentRepository() should match an abbreviated path of the current directory")
+	}
+}
+
+func TestStylesService_CreateResponsiveTable_BorderStyles(t *testing.T) {
+	headers := []string{"Name", "Status"}
+	data := [][]string{
+		{"repo1", "Clean"},
+		{"repo2", "Modified"},
+	}
+
+	tests := []struct {
+		name        string
+		borderStyle BorderStyle
+		corner      string
+	}{
+		{"default", BorderStyleDefault, "┌"},
+		{"normal", BorderStyleNormal, "┌"},
+		{"rounded", BorderStyleRounded, "╭"},
+		{"thick", BorderStyleThick, "┏"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(ThemeFleetName)
+			service.SetBorderStyle(tt.borderStyle)
+
+			if service.GetBorderStyle() != tt.borderStyle {
+				t.Errorf("GetBorderStyle() = %v, want %v", service.GetBorderStyle(), tt.borderStyle)
+			}
+
+			table := service.CreateResponsiveTable(headers, data)
+			if !strings.Contains(table, tt.corner) {
+				t.Errorf("CreateResponsiveTable() with %s border should contain %q", tt.name, tt.corner)
+			}
+		})
+	}
+
+	t.Run("none", func(t *testing.T) {
+		service := NewService(ThemeFleetName)
+		service.SetBorderStyle(BorderStyleNone)
+
+		table := service.CreateResponsiveTable(headers, data)
+		for _, char := range []string{"─", "│", "┌", "╭", "┏"} {
+			if strings.Contains(table, char) {
+				t.Errorf("CreateResponsiveTable() without border should not contain %q", char)
+			}
+		}
+		for _, row := range data {
+			for _, cell := range row {
+				if !strings.Contains(table, cell) {
+					t.Errorf("CreateResponsiveTable() should contain data %q", cell)
+				}
+			}
+		}
+		if len(strings.Split(table, "\n")) != len(data)+1 {
+			t.Errorf("CreateResponsiveTable() without border should have one line per row, got:\n%s", table)
+		}
+	})
+}
+
+func TestDisableColors(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.TrueColor)
+
+	style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FF0000"))
+	if got := style.Render("repo1"); got == "repo1" {
+		t.Fatalf("Render() = %q, want a styled text before DisableColors", got)
+	}
+
+	DisableColors()
+	if got := style.Render("repo1"); got != "repo1" {
+		t.Errorf("Render() = %q, want plain text after DisableColors", got)
+	}
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// matchesFilter returns true if the text contains the filter, ignoring case.
+// An empty filter matches everything.
+func matchesFilter(text, filter string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(filter))
+}
+
+// filterItems returns the groups or repositories whose title or description contains the filter
+func filterItems(items []list.Item, filter string) []list.Item {
+	if filter == "" {
+		return items
+	}
+
+	visible := make([]list.Item, 0, len(items))
+	for _, item := range items {
+		entry := item.(list.DefaultItem)
+		if matchesFilter(entry.Title(), filter) || matchesFilter(entry.Description(), filter) {
+			visible = append(visible, item)
+		}
+	}
+	return visible
+}
+
+// commandSuggestion is a command offered on the command input screen. Custom commands
+// come from the configuration and list the groups defining them.
+type commandSuggestion struct {
+	command string
+	groups  []string
+}
+
+// isCustom returns true if the command comes from the configuration rather than git
+func (s commandSuggestion) isCustom() bool {
+	return len(s.groups) > 0
+}
+
+// filterCommands returns the commands containing the filter
+func filterCommands(commands []string, filter string) []string {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return commands
+	}
+
+	visible := make([]string, 0, len(commands))
+	for _, command := range commands {
+		if matchesFilter(command, filter) {
+			visible = append(visible, command)
+		}
+	}
+	return visible
+}
+
+// highlightMatch renders the first occurrence of the filter in the text with the style
+func highlightMatch(text, filter string, style lipgloss.Style) string {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return text
+	}
+
+	index := strings.Index(strings.ToLower(text), strings.ToLower(filter))
+	end := index + len(filter)
+	if index < 0 || end > len(text) {
+		return text
+	}
+
+	return text[:index] + style.Render(text[index:end]) + text[end:]
+}
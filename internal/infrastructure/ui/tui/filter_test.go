@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func testGroups() []list.Item {
+	return []list.Item{
+		GroupItem{name: "frontend", description: "Web and mobile apps"},
+		GroupItem{name: "backend", description: "API services"},
+		GroupItem{name: "qa", description: "Test suites"},
+	}
+}
+
+func typeKeys(t *testing.T, m Model, keys string) Model {
+	t.Helper()
+	for _, r := range keys {
+		updated, _ := m.handleGroupSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+	return m
+}
+
+func TestFilterItems(t *testing.T) {
+	groups := testGroups()
+
+	if got := filterItems(groups, ""); len(got) != 3 {
+		t.Errorf("filterItems() with empty filter = %d groups, want 3", len(got))
+	}
+
+	got := filterItems(groups, "END")
+	if len(got) != 2 || got[0].(GroupItem).name != "frontend" || got[1].(GroupItem).name != "backend" {
+		t.Errorf("filterItems(END) = %v, want frontend and backend", got)
+	}
+
+	// Descriptions match too
+	if got := filterItems(groups, "api"); len(got) != 1 || got[0].(GroupItem).name != "backend" {
+		t.Errorf("filterItems(api) = %v, want backend", got)
+	}
+}
+
+func TestFilterCommands(t *testing.T) {
+	commands := []string{"git pull", "git push", "git status"}
+
+	if got := filterCommands(commands, " "); len(got) != 3 {
+		t.Errorf("filterCommands() with blank filter = %v, want all", got)
+	}
+	if got := filterCommands(commands, "pu"); len(got) != 2 {
+		t.Errorf("filterCommands(pu) = %v, want pull and push", got)
+	}
+}
+
+func TestHighlightMatch(t *testing.T) {
+	style := lipgloss.NewStyle()
+
+	if got := highlightMatch("backend", "", style); got != "backend" {
+		t.Errorf("highlightMatch() without filter = %q", got)
+	}
+	if got := highlightMatch("backend", "END", style); got != "back"+style.Render("end") {
+		t.Errorf("highlightMatch() = %q", got)
+	}
+	if got := highlightMatch("backend", "web", style); got != "backend" {
+		t.Errorf("highlightMatch() without match = %q", got)
+	}
+}
+
+func TestModel_GroupFilter(t *testing.T) {
+	model := NewModel(nil, nil, nil, createTestStylesService())
+	updated, _ := model.Update(groupsLoadedMsg(testGroups()))
+	m := updated.(Model)
+	m.width = 80
+
+	m = typeKeys(t, m, "back")
+	if !m.filtering || m.groupFilter != "back" {
+		t.Fatalf("filter = %q (filtering %v), want back", m.groupFilter, m.filtering)
+	}
+	if items := m.groupList.Items(); len(items) != 1 || items[0].(GroupItem).name != "backend" {
+		t.Errorf("visible groups = %v, want backend", items)
+	}
+	if output := m.renderGroupSelection(); containsSubstring(output, "frontend") || !containsSubstring(output, "Filter: back") {
+		t.Errorf("renderGroupSelection() should only show the matching groups, got:\n%s", output)
+	}
+
+	// Enter picks the highlighted group among the visible ones
+	updated, _ = m.handleGroupSelection(tea.KeyMsg{Type: tea.KeyEnter})
+	if selected := updated.(Model).selectedGroups; len(selected) != 1 || selected[0] != "backend" {
+		t.Errorf("selectedGroups = %v, want [backend]", selected)
+	}
+
+	updated, _ = m.handleGroupSelection(tea.KeyMsg{Type: tea.KeyBackspace})
+	if m = updated.(Model); m.groupFilter != "bac" {
+		t.Errorf("filter after backspace = %q, want bac", m.groupFilter)
+	}
+
+	updated, _ = m.handleGroupSelection(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.filtering || m.groupFilter != "" || len(m.groupList.Items()) != 3 {
+		t.Errorf("Esc should clear the filter, got %q with %d groups", m.groupFilter, len(m.groupList.Items()))
+	}
+}
+
+func TestModel_GroupFilter_Slash(t *testing.T) {
+	model := NewModel(nil, nil, nil, createTestStylesService())
+	updated, _ := model.Update(groupsLoadedMsg(testGroups()))
+	m := updated.(Model)
+
+	// q quits unless filtering, so / starts the filter for names beginning with q
+	m = typeKeys(t, m, "/q")
+	if m.groupFilter != "q" {
+		t.Errorf("filter = %q, want q", m.groupFilter)
+	}
+	if items := m.groupList.Items(); len(items) != 1 || items[0].(GroupItem).name != "qa" {
+		t.Errorf("visible groups = %v, want qa", items)
+	}
+}
+
+func TestModel_CommandSuggestions(t *testing.T) {
+	model := NewModel(nil, nil, nil, createTestStylesService())
+	model.state = StateCommandInput
+	updated, _ := model.Update(commandsLoadedMsg{"git pull", "git push", "git status"})
+	m := updated.(Model)
+
+	for _, r := range "pu" {
+		updated, _ = m.handleCommandInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+
+	output := m.renderCommandInput()
+	if !containsSubstring(output, "git pull") || containsSubstring(output, "git status") {
+		t.Errorf("renderCommandInput() should only suggest matching commands, got:\n%s", output)
+	}
+
+	updated, _ = m.handleCommandInput(tea.KeyMsg{Type: tea.KeyDown})
+	updated, _ = updated.(Model).handleCommandInput(tea.KeyMsg{Type: tea.KeyTab})
+	m = updated.(Model)
+	if m.commandInput.Value() != "git push " {
+		t.Errorf("Tab should complete the highlighted suggestion, got %q", m.commandInput.Value())
+	}
+
+	// Esc clears the typed command before going back
+	updated, _ = m.handleCommandInput(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.commandInput.Value() != "" || m.state != StateCommandInput {
+		t.Errorf("Esc should clear the command, got %q in state %d", m.commandInput.Value(), m.state)
+	}
+}
+
+func TestModel_CustomCommandSuggestions(t *testing.T) {
+	model := NewModel(nil, nil, nil, createTestStylesService())
+	updated, _ := model.Update(groupsLoadedMsg{
+		GroupItem{name: "docs", defaultCommand: "pull --rebase"},
+		GroupItem{name: "site", defaultCommand: "pull --rebase"},
+		GroupItem{name: "api", defaultCommand: "fetch --all"},
+	})
+	updated, _ = updated.Update(commandsLoadedMsg{"git pull", "git status"})
+	m := updated.(Model)
+	m.state = StateCommandInput
+	m.selectedGroups = []string{"docs", "site"}
+
+	suggestions := m.commandSuggestions()
+	if len(suggestions) != 3 || !suggestions[0].isCustom() || suggestions[0].command != "pull --rebase" {
+		t.Fatalf("commandSuggestions() = %+v, want the selected groups' default command first", suggestions)
+	}
+	if len(suggestions[0].groups) != 2 {
+		t.Errorf("groups sharing a default command should share its entry, got %v", suggestions[0].groups)
+	}
+
+	output := m.renderCommandInput()
+	if !containsSubstring(output, "★ pull --rebase  (@docs, @site)") || containsSubstring(output, "fetch --all") {
+		t.Errorf("renderCommandInput() should mark the custom commands of the selected groups, got:\n%s", output)
+	}
+
+	updated, _ = m.handleCommandInput(tea.KeyMsg{Type: tea.KeyTab})
+	if value := updated.(Model).commandInput.Value(); value != "pull --rebase " {
+		t.Errorf("Tab should complete the custom command, got %q", value)
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -26,6 +27,7 @@ const (
 // Messages
 type groupsLoadedMsg []list.Item
 type groupsLoadErrorMsg error
+type commandsLoadedMsg []string
 
 // Model represents the TUI model
 type Model struct {
@@ -44,6 +46,12 @@ type Model struct {
 	shouldExecute   bool
 	error           error
 
+	// Filtering
+	groupFilter  string
+	filtering    bool
+	commands     []string
+	commandIndex int
+
 	// UI components
 	groupList list.Model
 	width     int
@@ -52,9 +60,10 @@ type Model struct {
 
 // GroupItem represents a group in the list
 type GroupItem struct {
-	name        string
-	description string
-	selected    bool
+	name           string
+	description    string
+	defaultCommand string
+	selected       bool
 }
 
 func (i GroupItem) FilterValue() string { return i.name }
@@ -101,9 +110,27 @@ func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		textinput.Blink,
 		m.loadGroups(),
+		m.loadCommands(),
 	)
 }
 
+// loadCommands loads the commands suggested on the command input screen
+func (m Model) loadCommands() tea.Cmd {
+	return func() tea.Msg {
+		commands, err := m.executeCommandUC.GetAvailableCommands(context.Background())
+		if err != nil {
+			// Suggestions are optional, any command can still be typed
+			return commandsLoadedMsg(nil)
+		}
+
+		suggestions := make([]string, len(commands))
+		for i, command := range commands {
+			suggestions[i] = "git " + command
+		}
+		return commandsLoadedMsg(suggestions)
+	}
+}
+
 // loadGroups loads groups from configuration
 func (m Model) loadGroups() tea.Cmd {
 	return func() tea.Msg {
@@ -116,13 +143,16 @@ func (m Model) loadGroups() tea.Cmd {
 		items := make([]list.Item, len(groups))
 		for i, group := range groups {
 			description := group.Description
-			if description == "" {
+			if description == "" && group.IsComposed() {
+				description = group.Composition.String()
+			} else if description == "" {
 				description = fmt.Sprintf("%d repositories", len(group.Repositories))
 			}
 			items[i] = GroupItem{
-				name:        group.Name,
-				description: description,
-				selected:    false,
+				name:           group.Name,
+				description:    description,
+				defaultCommand: group.DefaultCommand,
+				selected:       false,
 			}
 		}
 
@@ -135,7 +165,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case groupsLoadedMsg:
 		m.groups = []list.Item(msg)
-		m.groupList.SetItems(m.groups)
+		m.groupList.SetItems(filterItems(m.groups, m.groupFilter))
+		return m, nil
+
+	case commandsLoadedMsg:
+		m.commands = []string(msg)
 		return m, nil
 
 	case groupsLoadErrorMsg:
@@ -164,8 +198,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // handleGroupSelection handles group selection state
 func (m Model) handleGroupSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
-	case "ctrl+c", "q":
+	case "ctrl+c":
 		return m, tea.Quit
+	case "q":
+		if !m.filtering {
+			return m, tea.Quit
+		}
+	case "esc":
+		// Clear the filter, showing every group again
+		if m.filtering {
+			m.setGroupFilter("", false)
+			return m, nil
+		}
+	case "backspace":
+		if m.filtering {
+			filter := []rune(m.groupFilter)
+			if len(filter) > 0 {
+				filter = filter[:len(filter)-1]
+			}
+			m.setGroupFilter(string(filter), len(filter) > 0)
+		}
+		return m, nil
+	case "/":
+		if !m.filtering {
+			m.setGroupFilter("", true)
+			return m, nil
+		}
 	case " ":
 		// Toggle group selection
 		if i, ok := m.groupList.SelectedItem().(GroupItem); ok {
@@ -198,19 +256,87 @@ func (m Model) handleGroupSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Typing narrows the visible groups
+	if msg.Type == tea.KeyRunes {
+		m.setGroupFilter(m.groupFilter+string(msg.Runes), true)
+		return m, nil
+	}
+
 	var cmd tea.Cmd
 	m.groupList, cmd = m.groupList.Update(msg)
 	return m, cmd
 }
 
+// setGroupFilter shows the groups matching the filter, moving the cursor back to the first one
+func (m *Model) setGroupFilter(filter string, filtering bool) {
+	m.groupFilter = filter
+	m.filtering = filtering
+	m.groupList.SetItems(filterItems(m.groups, filter))
+	m.groupList.Select(0)
+}
+
+// commandSuggestions returns the suggestions matching the typed command: the default
+// commands of the selected groups first, then the git commands
+func (m Model) commandSuggestions() []commandSuggestion {
+	filter := strings.TrimSpace(m.commandInput.Value())
+
+	var suggestions []commandSuggestion
+	custom := make(map[string]int)
+	for _, item := range m.groups {
+		group := item.(GroupItem)
+		if group.defaultCommand == "" || !slices.Contains(m.selectedGroups, group.name) || !matchesFilter(group.defaultCommand, filter) {
+			continue
+		}
+
+		// Groups sharing a default command share its entry
+		if i, exists := custom[group.defaultCommand]; exists {
+			suggestions[i].groups = append(suggestions[i].groups, group.name)
+			continue
+		}
+		custom[group.defaultCommand] = len(suggestions)
+		suggestions = append(suggestions, commandSuggestion{command: group.defaultCommand, groups: []string{group.name}})
+	}
+
+	for _, command := range filterCommands(m.commands, filter) {
+		suggestions = append(suggestions, commandSuggestion{command: command})
+	}
+	return suggestions
+}
+
 // handleCommandInput handles command input state
 func (m Model) handleCommandInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	suggestions := m.commandSuggestions()
+
 	switch msg.String() {
 	case "ctrl+c":
 		return m, tea.Quit
 	case "esc":
+		// Clear the typed command first, then go back to the groups
+		if m.commandInput.Value() != "" {
+			m.commandInput.SetValue("")
+			m.commandIndex = 0
+			return m, nil
+		}
 		m.state = StateGroupSelection
 		return m, nil
+	case "up":
+		if m.commandIndex > 0 {
+			m.commandIndex--
+		}
+		return m, nil
+	case "down":
+		if m.commandIndex < len(suggestions)-1 {
+			m.commandIndex++
+		}
+		return m, nil
+	case "tab":
+		// Complete the command with the highlighted suggestion
+		if m.commandIndex < len(suggestions) {
+			m.commandInput.SetValue(suggestions[m.commandIndex].command + " ")
+			m.commandInput.CursorEnd()
+			m.commandIndex = 0
+		}
+		return m, nil
 	case "enter":
 		m.selectedCommand = m.commandInput.Value()
 		if m.selectedCommand == "" {
@@ -223,6 +349,7 @@ func (m Model) handleCommandInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	var cmd tea.Cmd
 	m.commandInput, cmd = m.commandInput.Update(msg)
+	m.commandIndex = 0
 	return m, cmd
 }
 
@@ -260,7 +387,7 @@ func (m Model) renderGroupSelection() string {
 	b.WriteString(title + "\n\n")
 
 	// Instructions
-	instructions := m.stylesService.GetPathStyle().Render("Use ↑/↓ to navigate, Space to toggle selection, Enter to continue")
+	instructions := m.stylesService.GetPathStyle().Render("Use ↑/↓ to navigate, Space to toggle selection, Enter to continue, type to filter, Esc to clear")
 	b.WriteString(instructions + "\n\n")
 
 	// Check if groups are still loading or empty
@@ -270,8 +397,18 @@ func (m Model) renderGroupSelection() string {
 		return b.String()
 	}
 
+	if m.filtering {
+		b.WriteString(fmt.Sprintf("Filter: %s\n\n", m.groupFilter))
+	}
+
+	visible := filterItems(m.groups, m.groupFilter)
+	if len(visible) == 0 {
+		b.WriteString(m.stylesService.GetPathStyle().Italic(true).Render("No groups match the filter") + "\n")
+	}
+
 	// Group list with selection indicators
-	for i, item := range m.groups {
+	highlight := m.matchStyle()
+	for i, item := range visible {
 		group := item.(GroupItem)
 		indicator := "  "
 		style := lipgloss.NewStyle()
@@ -285,7 +422,9 @@ func (m Model) renderGroupSelection() string {
 			style = style.Background(lipgloss.Color(m.stylesService.GetHighlightBgColor()))
 		}
 
-		line := fmt.Sprintf("%s%s - %s", indicator, group.name, group.description)
+		line := fmt.Sprintf("%s%s - %s", indicator,
+			highlightMatch(group.name, m.groupFilter, highlight),
+			highlightMatch(group.description, m.groupFilter, highlight))
 		b.WriteString(style.Render(line) + "\n")
 	}
 
@@ -300,6 +439,11 @@ func (m Model) renderGroupSelection() string {
 	return b.String()
 }
 
+// matchStyle returns the style of the parts of the entries matching the filter
+func (m Model) matchStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.stylesService.GetHighlightColor()))
+}
+
 // renderCommandInput renders the command input view
 func (m Model) renderCommandInput() string {
 	var b strings.Builder
@@ -316,8 +460,27 @@ func (m Model) renderCommandInput() string {
 	b.WriteString("Command to execute:\n")
 	b.WriteString(m.commandInput.View() + "\n\n")
 
+	// Suggestions matching the typed command, the custom ones marked with their groups
+	if suggestions := m.commandSuggestions(); len(suggestions) > 0 {
+		highlight := m.matchStyle()
+		for i, suggestion := range suggestions {
+			style := lipgloss.NewStyle()
+			line := "  " + highlightMatch(suggestion.command, m.commandInput.Value(), highlight)
+			if suggestion.isCustom() {
+				style = style.Foreground(lipgloss.Color(m.stylesService.GetSecondaryColor()))
+				line = fmt.Sprintf("★ %s  (@%s)", highlightMatch(suggestion.command, m.commandInput.Value(), highlight),
+					strings.Join(suggestion.groups, ", @"))
+			}
+			if i == m.commandIndex {
+				style = style.Background(lipgloss.Color(m.stylesService.GetHighlightBgColor()))
+			}
+			b.WriteString(style.Render(line) + "\n")
+		}
+		b.WriteString("\n")
+	}
+
 	// Instructions
-	instructions := m.stylesService.GetPathStyle().Render("Press Enter to execute, Esc to go back, Ctrl+C to quit")
+	instructions := m.stylesService.GetPathStyle().Render("Press Enter to execute, Tab to complete, Esc to clear or go back, Ctrl+C to quit")
 	b.WriteString(instructions)
 
 	return b.String()
@@ -0,0 +1,235 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+	"github.com/qskkk/git-fleet/v2/internal/infrastructure/ui/styles"
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+	"golang.org/x/term"
+)
+
+// RepositoryItem represents a repository in the picker
+type RepositoryItem struct {
+	name     string
+	path     string
+	selected bool
+}
+
+func (i RepositoryItem) FilterValue() string { return i.name }
+func (i RepositoryItem) Title() string       { return i.name }
+func (i RepositoryItem) Description() string { return i.path }
+
+// RepositoryPicker lets the user narrow the repositories of a CLI command with the
+// same selection as the group screen of the TUI
+type RepositoryPicker struct {
+	stylesService styles.Service
+}
+
+// NewRepositoryPicker creates a new repository picker
+func NewRepositoryPicker(stylesService styles.Service) *RepositoryPicker {
+	return &RepositoryPicker{stylesService: stylesService}
+}
+
+// SelectRepositories shows the repositories, all of them checked, and returns the names
+// of those still checked when the user presses Enter
+func (p *RepositoryPicker) SelectRepositories(ctx context.Context, repos []*entities.Repository) ([]string, error) {
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		return nil, errors.ErrSelectNeedsTerminal
+	}
+
+	program := tea.NewProgram(newPickerModel(repos, p.stylesService), tea.WithAltScreen(), tea.WithContext(ctx))
+	finalModel, err := program.Run()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run TUI: %w", err)
+	}
+
+	m, ok := finalModel.(pickerModel)
+	if !ok || !m.confirmed {
+		return nil, errors.ErrSelectionCancelled
+	}
+	return m.selectedNames(), nil
+}
+
+// pickerModel is the TUI model of the repository picker
+type pickerModel struct {
+	stylesService styles.Service
+	items         []list.Item
+	itemList      list.Model
+	filter        string
+	filtering     bool
+	confirmed     bool
+	width         int
+}
+
+// newPickerModel creates a picker model offering the repositories, all of them checked
+func newPickerModel(repos []*entities.Repository, stylesService styles.Service) pickerModel {
+	items := make([]list.Item, len(repos))
+	for i, repo := range repos {
+		items[i] = RepositoryItem{name: repo.Name, path: repo.Path, selected: true}
+	}
+
+	itemList := list.New(items, list.NewDefaultDelegate(), 0, 0)
+	itemList.SetShowStatusBar(false)
+	itemList.SetFilteringEnabled(false)
+
+	return pickerModel{
+		stylesService: stylesService,
+		items:         items,
+		itemList:      itemList,
+	}
+}
+
+// Init initializes the model
+func (m pickerModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages
+func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.itemList.SetWidth(msg.Width)
+		m.itemList.SetHeight(msg.Height - 4)
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+// handleKey toggles, filters and confirms the selection like the group selection screen
+func (m pickerModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "q":
+		if !m.filtering {
+			return m, tea.Quit
+		}
+	case "esc":
+		// Clear the filter, showing every repository again
+		if m.filtering {
+			m.setFilter("", false)
+			return m, nil
+		}
+		return m, tea.Quit
+	case "backspace":
+		if m.filtering {
+			filter := []rune(m.filter)
+			if len(filter) > 0 {
+				filter = filter[:len(filter)-1]
+			}
+			m.setFilter(string(filter), len(filter) > 0)
+		}
+		return m, nil
+	case "/":
+		if !m.filtering {
+			m.setFilter("", true)
+			return m, nil
+		}
+	case " ":
+		if current, ok := m.itemList.SelectedItem().(RepositoryItem); ok {
+			for j, item := range m.items {
+				if repo := item.(RepositoryItem); repo.name == current.name {
+					repo.selected = !repo.selected
+					m.items[j] = repo
+					break
+				}
+			}
+			m.itemList.SetItems(filterItems(m.items, m.filter))
+		}
+		return m, nil
+	case "enter":
+		m.confirmed = true
+		return m, tea.Quit
+	}
+
+	// Typing narrows the visible repositories
+	if msg.Type == tea.KeyRunes {
+		m.setFilter(m.filter+string(msg.Runes), true)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.itemList, cmd = m.itemList.Update(msg)
+	return m, cmd
+}
+
+// setFilter shows the repositories matching the filter, moving the cursor back to the first one
+func (m *pickerModel) setFilter(filter string, filtering bool) {
+	m.filter = filter
+	m.filtering = filtering
+	m.itemList.SetItems(filterItems(m.items, filter))
+	m.itemList.Select(0)
+}
+
+// selectedNames returns the names of the checked repositories in their listed order
+func (m pickerModel) selectedNames() []string {
+	var names []string
+	for _, item := range m.items {
+		if repo := item.(RepositoryItem); repo.selected {
+			names = append(names, repo.name)
+		}
+	}
+	return names
+}
+
+// View renders the model
+func (m pickerModel) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	var b strings.Builder
+
+	b.WriteString(m.stylesService.GetTitleStyle().Render("🚀 GitFleet - Select Repositories") + "\n\n")
+
+	instructions := m.stylesService.GetPathStyle().Render("Use ↑/↓ to navigate, Space to toggle selection, Enter to run, type to filter, Esc to clear or cancel")
+	b.WriteString(instructions + "\n\n")
+
+	if m.filtering {
+		b.WriteString(fmt.Sprintf("Filter: %s\n\n", m.filter))
+	}
+
+	visible := filterItems(m.items, m.filter)
+	if len(visible) == 0 {
+		b.WriteString(m.stylesService.GetPathStyle().Italic(true).Render("No repositories match the filter") + "\n")
+	}
+
+	highlight := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.stylesService.GetHighlightColor()))
+	for i, item := range visible {
+		repo := item.(RepositoryItem)
+		indicator := "  "
+		style := lipgloss.NewStyle()
+
+		if repo.selected {
+			indicator = "✓ "
+			style = style.Foreground(lipgloss.Color(m.stylesService.GetSecondaryColor()))
+		}
+
+		if i == m.itemList.Index() {
+			style = style.Background(lipgloss.Color(m.stylesService.GetHighlightBgColor()))
+		}
+
+		line := fmt.Sprintf("%s%s - %s", indicator,
+			highlightMatch(repo.name, m.filter, highlight),
+			highlightMatch(repo.path, m.filter, highlight))
+		b.WriteString(style.Render(line) + "\n")
+	}
+
+	b.WriteString("\n")
+	selected := fmt.Sprintf("Selected: %d of %d repositories", len(m.selectedNames()), len(m.items))
+	b.WriteString(m.stylesService.GetSuccessStyle().Render(selected) + "\n")
+
+	return b.String()
+}
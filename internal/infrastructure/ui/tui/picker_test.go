@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/qskkk/git-fleet/v2/internal/domain/entities"
+)
+
+func pickerUpdate(m pickerModel, msg tea.Msg) pickerModel {
+	updated, _ := m.Update(msg)
+	return updated.(pickerModel)
+}
+
+func testPicker() pickerModel {
+	repos := []*entities.Repository{
+		{Name: "api", Path: "/src/api"},
+		{Name: "web", Path: "/src/web"},
+		{Name: "worker", Path: "/src/worker"},
+	}
+	return pickerUpdate(newPickerModel(repos, createTestStylesService()), tea.WindowSizeMsg{Width: 80, Height: 24})
+}
+
+func TestPickerModel_Selection(t *testing.T) {
+	m := testPicker()
+
+	if got := m.selectedNames(); !reflect.DeepEqual(got, []string{"api", "web", "worker"}) {
+		t.Fatalf("selectedNames() = %v, want every repository checked at first", got)
+	}
+
+	// Typing filters, and Space unchecks the repository under the cursor
+	for _, r := range "web" {
+		m = pickerUpdate(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m = pickerUpdate(m, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if got := m.selectedNames(); !reflect.DeepEqual(got, []string{"api", "worker"}) {
+		t.Errorf("selectedNames() = %v, want web unchecked", got)
+	}
+	if view := m.View(); !strings.Contains(view, "Selected: 2 of 3 repositories") || strings.Contains(view, "/src/api") {
+		t.Errorf("View() = %q, want the filtered list and the selection count", view)
+	}
+
+	m = pickerUpdate(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.confirmed {
+		t.Error("Enter did not confirm the selection")
+	}
+}
+
+func TestPickerModel_Cancel(t *testing.T) {
+	m := testPicker()
+
+	// The first Esc clears the filter, the second one cancels
+	m = pickerUpdate(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	m = pickerUpdate(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.filtering || m.filter != "" {
+		t.Errorf("Esc did not clear the filter %q", m.filter)
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil || updated.(pickerModel).confirmed {
+		t.Error("Esc without a filter should quit without confirming")
+	}
+}
@@ -0,0 +1,83 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time. Durations, timestamps and waits are taken from a Clock so
+// that tests can supply a fake one and get reproducible output without sleeping.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// Real is the Clock of the system time
+type Real struct{}
+
+// New returns the Clock of the system time
+func New() Clock {
+	return Real{}
+}
+
+// Now returns the current system time
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+// After waits for the duration to elapse, then sends the current time on the returned channel
+func (Real) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Fake is a Clock whose time only moves when told to. With a Step, each call to Now
+// moves it forward by the step, so that every measured duration is a multiple of it.
+type Fake struct {
+	mutex sync.Mutex
+	now   time.Time
+	step  time.Duration
+}
+
+// NewFake returns a Fake clock starting at the given time and moving forward by step on
+// each call to Now, or standing still when step is zero
+func NewFake(start time.Time, step time.Duration) *Fake {
+	return &Fake{now: start, step: step}
+}
+
+// Now returns the time of the fake clock, then moves it forward by its step
+func (f *Fake) Now() time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	now := f.now
+	f.now = f.now.Add(f.step)
+	return now
+}
+
+// After moves the fake clock forward by d and returns a channel holding the new time,
+// so that waiting on it returns at once
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.now = f.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+	return ch
+}
+
+// Advance moves the fake clock forward by d
+func (f *Fake) Advance(d time.Duration) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.now = f.now.Add(d)
+}
+
+// Set moves the fake clock to the given time
+func (f *Fake) Set(t time.Time) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.now = t
+}
@@ -0,0 +1,59 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReal_Now(t *testing.T) {
+	before := time.Now()
+	now := New().Now()
+	if now.Before(before) || now.After(time.Now()) {
+		t.Errorf("Real.Now() = %v, want the current time", now)
+	}
+}
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	fake := NewFake(start, time.Second)
+	if now := fake.Now(); !now.Equal(start) {
+		t.Errorf("first Now() = %v, want %v", now, start)
+	}
+	if now := fake.Now(); !now.Equal(start.Add(time.Second)) {
+		t.Errorf("second Now() = %v, want one step later", now)
+	}
+
+	fake.Advance(time.Minute)
+	if now := fake.Now(); !now.Equal(start.Add(2*time.Second + time.Minute)) {
+		t.Errorf("Now() after Advance() = %v", now)
+	}
+
+	fake.Set(start)
+	if now := fake.Now(); !now.Equal(start) {
+		t.Errorf("Now() after Set() = %v, want %v", now, start)
+	}
+
+	still := NewFake(start, 0)
+	still.Now()
+	if now := still.Now(); !now.Equal(start) {
+		t.Errorf("Now() without a step = %v, want %v", now, start)
+	}
+}
+
+func TestFake_After(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake := NewFake(start, 0)
+
+	select {
+	case now := <-fake.After(time.Minute):
+		if !now.Equal(start.Add(time.Minute)) {
+			t.Errorf("After() sent %v, want the time a minute later", now)
+		}
+	default:
+		t.Fatal("After() should not block on a fake clock")
+	}
+	if now := fake.Now(); !now.Equal(start.Add(time.Minute)) {
+		t.Errorf("Now() after After() = %v, want the clock moved forward", now)
+	}
+}
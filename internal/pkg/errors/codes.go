@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Error codes of the git execution failure errors, as written in reports and events
+const (
+	CodeNotAGitRepo      = "not_a_git_repo"
+	CodeMergeConflict    = "merge_conflict"
+	CodeAuthRequired     = "auth_required"
+	CodeTimeout          = "timeout"
+	CodeNetworkFailure   = "network_failure"
+	CodeHookRejected     = "hook_rejected"
+	CodeGitCommandFailed = "git_command_failed"
+	CodeRepositoryLocked = "repository_locked"
+)
+
+// codedErrors maps the error codes to their errors
+var codedErrors = map[string]error{
+	CodeNotAGitRepo:      ErrNotAGitRepo,
+	CodeMergeConflict:    ErrMergeConflict,
+	CodeAuthRequired:     ErrAuthRequired,
+	CodeTimeout:          ErrTimeout,
+	CodeNetworkFailure:   ErrNetworkFailure,
+	CodeHookRejected:     ErrHookRejected,
+	CodeGitCommandFailed: ErrGitCommandFailed,
+	CodeRepositoryLocked: ErrRepositoryLocked,
+}
+
+// TransientCodes lists the error codes of failures that may not happen again when the
+// command is retried, which are the ones retried by default
+var TransientCodes = []string{CodeTimeout, CodeNetworkFailure, CodeRepositoryLocked}
+
+// IsValidCode checks if code is the code of a git execution failure error
+func IsValidCode(code string) bool {
+	_, ok := codedErrors[code]
+	return ok
+}
+
+// Codes returns the codes of the git execution failure errors, sorted
+func Codes() []string {
+	codes := make([]string, 0, len(codedErrors))
+	for code := range codedErrors {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Code returns the error code of the git execution failure error in the chain of err,
+// or an empty string when there is none
+func Code(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	for code, target := range codedErrors {
+		if IsError(err, target) {
+			return code
+		}
+	}
+
+	return ""
+}
+
+// FromCode returns the error with the given code, wrapping the message when one is given.
+// Unknown codes return nil.
+func FromCode(code, message string) error {
+	err, ok := codedErrors[code]
+	if !ok {
+		return nil
+	}
+
+	if message == "" {
+		return err
+	}
+	return fmt.Errorf("%w: %s", err, message)
+}
@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{nil, ""},
+		{ErrMergeConflict, CodeMergeConflict},
+		{fmt.Errorf("pull: %w", ErrAuthRequired), CodeAuthRequired},
+		{FromCode(CodeTimeout, "command execution timed out"), CodeTimeout},
+		{ErrRepositoryNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Code(tt.err); got != tt.expected {
+			t.Errorf("Code(%v) = %q, want %q", tt.err, got, tt.expected)
+		}
+	}
+}
+
+func TestFromCode(t *testing.T) {
+	for code, target := range codedErrors {
+		err := FromCode(code, "exit status 1")
+		if !IsError(err, target) {
+			t.Errorf("FromCode(%q) = %v, want %v in its chain", code, err, target)
+		}
+		if err.Error() != target.Error()+": exit status 1" {
+			t.Errorf("FromCode(%q) message = %q", code, err.Error())
+		}
+	}
+
+	if err := FromCode(CodeNotAGitRepo, ""); err != ErrNotAGitRepo {
+		t.Errorf("FromCode() without message = %v, want %v", err, ErrNotAGitRepo)
+	}
+	if err := FromCode("unknown", "message"); err != nil {
+		t.Errorf("FromCode() with unknown code = %v, want nil", err)
+	}
+}
@@ -16,6 +16,28 @@ var (
 	ErrUnknownRemoveSubcommand     = errors.New("unknown remove subcommand")
 	ErrAddCommandRequiresSubcmd    = errors.New("add command requires a subcommand (repository, group)")
 	ErrRemoveCommandRequiresSubcmd = errors.New("remove command requires a subcommand (repository, group)")
+	ErrFlagRequiresValue           = errors.New("flag requires a value")
+	ErrInvalidBorderStyle          = errors.New("invalid border style")
+	ErrUnsupportedShell            = errors.New("unsupported shell")
+	ErrUnsupportedExportFormat     = errors.New("unsupported export format")
+	ErrInvalidEventsFormat         = errors.New("invalid events format")
+	ErrInvalidLogLevel             = errors.New("invalid log level")
+	ErrInvalidSortKey              = errors.New("invalid sort key")
+	ErrInvalidGroupByKey           = errors.New("invalid group-by key")
+	ErrInvalidStatusFormat         = errors.New("invalid status format")
+	ErrInvalidGroupRange           = errors.New("invalid group range")
+	ErrInvalidCompositionOperation = errors.New("invalid group composition operation")
+	ErrInvalidFunctionName         = errors.New("invalid shell function name")
+	ErrInvalidSize                 = errors.New("invalid size")
+	ErrInvalidRetryCount           = errors.New("invalid retry count")
+	ErrInvalidRetryCode            = errors.New("invalid retry error code")
+	ErrInvalidGitJobs              = errors.New("invalid number of git jobs")
+	ErrInvalidCopyFormat           = errors.New("invalid copy format")
+	ErrInvalidStatusFilter         = errors.New("invalid status filter")
+	ErrInvalidWorkDir              = errors.New("invalid working directory")
+	ErrInvalidOutputOrder          = errors.New("invalid output order")
+	ErrInvalidDirtyPolicy          = errors.New("invalid dirty policy")
+	ErrInvalidPathStyle            = errors.New("invalid path style")
 
 	// Usage errors
 	ErrUsageAddRepository    = errors.New("usage: gf add repository <name> <path>")
@@ -23,22 +45,61 @@ var (
 	ErrUsageRemoveRepository = errors.New("usage: gf remove repository <name>")
 	ErrUsageRemoveGroup      = errors.New("usage: gf remove group <name>")
 	ErrUsageGoto             = errors.New("usage: gf goto <repository-name>")
+	ErrUsageRerun            = errors.New("usage: gf rerun --report <file>")
+	ErrUsageShellInit        = errors.New("usage: gf shell-init <zsh|bash|fish> [function-name]")
+	ErrUsageExport           = errors.New("usage: gf export <format>")
+	ErrUsageTagRelease       = errors.New("usage: gf @<group> tag-release <version> [-m <message>] [--no-push]")
+	ErrUsageBranchCleanup    = errors.New("usage: gf @<group> branch-cleanup [--dry-run]")
+	ErrUsageResetToUpstream  = errors.New("usage: gf @<group> reset-to-upstream [--force]")
+	ErrUsageWorktreeAdd      = errors.New("usage: gf @<group> worktree-add <branch>")
+	ErrUsageCommitPrompt     = errors.New("usage: gf @<group> --interactive-commit commit [options]")
+	ErrUsagePullReport       = errors.New("usage: gf @<group> pull --ff-only --report-conflicts [options]")
+	ErrUsageAmend            = errors.New("usage: gf @<group> amend [-m <message>] [--force]")
+	ErrUsageGrep             = errors.New("usage: gf @<group> grep <pattern> [--files-only]")
+	ErrUsageSwitchRemote     = errors.New("usage: gf @<group> switch-remote <remote> <new-url> [--dry-run]")
+	ErrUsageAuthors          = errors.New("usage: gf @<group> authors [--since <date>]")
+	ErrUsageClean            = errors.New("usage: gf @<group> clean [--dry-run | --force]")
+	ErrUsageCount            = errors.New("usage: gf @<group> count <pattern>")
+	ErrUsageApply            = errors.New("usage: gf @<group> apply <patch-file> [--3way]")
+	ErrUsageRebaseOnto       = errors.New("usage: gf @<group> rebase-onto <branch> [--abort-on-conflict]")
+	ErrUsageCompareBranch    = errors.New("usage: gf @<group> compare-branch <branch>")
 
 	// Repository and configuration errors
 	ErrRepositoryNotFound      = errors.New("repository not found")
 	ErrGroupNotFound           = errors.New("group not found")
 	ErrNoRepositoriesForGroups = errors.New("no repositories found for groups")
 	ErrInvalidDirectory        = errors.New("not a valid directory")
+	ErrInvalidRepositoryRegexp = errors.New("invalid repository pattern")
+	ErrAmbiguousRepositoryName = errors.New("pattern matches several repositories")
 
 	// Git operation errors
-	ErrFailedToGetCurrentBranch = errors.New("failed to get current branch")
-	ErrFailedToGetStatus        = errors.New("failed to get status")
-	ErrGitStatusError           = errors.New("git status error")
-	ErrFailedToGetRemotes       = errors.New("failed to get remotes")
-	ErrFailedToGetLastCommit    = errors.New("failed to get last commit")
-	ErrUnexpectedGitLogFormat   = errors.New("unexpected git log output format")
-	ErrFailedToParseAheadCount  = errors.New("failed to parse ahead count")
-	ErrFailedToParseBehindCount = errors.New("failed to parse behind count")
+	ErrFailedToGetCurrentBranch  = errors.New("failed to get current branch")
+	ErrFailedToGetStatus         = errors.New("failed to get status")
+	ErrGitStatusError            = errors.New("git status error")
+	ErrFailedToGetRemotes        = errors.New("failed to get remotes")
+	ErrFailedToGetRemoteURL      = errors.New("failed to get remote url")
+	ErrFailedToGetLastCommit     = errors.New("failed to get last commit")
+	ErrUnexpectedGitLogFormat    = errors.New("unexpected git log output format")
+	ErrFailedToParseAheadCount   = errors.New("failed to parse ahead count")
+	ErrFailedToParseBehindCount  = errors.New("failed to parse behind count")
+	ErrFailedToGetGitDir         = errors.New("failed to get git directory")
+	ErrFailedToGetDiffStat       = errors.New("failed to get diff stat")
+	ErrFailedToGetUntracked      = errors.New("failed to get untracked files")
+	ErrLargeUntrackedFiles       = errors.New("large untracked files found")
+	ErrFailedToGetTags           = errors.New("failed to get tags")
+	ErrFailedToPruneRemote       = errors.New("failed to prune remote")
+	ErrFailedToPruneTags         = errors.New("failed to prune tags")
+	ErrDefaultBranchNotFound     = errors.New("default branch not found")
+	ErrBranchNotFound            = errors.New("branch not found")
+	ErrFailedToCompareBranch     = errors.New("failed to compare branch")
+	ErrFailedToGetMergedBranches = errors.New("failed to get merged branches")
+	ErrFailedToDeleteBranch      = errors.New("failed to delete branch")
+	ErrFailedToSetRemoteURL      = errors.New("failed to set remote url")
+	ErrFailedToGetDiskUsage      = errors.New("failed to get disk usage")
+	ErrFailedToListFiles         = errors.New("failed to list tracked files")
+	ErrFailedToGetSubmodules     = errors.New("failed to get submodules")
+	ErrFailedToGetAuthors        = errors.New("failed to get authors")
+	ErrFailedToClean             = errors.New("failed to clean untracked files")
 
 	// Command execution errors
 	ErrCommandExecution         = errors.New("error executing command")
@@ -47,6 +108,16 @@ var (
 	ErrGlobalCommandExecution   = errors.New("error executing global command")
 	ErrPullCommandExecution     = errors.New("error executing pull command")
 	ErrFetchCommandExecution    = errors.New("error executing fetch command")
+	ErrCommandNotConfirmed      = errors.New("dangerous command was not confirmed, use --yes to skip the confirmation")
+	ErrNoCommitMessage          = errors.New("no commit message given, pass -m <message> when not running in a terminal or with --yes")
+	ErrNoTerminal               = errors.New("input is not a terminal")
+	ErrNotifierUnavailable      = errors.New("no desktop notifier available")
+	ErrClipboardUnavailable     = errors.New("no clipboard tool available")
+	ErrCommandFailed            = errors.New("command failed")
+	ErrSelectNeedsTerminal      = errors.New("--select needs a terminal to pick the repositories in")
+	ErrSelectionCancelled       = errors.New("repository selection was cancelled")
+	ErrNoRepositoriesSelected   = errors.New("no repositories selected")
+	ErrDirtyNeedsCommand        = errors.New("--dirty selects the repositories a command runs in; use status --filter status=modified to list them")
 
 	// Configuration errors
 	ErrConfigurationError       = errors.New("configuration error")
@@ -78,11 +149,13 @@ var (
 	ErrCommandTimeoutNegative    = errors.New("command timeout cannot be negative")
 	ErrAtLeastOneGroupRequired   = errors.New("at least one group must be specified")
 	ErrGroupMustHaveRepositories = errors.New("group must contain at least one repository")
+	ErrCompositionNeedsTwoGroups = errors.New("group composition must combine at least two groups")
 	ErrCommandArgumentsEmpty     = errors.New("command arguments cannot be empty")
 
 	// Config file errors
 	ErrConfigFileNotExists         = errors.New("configuration file does not exist")
 	ErrConfigFileAlreadyExists     = errors.New("configuration file already exists")
+	ErrConfigChangedOnDisk         = errors.New("configuration file changed since it was loaded, the change was not saved")
 	ErrFailedToReadConfig          = errors.New("failed to read configuration file")
 	ErrFailedToParseConfig         = errors.New("failed to parse configuration file")
 	ErrFailedToCreateConfigDir     = errors.New("failed to create config directory")
@@ -92,20 +165,62 @@ var (
 	ErrFailedToSaveConfig          = errors.New("failed to save configuration")
 	ErrFailedToValidateConfig      = errors.New("configuration validation failed")
 	ErrFailedToCreateDefaultConfig = errors.New("failed to create default configuration")
+	ErrInvalidConfigVersion        = errors.New("invalid configuration version")
+	ErrFailedToMigrateConfig       = errors.New("failed to migrate configuration")
 	ErrFailedToSetTheme            = errors.New("failed to set theme")
+	ErrFailedToBackupConfig        = errors.New("failed to back up configuration")
+	ErrFailedToListBackups         = errors.New("failed to list configuration backups")
+	ErrFailedToRestoreConfig       = errors.New("failed to restore configuration")
+	ErrBackupNotFound              = errors.New("configuration backup not found")
+	ErrNoBackupsAvailable          = errors.New("no configuration backups available")
+	ErrFailedToReadState           = errors.New("failed to read state file")
+	ErrFailedToWriteState          = errors.New("failed to write state file")
 
 	// Git repository specific errors
 	ErrNotValidGitRepository       = errors.New("path is not a valid Git repository")
 	ErrRepositoryPathNotAccessible = errors.New("repository path does not exist or is not accessible")
 	ErrInvalidRepositoryPath       = errors.New("invalid repository path")
 
+	// Git execution failure errors, recorded on results as their error code
+	ErrNotAGitRepo      = errors.New("not a git repository")
+	ErrMergeConflict    = errors.New("merge conflict")
+	ErrAuthRequired     = errors.New("authentication required")
+	ErrTimeout          = errors.New("command timed out")
+	ErrNetworkFailure   = errors.New("remote could not be reached")
+	ErrHookRejected     = errors.New("rejected by a hook")
+	ErrGitCommandFailed = errors.New("git command failed")
+	ErrRepositoryLocked = errors.New("locked by another process")
+
 	// Execution service errors
 	ErrBuiltInCommandNotSupported = errors.New("built-in command not supported in execution service")
 	ErrCommandStringEmpty         = errors.New("command string cannot be empty")
 	ErrNoCommandArgumentsFound    = errors.New("no command arguments found")
 
+	// Report errors
+	ErrFailedToReadReport  = errors.New("failed to read report file")
+	ErrFailedToParseReport = errors.New("failed to parse report file")
+	ErrFailedToWriteReport = errors.New("failed to write report file")
+	ErrInvalidReport       = errors.New("report is missing command, groups or summary")
+
+	// Execution log errors
+	ErrFailedToCreateLogDir = errors.New("failed to create log directory")
+
+	// Update check errors
+	ErrUpdateCheckFailed   = errors.New("failed to check for updates")
+	ErrInvalidReleaseReply = errors.New("unexpected reply from the releases API")
+
+	// Env file errors
+	ErrFailedToReadEnvFile = errors.New("failed to read env file")
+	ErrInvalidEnvFileLine  = errors.New("invalid env file line, expected KEY=VALUE")
+
 	// Group reference errors
 	ErrGroupReferencesNonExistentRepo = errors.New("group references non-existent repository")
+	ErrDependsOnNonExistentRepo       = errors.New("repository depends on non-existent repository")
+	ErrInvalidRepositoryTimeout       = errors.New("invalid repository timeout")
+	ErrDependencyCycle                = errors.New("repository dependencies form a cycle")
+	ErrComposesNonExistentGroup       = errors.New("group composition references non-existent group")
+	ErrGroupCompositionCycle          = errors.New("group compositions form a cycle")
+	ErrInvalidAutoDiscover            = errors.New("invalid auto_discover settings")
 )
 
 // Error wrapper functions for consistent error formatting
@@ -135,6 +250,118 @@ func WrapUnknownRemoveSubcommand(subcmd string) error {
 	return fmt.Errorf("%w: %s", ErrUnknownRemoveSubcommand, subcmd)
 }
 
+// WrapFlagRequiresValue creates an error for a flag given without its value
+func WrapFlagRequiresValue(flag string) error {
+	return fmt.Errorf("%w: %s", ErrFlagRequiresValue, flag)
+}
+
+// WrapInvalidBorderStyle creates an error for an unsupported border style
+func WrapInvalidBorderStyle(borderStyle string, validStyles []string) error {
+	return fmt.Errorf("%w '%s', valid styles are: %v", ErrInvalidBorderStyle, borderStyle, validStyles)
+}
+
+// WrapInvalidEventsFormat creates an error for an unsupported --events format
+func WrapInvalidEventsFormat(format string, validFormats []string) error {
+	return fmt.Errorf("%w '%s', valid formats are: %v", ErrInvalidEventsFormat, format, validFormats)
+}
+
+// WrapInvalidLogLevel creates an error for an unknown --log-level value
+func WrapInvalidLogLevel(level string, validLevels []string) error {
+	return fmt.Errorf("%w '%s', valid levels are: %v", ErrInvalidLogLevel, level, validLevels)
+}
+
+// WrapInvalidSortKey creates an error for an unknown --sort key
+func WrapInvalidSortKey(key string, validKeys []string) error {
+	return fmt.Errorf("%w '%s', valid keys are: %v", ErrInvalidSortKey, key, validKeys)
+}
+
+// WrapInvalidGroupByKey creates an error for an unknown --group-by key
+func WrapInvalidGroupByKey(key string, validKeys []string) error {
+	return fmt.Errorf("%w '%s', valid keys are: %v", ErrInvalidGroupByKey, key, validKeys)
+}
+
+// WrapInvalidStatusFormat creates an error for an unknown --format value
+func WrapInvalidStatusFormat(format string, validFormats []string) error {
+	return fmt.Errorf("%w '%s', valid formats are: %v", ErrInvalidStatusFormat, format, validFormats)
+}
+
+// WrapInvalidRetryCount creates an error for a --retry count that is not a positive number
+func WrapInvalidRetryCount(count string) error {
+	return fmt.Errorf("%w '%s', use a positive number of retries", ErrInvalidRetryCount, count)
+}
+
+// WrapInvalidRetryCode creates an error for a --retry-on entry that is not an error code
+func WrapInvalidRetryCode(code string, validCodes []string) error {
+	return fmt.Errorf("%w '%s', valid codes are: %v", ErrInvalidRetryCode, code, validCodes)
+}
+
+// WrapInvalidGitJobs creates an error for a --git-jobs value that is not a positive number
+func WrapInvalidGitJobs(jobs string) error {
+	return fmt.Errorf("%w '%s', use a positive number of jobs", ErrInvalidGitJobs, jobs)
+}
+
+// WrapInvalidCopyFormat creates an error for an unsupported --copy format
+func WrapInvalidCopyFormat(format string, validFormats []string) error {
+	return fmt.Errorf("%w '%s', valid formats are: %v", ErrInvalidCopyFormat, format, validFormats)
+}
+
+// WrapInvalidStatusFilter creates an error for a --filter that is not status=<status>
+// with a known status
+func WrapInvalidStatusFilter(filter string, validStatuses []string) error {
+	return fmt.Errorf("%w '%s', use status=<status> with one of: %v", ErrInvalidStatusFilter, filter, validStatuses)
+}
+
+// WrapInvalidWorkDir creates an error for a --workdir that is not a subdirectory of
+// the repositories
+func WrapInvalidWorkDir(dir string) error {
+	return fmt.Errorf("%w '%s', use a path relative to the repositories and inside them", ErrInvalidWorkDir, dir)
+}
+
+// WrapInvalidOutputOrder creates an error for an unknown --order-output value
+func WrapInvalidOutputOrder(order string, validOrders []string) error {
+	return fmt.Errorf("%w '%s', valid orders are: %v", ErrInvalidOutputOrder, order, validOrders)
+}
+
+// WrapInvalidPathStyle creates an error for an unknown --path-style value
+func WrapInvalidPathStyle(pathStyle string, validStyles []string) error {
+	return fmt.Errorf("%w '%s', valid styles are: %v", ErrInvalidPathStyle, pathStyle, validStyles)
+}
+
+// WrapInvalidDirtyPolicy creates an error for an unknown --on-dirty policy
+func WrapInvalidDirtyPolicy(policy string, validPolicies []string) error {
+	return fmt.Errorf("%w '%s', valid policies are: %v", ErrInvalidDirtyPolicy, policy, validPolicies)
+}
+
+// WrapInvalidGroupRange creates an error for a group token whose range cannot be parsed
+func WrapInvalidGroupRange(token string) error {
+	return fmt.Errorf("%w '%s', use <group>[start:end] with zero-based indices, e.g. all[0:10]", ErrInvalidGroupRange, token)
+}
+
+// WrapInvalidCompositionOperation creates an error for an unknown group composition operation
+func WrapInvalidCompositionOperation(operation string, validOperations []string) error {
+	return fmt.Errorf("%w '%s', valid operations are: %v", ErrInvalidCompositionOperation, operation, validOperations)
+}
+
+// WrapInvalidSize creates an error for a size that cannot be parsed
+func WrapInvalidSize(size string) error {
+	return fmt.Errorf("%w '%s', use a number of bytes with an optional K, M or G suffix", ErrInvalidSize, size)
+}
+
+// WrapUnsupportedExportFormat creates an error for an unknown export format
+func WrapUnsupportedExportFormat(format string, validFormats []string) error {
+	return fmt.Errorf("%w '%s', valid formats are: %v", ErrUnsupportedExportFormat, format, validFormats)
+}
+
+// WrapUnsupportedShell creates an error for an unsupported shell
+func WrapUnsupportedShell(shell string, validShells []string) error {
+	return fmt.Errorf("%w '%s', valid shells are: %v", ErrUnsupportedShell, shell, validShells)
+}
+
+// WrapInvalidFunctionName creates an error for an invalid shell function name
+func WrapInvalidFunctionName(name string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidFunctionName, name)
+}
+
 // WrapRepositoryNotFound creates an error for repository not found
 func WrapRepositoryNotFound(repoName string) error {
 	return fmt.Errorf("%w: '%s'", ErrRepositoryNotFound, repoName)
@@ -186,6 +413,41 @@ func WrapGroupReferencesNonExistentRepo(groupName, repoName string) error {
 	return fmt.Errorf("group '%s' references non-existent repository '%s'", groupName, repoName)
 }
 
+// WrapInvalidRepositoryTimeout creates an error for a repository timeout that is not a positive duration
+func WrapInvalidRepositoryTimeout(repoName, timeout string) error {
+	return fmt.Errorf("%w: '%s' has timeout '%s', expected a positive duration such as 10m", ErrInvalidRepositoryTimeout, repoName, timeout)
+}
+
+// WrapInvalidAutoDiscover creates an error for automatic discovery settings that cannot be used
+func WrapInvalidAutoDiscover(reason string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidAutoDiscover, reason)
+}
+
+// WrapDependsOnNonExistentRepo creates an error for a dependency on an unknown repository
+func WrapDependsOnNonExistentRepo(repoName, dependency string) error {
+	return fmt.Errorf("%w: '%s' depends on '%s'", ErrDependsOnNonExistentRepo, repoName, dependency)
+}
+
+// WrapInvalidGroupComposition creates an error for a group whose composition is invalid
+func WrapInvalidGroupComposition(groupName string, err error) error {
+	return fmt.Errorf("group '%s': %w", groupName, err)
+}
+
+// WrapComposesNonExistentGroup creates an error for a composition of an unknown group
+func WrapComposesNonExistentGroup(groupName, composedGroup string) error {
+	return fmt.Errorf("%w: '%s' is composed of '%s'", ErrComposesNonExistentGroup, groupName, composedGroup)
+}
+
+// WrapGroupCompositionCycle creates an error for a group composed, directly or not, of itself
+func WrapGroupCompositionCycle(groupName string) error {
+	return fmt.Errorf("%w through '%s'", ErrGroupCompositionCycle, groupName)
+}
+
+// WrapDependencyCycle creates an error for repositories whose dependencies form a cycle
+func WrapDependencyCycle(repoNames []string) error {
+	return fmt.Errorf("%w between: %v", ErrDependencyCycle, repoNames)
+}
+
 // WrapConfigFileNotExists creates an error for missing config file
 func WrapConfigFileNotExists(path string) error {
 	return fmt.Errorf("%w at %s", ErrConfigFileNotExists, path)
@@ -196,6 +458,43 @@ func WrapConfigFileAlreadyExists(path string) error {
 	return fmt.Errorf("%w at %s", ErrConfigFileAlreadyExists, path)
 }
 
+// WrapConfigChangedOnDisk creates an error for a config file edited since it was loaded,
+// when reloading it to apply the change again was declined
+func WrapConfigChangedOnDisk(path string) error {
+	return fmt.Errorf("%w at %s", ErrConfigChangedOnDisk, path)
+}
+
+// WrapInvalidRepositoryRegexp creates an error for a repository pattern that is not a valid regular expression
+func WrapInvalidRepositoryRegexp(pattern string, err error) error {
+	return fmt.Errorf("%w '%s': %w", ErrInvalidRepositoryRegexp, pattern, err)
+}
+
+// WrapAmbiguousRepositoryName creates an error for a pattern matching more than one repository
+func WrapAmbiguousRepositoryName(pattern string, names []string) error {
+	return fmt.Errorf("%w: '%s' matches %v", ErrAmbiguousRepositoryName, pattern, names)
+}
+
+// WrapCommandFailed creates an error for a command that failed on some repositories
+func WrapCommandFailed(failed, total int) error {
+	return fmt.Errorf("%w on %d of %d repositories", ErrCommandFailed, failed, total)
+}
+
+// WrapBackupNotFound creates an error for a configuration backup that does not exist
+func WrapBackupNotFound(name string) error {
+	return fmt.Errorf("%w: %s", ErrBackupNotFound, name)
+}
+
+// WrapInvalidConfigVersion creates an error for a configuration version that is not a number
+func WrapInvalidConfigVersion(version string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidConfigVersion, version)
+}
+
+// WrapConfigParseError creates an error for a configuration file that cannot be parsed,
+// pointing at the line and column of the problem
+func WrapConfigParseError(line, column int, err error) error {
+	return fmt.Errorf("%w at line %d, column %d: %w", ErrFailedToParseConfig, line, column, err)
+}
+
 // WrapPathError creates an error with path context
 func WrapPathError(baseErr error, path string, err error) error {
 	if err != nil {
@@ -244,6 +543,21 @@ func WrapConfigSetTheme(theme string, validThemes []string) error {
 	return fmt.Errorf("invalid theme '%s', valid themes are: %v", theme, validThemes)
 }
 
+// WrapExecutionLogError creates an error for a log directory that cannot be created
+func WrapExecutionLogError(path string, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrFailedToCreateLogDir, path, err)
+}
+
+// WrapUpdateCheckError wraps the cause of a failed update check
+func WrapUpdateCheckError(err error) error {
+	return fmt.Errorf("%w: %w", ErrUpdateCheckFailed, err)
+}
+
+// WrapInvalidEnvFileLine creates an error for a malformed line of an env file
+func WrapInvalidEnvFileLine(path string, line int, content string) error {
+	return fmt.Errorf("%w at %s:%d: %s", ErrInvalidEnvFileLine, path, line, content)
+}
+
 // IsError checks if an error is of a specific type
 func IsError(err, target error) bool {
 	return errors.Is(err, target)
@@ -21,6 +21,41 @@ const (
 	ERROR
 )
 
+// levelNames maps the accepted level names to their level; trace is an
+// alias of debug, the most detailed level the logger has
+var levelNames = map[string]Level{
+	"trace": DEBUG,
+	"debug": DEBUG,
+	"info":  INFO,
+	"warn":  WARN,
+	"error": ERROR,
+}
+
+// LevelNames lists the accepted level names, from the most to the least detailed
+var LevelNames = []string{"trace", "debug", "info", "warn", "error"}
+
+// ParseLevel returns the level for a name such as "info", ignoring case
+func ParseLevel(name string) (Level, bool) {
+	level, ok := levelNames[strings.ToLower(name)]
+	return level, ok
+}
+
+// String returns the upper-case name of the level
+func (l Level) String() string {
+	switch l {
+	case DEBUG:
+		return "DEBUG"
+	case INFO:
+		return "INFO"
+	case WARN:
+		return "WARN"
+	case ERROR:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+}
+
 // Color constants for styled logging (Catppuccin Mocha)
 const (
 	ColorDebug = "#9399b2" // Mocha Overlay 2 (gray)
@@ -144,3 +144,39 @@ func TestLogger_GetLevel(t *testing.T) {
 		t.Errorf("After SetLevel(ERROR), GetLevel() = %d, want %d", logger.GetLevel(), ERROR)
 	}
 }
+
+func TestParseLevel(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expectedLevel Level
+		expectedOK    bool
+	}{
+		{"trace", DEBUG, true},
+		{"debug", DEBUG, true},
+		{"INFO", INFO, true},
+		{"warn", WARN, true},
+		{"error", ERROR, true},
+		{"verbose", DEBUG, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			level, ok := ParseLevel(tc.name)
+			if ok != tc.expectedOK {
+				t.Fatalf("ParseLevel(%q) ok = %v, want %v", tc.name, ok, tc.expectedOK)
+			}
+			if ok && level != tc.expectedLevel {
+				t.Errorf("ParseLevel(%q) = %v, want %v", tc.name, level, tc.expectedLevel)
+			}
+		})
+	}
+}
+
+func TestLevel_String(t *testing.T) {
+	if INFO.String() != "INFO" {
+		t.Errorf("INFO.String() = %q, want %q", INFO.String(), "INFO")
+	}
+	if Level(42).String() != "Level(42)" {
+		t.Errorf("Level(42).String() = %q, want %q", Level(42).String(), "Level(42)")
+	}
+}
@@ -0,0 +1,48 @@
+// Package themes names the UI themes and table border styles, so that the configuration
+// can default and validate them without depending on the styling itself
+package themes
+
+import "strings"
+
+// Names of the UI themes
+const (
+	Dark  = "dark"
+	Light = "light"
+	Fleet = "fleet"
+	Auto  = "auto"
+	Time  = "time"
+)
+
+// Names lists the supported themes
+var Names = []string{Dark, Light, Fleet, Auto, Time}
+
+// Names of the table border styles
+const (
+	BorderNormal  = "normal"
+	BorderRounded = "rounded"
+	BorderThick   = "thick"
+	BorderNone    = "none"
+)
+
+// BorderStyleNames lists the supported border style names
+var BorderStyleNames = []string{BorderNone, BorderNormal, BorderRounded, BorderThick}
+
+// IsValid reports whether the given name is a supported theme
+func IsValid(name string) bool {
+	return contains(Names, name)
+}
+
+// IsValidBorderStyle reports whether the given name is a supported border style
+func IsValidBorderStyle(name string) bool {
+	return contains(BorderStyleNames, name)
+}
+
+// contains reports whether the names hold the given name, whatever its case
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if strings.ToLower(name) == n {
+			return true
+		}
+	}
+	return false
+}
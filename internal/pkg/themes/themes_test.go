@@ -0,0 +1,28 @@
+package themes
+
+import "testing"
+
+func TestIsValid(t *testing.T) {
+	for _, name := range Names {
+		if !IsValid(name) {
+			t.Errorf("IsValid(%q) should be true", name)
+		}
+	}
+	if !IsValid("Fleet") {
+		t.Error("IsValid(\"Fleet\") should ignore the case")
+	}
+	if IsValid("solarized") {
+		t.Error("IsValid(\"solarized\") should be false")
+	}
+}
+
+func TestIsValidBorderStyle(t *testing.T) {
+	for _, name := range BorderStyleNames {
+		if !IsValidBorderStyle(name) {
+			t.Errorf("IsValidBorderStyle(%q) should be true", name)
+		}
+	}
+	if IsValidBorderStyle("dotted") {
+		t.Error("IsValidBorderStyle(\"dotted\") should be false")
+	}
+}
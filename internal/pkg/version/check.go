@@ -0,0 +1,108 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+// LatestReleaseURL is the GitHub API endpoint returning the latest GitFleet release
+const LatestReleaseURL = "https://api.github.com/repos/qskkk/git-fleet/releases/latest"
+
+// CheckTimeout bounds the update check so that an offline machine does not wait long
+const CheckTimeout = 3 * time.Second
+
+// UpdateCheck is the result of comparing the running version with the latest release
+type UpdateCheck struct {
+	Current   string
+	Latest    string
+	Available bool
+}
+
+// release is the part of the GitHub release payload used by the update check
+type release struct {
+	TagName string `json:"tag_name"`
+}
+
+// CheckForUpdate queries the releases endpoint for the latest tag and compares it with
+// the running version. Development builds are never reported as outdated.
+func CheckForUpdate(ctx context.Context, client *http.Client, url string) (*UpdateCheck, error) {
+	ctx, cancel := context.WithTimeout(ctx, CheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.WrapUpdateCheckError(err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.WrapUpdateCheckError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.WrapUpdateCheckError(fmt.Errorf("%w: %s", errors.ErrInvalidReleaseReply, resp.Status))
+	}
+
+	var latest release
+	if err := json.NewDecoder(resp.Body).Decode(&latest); err != nil || latest.TagName == "" {
+		return nil, errors.WrapUpdateCheckError(errors.ErrInvalidReleaseReply)
+	}
+
+	check := &UpdateCheck{Current: Version, Latest: latest.TagName}
+	if Version != "dev" {
+		check.Available = CompareVersions(latest.TagName, Version) > 0
+	}
+
+	return check, nil
+}
+
+// CompareVersions compares two versions such as "v1.2.3" and returns -1, 0 or 1 when
+// a is older than, equal to or newer than b. The leading "v" and any pre-release or
+// build suffix are ignored, and missing parts count as 0.
+func CompareVersions(a, b string) int {
+	partsA, partsB := versionParts(a), versionParts(b)
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var x, y int
+		if i < len(partsA) {
+			x = partsA[i]
+		}
+		if i < len(partsB) {
+			y = partsB[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+// versionParts returns the numeric parts of a version, stopping at the first part that
+// is not a number
+func versionParts(v string) []int {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+
+	var parts []int
+	for _, field := range strings.Split(v, ".") {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
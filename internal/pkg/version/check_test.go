@@ -0,0 +1,77 @@
+package version
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qskkk/git-fleet/v2/internal/pkg/errors"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v1.2.3", "1.2.3", 0},
+		{"v1.10.0", "v1.9.9", 1},
+		{"v2.0.0", "v2.0", 0},
+		{"v1.2.3", "v1.3.0", -1},
+		{"v1.3.0-rc.1", "v1.3.0", 0},
+		{"v2.1.0", "dev", 1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.expected {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestCheckForUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"tag_name": "v2.5.0"}`))
+	}))
+	defer server.Close()
+
+	original := Version
+	defer func() { Version = original }()
+
+	tests := []struct {
+		current   string
+		available bool
+	}{
+		{"v2.4.1", true},
+		{"2.5.0", false},
+		{"dev", false},
+	}
+
+	for _, tt := range tests {
+		Version = tt.current
+		check, err := CheckForUpdate(context.Background(), server.Client(), server.URL)
+		if err != nil {
+			t.Fatalf("CheckForUpdate() with %s error = %v", tt.current, err)
+		}
+		if check.Latest != "v2.5.0" || check.Current != tt.current || check.Available != tt.available {
+			t.Errorf("CheckForUpdate() with %s = %+v, want available %v", tt.current, check, tt.available)
+		}
+	}
+}
+
+func TestCheckForUpdate_Failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	if _, err := CheckForUpdate(context.Background(), server.Client(), server.URL); !errors.IsError(err, errors.ErrInvalidReleaseReply) {
+		t.Errorf("CheckForUpdate() on an error response error = %v, want ErrInvalidReleaseReply", err)
+	}
+
+	// A closed server behaves like an offline machine
+	server.Close()
+	if _, err := CheckForUpdate(context.Background(), server.Client(), server.URL); !errors.IsError(err, errors.ErrUpdateCheckFailed) {
+		t.Errorf("CheckForUpdate() offline error = %v, want ErrUpdateCheckFailed", err)
+	}
+}